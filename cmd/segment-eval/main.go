@@ -0,0 +1,198 @@
+// Command segment-eval evaluates segment detection mechanisms offline. It runs each mechanism on a
+// list of vehicles and time ranges, against a ClickHouse instance or a fixture dump loaded into a
+// throwaway ClickHouse container, and compares the segments to a baseline mechanism or to labelled
+// ground truth. It reports precision, recall, boundary error and runtime per mechanism, and exits
+// non-zero when a checked mechanism falls below the required precision or recall.
+//
+// Cases are a JSON array of {"name", "subject" or "tokenId", "from", "to", "config", "labels"},
+// where labels are [{"start", "end"}] ground truth segments.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"math/big"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"github.com/DIMO-Network/clickhouse-infra/pkg/connect"
+	chconfig "github.com/DIMO-Network/clickhouse-infra/pkg/connect/config"
+	"github.com/DIMO-Network/clickhouse-infra/pkg/container"
+	"github.com/DIMO-Network/cloudevent"
+	sigmigrations "github.com/DIMO-Network/model-garage/pkg/migrations"
+	"github.com/DIMO-Network/shared/pkg/settings"
+	"github.com/DIMO-Network/telemetry-api/internal/config"
+	"github.com/DIMO-Network/telemetry-api/internal/graph/model"
+	"github.com/DIMO-Network/telemetry-api/internal/segmenteval"
+	"github.com/DIMO-Network/telemetry-api/internal/service/ch"
+)
+
+// fixtureClickHousePassword is the password of the throwaway ClickHouse container for fixtures.
+const fixtureClickHousePassword = "segment-eval"
+
+func main() {
+	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+	if err := run(ctx); err != nil {
+		fmt.Fprintln(os.Stderr, "segment-eval:", err)
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context) error {
+	settingsFile := flag.String("settings", "settings.yaml", "settings file with the ClickHouse connection and the chain ID and vehicle contract for tokenId cases; empty for none")
+	casesFile := flag.String("cases", "", "JSON file with the cases to evaluate (required)")
+	fixtureDir := flag.String("fixture", "", "fixture directory to load into a ClickHouse container instead of using the settings' ClickHouse (requires Docker)")
+	dumpDir := flag.String("dump", "", "write a fixture of the cases from the settings' ClickHouse to this directory and exit")
+	baseline := flag.String("baseline", string(model.DetectionMechanismIgnitionDetection), "mechanism to compare to, or \"labels\" for the cases' ground truth")
+	mechanismList := flag.String("mechanisms", "", "comma-separated mechanisms to evaluate; default all except auto, threshold and geofence, which need a config")
+	minIoU := flag.Float64("min-iou", 0.5, "minimum intersection over union for a detected segment to match a baseline segment")
+	checkList := flag.String("check", "", "comma-separated mechanisms that must reach -min-precision and -min-recall")
+	minPrecision := flag.Float64("min-precision", 1, "minimum precision of the checked mechanisms")
+	minRecall := flag.Float64("min-recall", 1, "minimum recall of the checked mechanisms")
+	flag.Parse()
+
+	if *casesFile == "" {
+		return fmt.Errorf("-cases is required")
+	}
+	if *fixtureDir != "" && *dumpDir != "" {
+		return fmt.Errorf("-fixture and -dump are mutually exclusive")
+	}
+	var cfg config.Settings
+	if *settingsFile != "" {
+		var err error
+		cfg, err = settings.LoadConfig[config.Settings](*settingsFile)
+		if err != nil {
+			return fmt.Errorf("couldn't load settings: %w", err)
+		}
+	}
+	// Detectors are evaluated directly, not read from stored segments.
+	cfg.SegmentStoreEnabled = false
+
+	cases, err := segmenteval.LoadCases(*casesFile)
+	if err != nil {
+		return err
+	}
+	for i := range cases {
+		if cases[i].Subject == "" {
+			cases[i].Subject = cloudevent.ERC721DID{
+				ChainID:         cfg.ChainID,
+				ContractAddress: cfg.VehicleNFTAddress,
+				TokenID:         new(big.Int).SetUint64(uint64(cases[i].TokenID)),
+			}.String()
+		}
+	}
+	mechanisms, err := parseMechanisms(*mechanismList, defaultMechanisms())
+	if err != nil {
+		return err
+	}
+	checked, err := parseMechanisms(*checkList, nil)
+	if err != nil {
+		return err
+	}
+
+	if *dumpDir != "" {
+		conn, err := connect.GetClickhouseConn(&cfg.Clickhouse)
+		if err != nil {
+			return fmt.Errorf("failed to connect to clickhouse: %w", err)
+		}
+		fixture, err := segmenteval.DumpFixture(ctx, conn, cases)
+		if err != nil {
+			return err
+		}
+		if err := fixture.Write(*dumpDir); err != nil {
+			return err
+		}
+		fmt.Printf("wrote %d signals and %d state changes to %s\n", len(fixture.Signals), len(fixture.StateChanges), *dumpDir)
+		return nil
+	}
+
+	if *fixtureDir != "" {
+		chSettings, terminate, err := startFixtureClickHouse(ctx, *fixtureDir)
+		if err != nil {
+			return err
+		}
+		defer terminate()
+		cfg.Clickhouse = chSettings
+	}
+	service, err := ch.NewService(cfg)
+	if err != nil {
+		return err
+	}
+
+	results, err := segmenteval.Run(ctx, service, cases, *baseline, mechanisms, *minIoU)
+	if err != nil {
+		return err
+	}
+	if err := segmenteval.WriteReport(os.Stdout, *baseline, results); err != nil {
+		return err
+	}
+	return segmenteval.Check(results, checked, *minPrecision, *minRecall)
+}
+
+// startFixtureClickHouse starts a ClickHouse container with the signal migrations and the fixture in
+// dir, and returns its connection settings and a function that stops it.
+func startFixtureClickHouse(ctx context.Context, dir string) (chconfig.Settings, func(), error) {
+	fixture, err := segmenteval.LoadFixture(dir)
+	if err != nil {
+		return chconfig.Settings{}, nil, err
+	}
+	chContainer, err := container.CreateClickHouseContainer(ctx, chconfig.Settings{Password: fixtureClickHousePassword})
+	if err != nil {
+		return chconfig.Settings{}, nil, fmt.Errorf("failed to create clickhouse container: %w", err)
+	}
+	terminate := func() { chContainer.Terminate(context.Background()) }
+
+	db, err := chContainer.GetClickhouseAsDB()
+	if err != nil {
+		terminate()
+		return chconfig.Settings{}, nil, fmt.Errorf("failed to get clickhouse db: %w", err)
+	}
+	if err := sigmigrations.RunGoose(ctx, []string{"up"}, db); err != nil {
+		terminate()
+		return chconfig.Settings{}, nil, fmt.Errorf("failed to run migrations: %w", err)
+	}
+	conn, err := chContainer.GetClickHouseAsConn()
+	if err != nil {
+		terminate()
+		return chconfig.Settings{}, nil, fmt.Errorf("failed to get clickhouse connection: %w", err)
+	}
+	if err := fixture.Insert(ctx, conn); err != nil {
+		terminate()
+		return chconfig.Settings{}, nil, err
+	}
+	return chContainer.Config(), terminate, nil
+}
+
+// defaultMechanisms returns every mechanism that needs no config. Auto is resolved above the
+// ClickHouse service and is evaluated through the mechanism it chooses.
+func defaultMechanisms() []model.DetectionMechanism {
+	var mechanisms []model.DetectionMechanism
+	for _, m := range model.AllDetectionMechanism {
+		switch m {
+		case model.DetectionMechanismAuto, model.DetectionMechanismThreshold, model.DetectionMechanismGeofence:
+			continue
+		}
+		mechanisms = append(mechanisms, m)
+	}
+	return mechanisms
+}
+
+// parseMechanisms parses a comma-separated list of mechanisms, returning def if list is empty.
+func parseMechanisms(list string, def []model.DetectionMechanism) ([]model.DetectionMechanism, error) {
+	if list == "" {
+		return def, nil
+	}
+	var mechanisms []model.DetectionMechanism
+	for _, name := range strings.Split(list, ",") {
+		m := model.DetectionMechanism(strings.TrimSpace(name))
+		if !m.IsValid() || m == model.DetectionMechanismAuto {
+			return nil, fmt.Errorf("unknown mechanism %q", name)
+		}
+		mechanisms = append(mechanisms, m)
+	}
+	return mechanisms, nil
+}
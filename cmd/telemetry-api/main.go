@@ -17,6 +17,7 @@ import (
 	"github.com/DIMO-Network/shared/pkg/settings"
 	"github.com/DIMO-Network/telemetry-api/internal/app"
 	"github.com/DIMO-Network/telemetry-api/internal/config"
+	"github.com/DIMO-Network/telemetry-api/internal/service/ch"
 	"github.com/rs/zerolog"
 	"golang.org/x/sync/errgroup"
 )
@@ -43,6 +44,7 @@ func main() {
 	runnerGroup, runnerCtx := errgroup.WithContext(mainCtx)
 
 	settingsFile := flag.String("settings", "settings.yaml", "settings file")
+	migrate := flag.Bool("migrate", false, "apply the ClickHouse migrations and exit")
 	flag.Parse()
 
 	cfg, err := settings.LoadConfig[config.Settings](*settingsFile)
@@ -60,6 +62,14 @@ func main() {
 		zerolog.SetGlobalLevel(zerolog.InfoLevel)
 	}
 
+	if *migrate {
+		if err := ch.Migrate(mainCtx, cfg); err != nil {
+			logger.Fatal().Err(err).Msg("Couldn't apply migrations.")
+		}
+		logger.Info().Msg("Migrations applied.")
+		return
+	}
+
 	application, err := app.New(cfg)
 	if err != nil {
 		logger.Fatal().Err(err).Msg("Couldn't create application.")
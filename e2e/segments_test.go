@@ -10,6 +10,9 @@ import (
 	chconfig "github.com/DIMO-Network/clickhouse-infra/pkg/connect/config"
 	"github.com/DIMO-Network/clickhouse-infra/pkg/container"
 	sigmigrations "github.com/DIMO-Network/model-garage/pkg/migrations"
+	"github.com/DIMO-Network/telemetry-api/internal/config"
+	"github.com/DIMO-Network/telemetry-api/internal/graph/model"
+	"github.com/DIMO-Network/telemetry-api/internal/segmenteval"
 	"github.com/DIMO-Network/telemetry-api/internal/service/ch"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -524,3 +527,39 @@ func generateRechargeSignals(startTime time.Time) []testSignal {
 
 	return signals
 }
+
+// TestChangePointMatchesIgnitionBaseline checks with segmenteval that change point detection finds
+// the same trips as ignition detection, with boundaries within a CUSUM window.
+func TestChangePointMatchesIgnitionBaseline(t *testing.T) {
+	if testing.Short() {
+		t.Skip("Skipping integration test in short mode")
+	}
+
+	ctx := context.Background()
+	chContainer := setupClickhouseContainer(t)
+	t.Cleanup(func() { chContainer.Terminate(ctx) })
+
+	conn, err := chContainer.GetClickHouseAsConn()
+	require.NoError(t, err)
+	signals, stateChanges := generateTestData()
+	insertTestSignals(t, conn, signals)
+	insertTestStateChanges(t, conn, stateChanges)
+
+	service, err := ch.NewService(config.Settings{Clickhouse: chContainer.Config()})
+	require.NoError(t, err)
+
+	cases := []segmenteval.Case{{
+		Name:    "two trips",
+		Subject: testSubject,
+		From:    baseTime.Add(-1 * time.Hour),
+		To:      baseTime.Add(2 * time.Hour),
+	}}
+	mechanisms := []model.DetectionMechanism{model.DetectionMechanismChangePointDetection, model.DetectionMechanismFrequencyAnalysis}
+	results, err := segmenteval.Run(ctx, service, cases, string(model.DetectionMechanismIgnitionDetection), mechanisms, 0.5)
+	require.NoError(t, err)
+	require.Len(t, results, 3)
+	require.Equal(t, 2, results[0].Metrics.Baseline)
+
+	require.NoError(t, segmenteval.Check(results, mechanisms[:1], 1, 1))
+	assert.LessOrEqual(t, results[1].Metrics.MaxBoundaryError, time.Minute)
+}
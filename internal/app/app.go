@@ -26,6 +26,7 @@ import (
 	"github.com/DIMO-Network/telemetry-api/internal/service/ch"
 	"github.com/DIMO-Network/telemetry-api/internal/service/credittracker"
 	"github.com/DIMO-Network/telemetry-api/internal/service/fetchapi"
+	"github.com/rs/zerolog"
 )
 
 // App is the main application for the telemetry API.
@@ -89,13 +90,14 @@ func New(settings config.Settings) (*App, error) {
 	es := graph.NewExecutableSchema(cfg)
 	dct := dtcmiddleware.NewDCT(ctClient, &costCalculator)
 
-	server := newServer(es)
-	configureGQLExtensions(server, dct, queryRec, replayLogger)
-
-	authMiddleware, err := auth.NewJWTMiddleware(settings.TokenExchangeIssuer, settings.TokenExchangeJWTKeySetURL)
+	jwtValidator, err := auth.NewJWTValidator(settings.TokenExchangeIssuer, settings.TokenExchangeJWTKeySetURL)
 	if err != nil {
-		return nil, fmt.Errorf("couldn't create JWT middleware: %w", err)
+		return nil, fmt.Errorf("couldn't create JWT validator: %w", err)
 	}
+	authMiddleware := auth.NewJWTMiddleware(jwtValidator)
+
+	server := newServer(es, auth.NewWebsocketInitFunc(jwtValidator))
+	configureGQLExtensions(server, dct, queryRec, replayLogger)
 
 	limiter, err := limits.New(settings.MaxRequestDuration)
 	if err != nil {
@@ -156,7 +158,9 @@ func New(settings config.Settings) (*App, error) {
 		MCPHandler:    mcpAuthHandler,
 		QueryRecorder: queryRec,
 		cleanup: func() {
-			// TODO add cleanup logic for closing connections
+			if err := chService.Close(); err != nil {
+				zerolog.Ctx(context.Background()).Error().Err(err).Msg("Failed to close ClickHouse service.")
+			}
 		},
 	}, nil
 }
@@ -181,11 +185,12 @@ func newVinVCServiceFromSettings(settings config.Settings) (*vc.Repository, erro
 	return vc.New(fetchapiSvc, settings), nil
 }
 
-func newServer(es graphql.ExecutableSchema) *handler.Server {
+func newServer(es graphql.ExecutableSchema, wsInit transport.WebsocketInitFunc) *handler.Server {
 	srv := handler.New(es)
 
 	srv.AddTransport(transport.Websocket{
 		KeepAlivePingInterval: 10 * time.Second,
+		InitFunc:              wsInit,
 	})
 	srv.AddTransport(transport.Options{})
 	srv.AddTransport(transport.GET{})
@@ -14,9 +14,9 @@ import (
 	"github.com/rs/zerolog"
 )
 
-// NewJWTMiddleware creates a new JWT middleware with the given issuer and contract address.
-// This middleware will validate the token and add the claim to the context.
-func NewJWTMiddleware(issuer, jwksURI string) (*jwtmiddleware.JWTMiddleware, error) {
+// NewJWTValidator creates a validator for tokens issued by the given issuer.
+// Keys are fetched from jwksURI if set, otherwise from the issuer's discovery document.
+func NewJWTValidator(issuer, jwksURI string) (*validator.Validator, error) {
 	issuerURL, err := url.Parse(issuer)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse issuer URL: %w", err)
@@ -44,13 +44,17 @@ func NewJWTMiddleware(issuer, jwksURI string) (*jwtmiddleware.JWTMiddleware, err
 	if err != nil {
 		return nil, fmt.Errorf("failed to create validator: %w", err)
 	}
+	return jwtValidator, nil
+}
 
-	middleware := jwtmiddleware.New(
+// NewJWTMiddleware creates a new JWT middleware with the given validator.
+// This middleware will validate the token and add the claim to the context.
+func NewJWTMiddleware(jwtValidator *validator.Validator) *jwtmiddleware.JWTMiddleware {
+	return jwtmiddleware.New(
 		jwtValidator.ValidateToken,
 		jwtmiddleware.WithErrorHandler(ErrorHandler),
 		jwtmiddleware.WithCredentialsOptional(true),
 	)
-	return middleware, nil
 }
 
 // AddClaimHandler is a middleware that fills in GraphQL-friendly privilege information on
@@ -0,0 +1,45 @@
+package auth
+
+import (
+	"context"
+	"strings"
+
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	jwtmiddleware "github.com/auth0/go-jwt-middleware/v2"
+	"github.com/auth0/go-jwt-middleware/v2/validator"
+)
+
+// NewWebsocketInitFunc returns a WebSocket init function that authenticates
+// the connection. Browsers cannot set headers on WebSocket upgrades, so the
+// token is read from the "Authorization" key of the connection init payload
+// and its claims are added to the context the same way CheckJWT and
+// AddClaimHandler do for HTTP requests. Connections without a token are
+// accepted; the directives reject them like unauthenticated HTTP requests.
+func NewWebsocketInitFunc(jwtValidator *validator.Validator) transport.WebsocketInitFunc {
+	return func(ctx context.Context, initPayload transport.InitPayload) (context.Context, *transport.InitPayload, error) {
+		token := initPayload.Authorization()
+		if token == "" {
+			return ctx, &initPayload, nil
+		}
+		if len(token) > len("bearer ") && strings.EqualFold(token[:len("bearer ")], "bearer ") {
+			token = token[len("bearer "):]
+		}
+
+		validToken, err := jwtValidator.ValidateToken(ctx, token)
+		if err != nil {
+			return ctx, nil, UnauthorizedError{message: "invalid token", err: err}
+		}
+		claims, ok := validToken.(*validator.ValidatedClaims)
+		if !ok {
+			return ctx, nil, UnauthorizedError{err: jwtmiddleware.ErrJWTMissing}
+		}
+		telClaim, ok := claims.CustomClaims.(*TelemetryClaim)
+		if !ok {
+			return ctx, nil, UnauthorizedError{err: jwtmiddleware.ErrJWTMissing}
+		}
+
+		ctx = context.WithValue(ctx, jwtmiddleware.ContextKey{}, claims)
+		ctx = context.WithValue(ctx, TelemetryClaimContextKey{}, telClaim)
+		return ctx, &initPayload, nil
+	}
+}
@@ -21,7 +21,23 @@ type Settings struct {
 	CreditTrackerEndpoint     string          `yaml:"CREDIT_TRACKER_ENDPOINT"`
 	StorageNodeDevLicense     common.Address  `yaml:"STORAGE_NODE_DEV_LICENSE"`
 	VINDataVersion            string          `yaml:"VIN_DATA_VERSION"`
+	// SubscriptionPollInterval is how often signal subscriptions poll for
+	// new data, e.g. "5s". Defaults to 5s when empty.
+	SubscriptionPollInterval string `yaml:"SUBSCRIPTION_POLL_INTERVAL"`
+	// EventSubscriptionLookback is how far before each poll event subscriptions
+	// look for events stored late, e.g. "10m". Defaults to 10m when empty.
+	EventSubscriptionLookback string `yaml:"EVENT_SUBSCRIPTION_LOOKBACK"`
 	// RecordedDevelopers is a comma-separated list of developer license
 	// addresses whose queries are logged in full for later replay.
 	RecordedDevelopers string `yaml:"RECORDED_DEVELOPERS"`
+	// DriverScoreWeights is a comma-separated list of component=weight pairs
+	// overriding the default driver score weights, e.g. "speeding=2,nightDriving=0".
+	DriverScoreWeights string `yaml:"DRIVER_SCORE_WEIGHTS"`
+	// DriverScoreSpeedLimitKph is the speed above which driving counts as
+	// speeding in the driver score. Defaults to 120 when zero.
+	DriverScoreSpeedLimitKph float64 `yaml:"DRIVER_SCORE_SPEED_LIMIT_KPH"`
+	// SegmentStoreEnabled stores detected, closed segments in ClickHouse so they
+	// aren't detected again, and raises the segment date range limit to a year.
+	// The store tables are created by running the service with -migrate.
+	SegmentStoreEnabled bool `yaml:"SEGMENT_STORE_ENABLED"`
 }
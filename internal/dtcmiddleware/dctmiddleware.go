@@ -17,6 +17,7 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/rs/zerolog"
 	"github.com/segmentio/ksuid"
+	"github.com/vektah/gqlparser/v2/ast"
 	"github.com/vektah/gqlparser/v2/gqlerror"
 	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/status"
@@ -100,20 +101,19 @@ func (d DCT) InterceptResponse(
 		return next(ctx)
 	}
 
-	// Start timing the DCT request
-	dctTimer := prometheus.NewTimer(DCTRequestLatency.WithLabelValues("deduct"))
-	// Deduct the credits
-	referenceID := ksuid.New().String()
-
-	// TODO: The current credit calculator is a prototype and not accurate
-	// and causes extremely high costs causing deduct to fail. so we set it to 1 for now.
-	credits := uint64(1)
-	err := d.Tracker.DeductCredits(ctx, referenceID, developerID, tokenID, credits)
-	dctTimer.ObserveDuration()
+	// Subscriptions produce one response per update. Each update is charged
+	// once it exists; the final nil response that closes the stream is free.
+	if opCtx.Operation.Operation == ast.Subscription {
+		response := next(ctx)
+		if response == nil {
+			return nil
+		}
+		_, _ = d.deductCredits(ctx, developerID, tokenID)
+		return response
+	}
 
+	referenceID, err := d.deductCredits(ctx, developerID, tokenID)
 	if err != nil {
-		gqlError := processDCTErrorToGraphqlError(ctx, err)
-		zerolog.Ctx(ctx).Warn().Err(gqlError.Err).Msg("Failed to deduct credits")
 		// return &graphql.Response{
 		// 	Errors: gqlerror.List{gqlError},
 		// }
@@ -139,6 +139,28 @@ func (d DCT) InterceptResponse(
 	return response
 }
 
+// deductCredits charges the developer for one request and returns the
+// reference ID needed to refund it.
+func (d DCT) deductCredits(ctx context.Context, developerID string, tokenID *big.Int) (string, error) {
+	// Start timing the DCT request
+	dctTimer := prometheus.NewTimer(DCTRequestLatency.WithLabelValues("deduct"))
+	// Deduct the credits
+	referenceID := ksuid.New().String()
+
+	// TODO: The current credit calculator is a prototype and not accurate
+	// and causes extremely high costs causing deduct to fail. so we set it to 1 for now.
+	credits := uint64(1)
+	err := d.Tracker.DeductCredits(ctx, referenceID, developerID, tokenID, credits)
+	dctTimer.ObserveDuration()
+
+	if err != nil {
+		gqlError := processDCTErrorToGraphqlError(ctx, err)
+		zerolog.Ctx(ctx).Warn().Err(gqlError.Err).Msg("Failed to deduct credits")
+		return "", err
+	}
+	return referenceID, nil
+}
+
 // processDCTError extracts and processes error details from a gRPC error
 func processDCTErrorToGraphqlError(ctx context.Context, err error) *gqlerror.Error {
 	st, ok := status.FromError(err)
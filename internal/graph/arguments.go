@@ -8,6 +8,7 @@ import (
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/DIMO-Network/model-garage/pkg/vss"
 	"github.com/DIMO-Network/telemetry-api/internal/graph/model"
+	"github.com/DIMO-Network/telemetry-api/internal/repositories"
 )
 
 // aggregationArgsFromContext creates an aggregated signals arguments from the context and the provided arguments.
@@ -115,6 +116,52 @@ func latestArgsFromContext(ctx context.Context, tokenID int, filter *model.Signa
 	return &latestArgs, nil
 }
 
+// eventLocationPrecision returns the precision at which the events resolved
+// in ctx carry a location. It is LocationHidden if the location field is not
+// selected, so the location join is only done when needed. The field is looked
+// up among the selected fields, or among the fields selected under events for
+// an event page. Event queries require VEHICLE_ALL_TIME_LOCATION, so selected
+// locations are exact.
+func eventLocationPrecision(ctx context.Context) repositories.LocationPrecision {
+	if !selectsEventLocation(ctx, graphql.CollectFieldsCtx(ctx, nil)) {
+		return repositories.LocationHidden
+	}
+	return repositories.LocationExact
+}
+
+// segmentOptions returns the options for the segments or days resolved in
+// ctx, so locations are only fetched when the route or distance fields are
+// selected.
+func segmentOptions(ctx context.Context) repositories.SegmentOptions {
+	var opts repositories.SegmentOptions
+	for _, field := range graphql.CollectFieldsCtx(ctx, nil) {
+		switch field.Name {
+		case "route":
+			opts.WithRoute = true
+		case "distanceKm", "distanceSource":
+			opts.WithDistance = true
+		}
+	}
+	return opts
+}
+
+// selectsEventLocation reports whether the location field of an event is
+// selected in fields, directly or under an events field.
+func selectsEventLocation(ctx context.Context, fields []graphql.CollectedField) bool {
+	for _, field := range fields {
+		switch field.Name {
+		case "location":
+			return true
+		case "events":
+			subFields := graphql.CollectFields(graphql.GetOperationContext(ctx), field.Selections, nil)
+			if selectsEventLocation(ctx, subFields) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 // getIntervalMicroseconds parses the interval string and returns the number
 // of microseconds the interval contains.
 //
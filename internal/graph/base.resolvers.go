@@ -34,8 +34,8 @@ func (r *queryResolver) SignalsLatest(ctx context.Context, tokenID int, filter *
 }
 
 // AvailableSignals is the resolver for the AvailableSignals field.
-func (r *queryResolver) AvailableSignals(ctx context.Context, tokenID int, filter *model.SignalFilter) ([]string, error) {
-	return r.BaseRepo.GetAvailableSignals(ctx, uint32(tokenID), filter)
+func (r *queryResolver) AvailableSignals(ctx context.Context, tokenID int, filter *model.SignalFilter, from *time.Time, to *time.Time) ([]string, error) {
+	return r.BaseRepo.GetAvailableSignals(ctx, uint32(tokenID), from, to, filter)
 }
 
 // SignalsSnapshot is the resolver for the signalsSnapshot field.
@@ -65,8 +65,8 @@ func (r *queryResolver) SignalsSnapshot(ctx context.Context, tokenID int, filter
 }
 
 // DataSummary is the resolver for the dataSummary field.
-func (r *queryResolver) DataSummary(ctx context.Context, tokenID int, filter *model.SignalFilter) (*model.DataSummary, error) {
-	return r.BaseRepo.GetDataSummary(ctx, uint32(tokenID), filter)
+func (r *queryResolver) DataSummary(ctx context.Context, tokenID int, filter *model.SignalFilter, from *time.Time, to *time.Time) (*model.DataSummary, error) {
+	return r.BaseRepo.GetDataSummary(ctx, uint32(tokenID), from, to, filter)
 }
 
 // CurrentLocationApproximateCoordinates is the resolver for the currentLocationApproximateCoordinates field on SignalAggregations.
@@ -83,6 +83,15 @@ func (r *signalAggregationsResolver) CurrentLocationApproximateCoordinates(ctx c
 	return &model.Location{Latitude: latLng.Lat, Longitude: latLng.Lng, Hdop: vl.HDOP}, nil
 }
 
+// SignalsLatest is the resolver for the signalsLatest field.
+func (r *subscriptionResolver) SignalsLatest(ctx context.Context, tokenID int, filter *model.SignalFilter) (<-chan *model.SignalCollection, error) {
+	latestArgs, err := latestArgsFromContext(ctx, tokenID, filter)
+	if err != nil {
+		return nil, err
+	}
+	return r.BaseRepo.SubscribeSignalLatest(ctx, latestArgs)
+}
+
 // Query returns QueryResolver implementation.
 func (r *Resolver) Query() QueryResolver { return &queryResolver{r} }
 
@@ -91,5 +100,9 @@ func (r *Resolver) SignalAggregations() SignalAggregationsResolver {
 	return &signalAggregationsResolver{r}
 }
 
+// Subscription returns SubscriptionResolver implementation.
+func (r *Resolver) Subscription() SubscriptionResolver { return &subscriptionResolver{r} }
+
 type queryResolver struct{ *Resolver }
 type signalAggregationsResolver struct{ *Resolver }
+type subscriptionResolver struct{ *Resolver }
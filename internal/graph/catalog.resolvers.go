@@ -0,0 +1,17 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver
+// implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.89
+
+import (
+	"context"
+
+	"github.com/DIMO-Network/telemetry-api/internal/graph/model"
+)
+
+// SignalDefinitions is the resolver for the signalDefinitions field.
+func (r *queryResolver) SignalDefinitions(ctx context.Context) ([]*model.SignalDefinition, error) {
+	return r.BaseRepo.GetSignalDefinitions(), nil
+}
@@ -0,0 +1,37 @@
+package graph
+
+import (
+	"testing"
+
+	"github.com/99designs/gqlgen/client"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	"github.com/DIMO-Network/telemetry-api/internal/config"
+	"github.com/DIMO-Network/telemetry-api/internal/repositories"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSignalDefinitionsPrivileges(t *testing.T) {
+	// The catalog is built at startup and doesn't query ClickHouse.
+	repo, err := repositories.NewRepository(nil, config.Settings{})
+	require.NoError(t, err)
+	srv := handler.New(NewExecutableSchema(Config{Resolvers: &Resolver{BaseRepo: repo}}))
+	srv.AddTransport(transport.POST{})
+	c := client.New(srv)
+
+	var resp struct {
+		SignalDefinitions []struct {
+			Name       string
+			Privileges []string
+		}
+	}
+	c.MustPost(`{ signalDefinitions { name privileges } }`, &resp)
+
+	privileges := make(map[string][]string, len(resp.SignalDefinitions))
+	for _, def := range resp.SignalDefinitions {
+		require.NotEmpty(t, def.Privileges, def.Name)
+		privileges[def.Name] = def.Privileges
+	}
+	require.Equal(t, []string{"VEHICLE_NON_LOCATION_DATA"}, privileges["speed"])
+	require.Equal(t, []string{"VEHICLE_ALL_TIME_LOCATION"}, privileges["currentLocationCoordinates"])
+}
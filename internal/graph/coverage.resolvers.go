@@ -0,0 +1,23 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver
+// implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.89
+
+import (
+	"context"
+	"time"
+
+	"github.com/DIMO-Network/telemetry-api/internal/graph/model"
+)
+
+// DataCoverage is the resolver for the dataCoverage field.
+func (r *queryResolver) DataCoverage(ctx context.Context, tokenID int, from time.Time, to time.Time, interval string, names []string, minGapSeconds *int, filter *model.SignalFilter) ([]*model.SignalCoverage, error) {
+	return r.BaseRepo.GetDataCoverage(ctx, tokenID, from, to, interval, names, minGapSeconds, filter)
+}
+
+// SamplingStats is the resolver for the samplingStats field.
+func (r *queryResolver) SamplingStats(ctx context.Context, tokenID int, from time.Time, to time.Time, names []string, filter *model.SignalFilter) ([]*model.SignalSamplingStats, error) {
+	return r.BaseRepo.GetSamplingStats(ctx, tokenID, from, to, names, filter)
+}
@@ -13,6 +13,25 @@ import (
 )
 
 // Events is the resolver for the events field.
-func (r *queryResolver) Events(ctx context.Context, tokenID int, from time.Time, to time.Time, filter *model.EventFilter) ([]*model.Event, error) {
-	return r.BaseRepo.GetEvents(ctx, tokenID, from, to, filter)
+func (r *queryResolver) Events(ctx context.Context, tokenID int, from time.Time, to time.Time, filter *model.EventFilter, derivedEvents *model.DerivedEventsConfig, limit *int) ([]*model.Event, error) {
+	return r.BaseRepo.GetEvents(ctx, tokenID, from, to, filter, derivedEvents, limit, eventLocationPrecision(ctx))
+}
+
+// EventsPage is the resolver for the eventsPage field.
+func (r *queryResolver) EventsPage(ctx context.Context, tokenID int, from time.Time, to time.Time, filter *model.EventFilter, limit *int, after *time.Time) (*model.EventPage, error) {
+	return r.BaseRepo.GetEventsPage(ctx, tokenID, from, to, filter, limit, after, eventLocationPrecision(ctx))
+}
+
+// EventsAggregated is the resolver for the eventsAggregated field.
+func (r *queryResolver) EventsAggregated(ctx context.Context, tokenID int, from time.Time, to time.Time, interval string, filter *model.EventFilter) ([]*model.EventAggregation, error) {
+	intervalMicro, err := getIntervalMicroseconds(interval)
+	if err != nil {
+		return nil, err
+	}
+	return r.BaseRepo.GetEventsAggregated(ctx, tokenID, from, to, intervalMicro, filter)
+}
+
+// Events is the resolver for the events field.
+func (r *subscriptionResolver) Events(ctx context.Context, tokenID int, filter *model.EventFilter) (<-chan *model.Event, error) {
+	return r.BaseRepo.SubscribeEvents(ctx, tokenID, filter, eventLocationPrecision(ctx))
 }
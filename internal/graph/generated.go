@@ -32,6 +32,7 @@ type Config = graphql.Config[ResolverRoot, DirectiveRoot, ComplexityRoot]
 type ResolverRoot interface {
 	Query() QueryResolver
 	SignalAggregations() SignalAggregationsResolver
+	Subscription() SubscriptionResolver
 }
 
 type DirectiveRoot struct {
@@ -57,13 +58,38 @@ type ComplexityRoot struct {
 		VehicleTokenID func(childComplexity int) int
 	}
 
+	ChargingSession struct {
+		AveragePowerKw     func(childComplexity int) int
+		BatteryCapacityKwh func(childComplexity int) int
+		ChargerType        func(childComplexity int) int
+		EnergyAddedKwh     func(childComplexity int) int
+		Location           func(childComplexity int) int
+		PeakPowerKw        func(childComplexity int) int
+		SocEnd             func(childComplexity int) int
+		SocStart           func(childComplexity int) int
+	}
+
+	CoverageBucket struct {
+		Count     func(childComplexity int) int
+		Timestamp func(childComplexity int) int
+	}
+
 	DailyActivity struct {
-		Duration     func(childComplexity int) int
-		End          func(childComplexity int) int
-		EventCounts  func(childComplexity int) int
-		SegmentCount func(childComplexity int) int
-		Signals      func(childComplexity int) int
-		Start        func(childComplexity int) int
+		DistanceKm     func(childComplexity int) int
+		DistanceSource func(childComplexity int) int
+		Duration       func(childComplexity int) int
+		End            func(childComplexity int) int
+		EventCounts    func(childComplexity int) int
+		Score          func(childComplexity int) int
+		SegmentCount   func(childComplexity int) int
+		Signals        func(childComplexity int) int
+		Start          func(childComplexity int) int
+	}
+
+	DataGap struct {
+		Duration func(childComplexity int) int
+		End      func(childComplexity int) int
+		Start    func(childComplexity int) int
 	}
 
 	DataSummary struct {
@@ -75,14 +101,31 @@ type ComplexityRoot struct {
 		SignalDataSummary func(childComplexity int) int
 	}
 
+	DriverScore struct {
+		HarshAcceleration func(childComplexity int) int
+		HarshBraking      func(childComplexity int) int
+		HarshCornering    func(childComplexity int) int
+		NightDriving      func(childComplexity int) int
+		Overall           func(childComplexity int) int
+		Speeding          func(childComplexity int) int
+	}
+
 	Event struct {
 		DurationNs func(childComplexity int) int
+		Location   func(childComplexity int) int
 		Metadata   func(childComplexity int) int
 		Name       func(childComplexity int) int
 		Source     func(childComplexity int) int
 		Timestamp  func(childComplexity int) int
 	}
 
+	EventAggregation struct {
+		Count      func(childComplexity int) int
+		DurationNs func(childComplexity int) int
+		Name       func(childComplexity int) int
+		Timestamp  func(childComplexity int) int
+	}
+
 	EventCount struct {
 		Count func(childComplexity int) int
 		Name  func(childComplexity int) int
@@ -95,6 +138,18 @@ type ComplexityRoot struct {
 		NumberOfEvents func(childComplexity int) int
 	}
 
+	EventPage struct {
+		Events     func(childComplexity int) int
+		HasMore    func(childComplexity int) int
+		NextCursor func(childComplexity int) int
+	}
+
+	FuelDropDetails struct {
+		EndLevel   func(childComplexity int) int
+		LitersLost func(childComplexity int) int
+		StartLevel func(childComplexity int) int
+	}
+
 	LatestSignal struct {
 		Name          func(childComplexity int) int
 		Timestamp     func(childComplexity int) int
@@ -110,26 +165,61 @@ type ComplexityRoot struct {
 	}
 
 	Query struct {
-		Attestations     func(childComplexity int, tokenID *int, subject *string, filter *model.AttestationFilter) int
-		AvailableSignals func(childComplexity int, tokenID int, filter *model.SignalFilter) int
-		DailyActivity    func(childComplexity int, tokenID int, from time.Time, to time.Time, mechanism model.DetectionMechanism, config *model.SegmentConfig, signalRequests []*model.SegmentSignalRequest, eventRequests []*model.SegmentEventRequest, timezone *string) int
-		DataSummary      func(childComplexity int, tokenID int, filter *model.SignalFilter) int
-		Events           func(childComplexity int, tokenID int, from time.Time, to time.Time, filter *model.EventFilter) int
-		Segments         func(childComplexity int, tokenID int, from time.Time, to time.Time, mechanism model.DetectionMechanism, config *model.SegmentConfig, signalRequests []*model.SegmentSignalRequest, eventRequests []*model.SegmentEventRequest, limit *int, after *time.Time) int
-		Signals          func(childComplexity int, tokenID int, interval string, from time.Time, to time.Time, filter *model.SignalFilter) int
-		SignalsLatest    func(childComplexity int, tokenID int, filter *model.SignalFilter) int
-		SignalsSnapshot  func(childComplexity int, tokenID int, filter *model.SignalFilter) int
-		VinVCLatest      func(childComplexity int, tokenID int) int
+		Attestations      func(childComplexity int, tokenID *int, subject *string, filter *model.AttestationFilter) int
+		AvailableSignals  func(childComplexity int, tokenID int, filter *model.SignalFilter, from *time.Time, to *time.Time) int
+		DailyActivity     func(childComplexity int, tokenID int, from time.Time, to time.Time, mechanism model.DetectionMechanism, config *model.SegmentConfig, signalRequests []*model.SegmentSignalRequest, eventRequests []*model.SegmentEventRequest, timezone *string) int
+		DataCoverage      func(childComplexity int, tokenID int, from time.Time, to time.Time, interval string, names []string, minGapSeconds *int, filter *model.SignalFilter) int
+		DataSummary       func(childComplexity int, tokenID int, filter *model.SignalFilter, from *time.Time, to *time.Time) int
+		Events            func(childComplexity int, tokenID int, from time.Time, to time.Time, filter *model.EventFilter, derivedEvents *model.DerivedEventsConfig, limit *int) int
+		EventsAggregated  func(childComplexity int, tokenID int, from time.Time, to time.Time, interval string, filter *model.EventFilter) int
+		EventsPage        func(childComplexity int, tokenID int, from time.Time, to time.Time, filter *model.EventFilter, limit *int, after *time.Time) int
+		SamplingStats     func(childComplexity int, tokenID int, from time.Time, to time.Time, names []string, filter *model.SignalFilter) int
+		Segment           func(childComplexity int, tokenID int, id string, mechanism model.DetectionMechanism, config *model.SegmentConfig, signalRequests []*model.SegmentSignalRequest, eventRequests []*model.SegmentEventRequest) int
+		Segments          func(childComplexity int, tokenID int, from time.Time, to time.Time, mechanism model.DetectionMechanism, config *model.SegmentConfig, signalRequests []*model.SegmentSignalRequest, eventRequests []*model.SegmentEventRequest, limit *int, after *time.Time) int
+		SignalDefinitions func(childComplexity int) int
+		Signals           func(childComplexity int, tokenID int, interval string, from time.Time, to time.Time, filter *model.SignalFilter) int
+		SignalsLatest     func(childComplexity int, tokenID int, filter *model.SignalFilter) int
+		SignalsSnapshot   func(childComplexity int, tokenID int, filter *model.SignalFilter) int
+		VinVCLatest       func(childComplexity int, tokenID int) int
+	}
+
+	RefuelDetails struct {
+		EstimatedCost      func(childComplexity int) int
+		LitersAdded        func(childComplexity int) int
+		PeakLevel          func(childComplexity int) int
+		TankCapacityLiters func(childComplexity int) int
+		TroughLevel        func(childComplexity int) int
 	}
 
 	Segment struct {
+		Charging           func(childComplexity int) int
+		DistanceKm         func(childComplexity int) int
+		DistanceSource     func(childComplexity int) int
 		Duration           func(childComplexity int) int
 		End                func(childComplexity int) int
 		EventCounts        func(childComplexity int) int
+		FuelDrop           func(childComplexity int) int
+		ID                 func(childComplexity int) int
 		IsOngoing          func(childComplexity int) int
+		Mechanism          func(childComplexity int) int
+		Refuel             func(childComplexity int) int
+		Route              func(childComplexity int) int
+		Score              func(childComplexity int) int
 		Signals            func(childComplexity int) int
 		Start              func(childComplexity int) int
 		StartedBeforeRange func(childComplexity int) int
+		Stop               func(childComplexity int) int
+	}
+
+	SegmentRoute struct {
+		Format     func(childComplexity int) int
+		PointCount func(childComplexity int) int
+		Value      func(childComplexity int) int
+	}
+
+	SegmentStop struct {
+		Centroid   func(childComplexity int) int
+		IgnitionOn func(childComplexity int) int
 	}
 
 	SignalAggregationValue struct {
@@ -380,6 +470,13 @@ type ComplexityRoot struct {
 		Speed                                                     func(childComplexity int) int
 	}
 
+	SignalCoverage struct {
+		Buckets    func(childComplexity int) int
+		Gaps       func(childComplexity int) int
+		Name       func(childComplexity int) int
+		TotalCount func(childComplexity int) int
+	}
+
 	SignalDataSummary struct {
 		FirstSeen       func(childComplexity int) int
 		LastSeen        func(childComplexity int) int
@@ -387,6 +484,18 @@ type ComplexityRoot struct {
 		NumberOfSignals func(childComplexity int) int
 	}
 
+	SignalDefinition struct {
+		Aggregations func(childComplexity int) int
+		Description  func(childComplexity int) int
+		Max          func(childComplexity int) int
+		Min          func(childComplexity int) int
+		Name         func(childComplexity int) int
+		Privileges   func(childComplexity int) int
+		Unit         func(childComplexity int) int
+		ValueType    func(childComplexity int) int
+		VssPath      func(childComplexity int) int
+	}
+
 	SignalFloat struct {
 		Timestamp func(childComplexity int) int
 		Value     func(childComplexity int) int
@@ -397,6 +506,17 @@ type ComplexityRoot struct {
 		Value     func(childComplexity int) int
 	}
 
+	SignalSamplingStats struct {
+		ActiveHours           func(childComplexity int) int
+		MaxIntervalSeconds    func(childComplexity int) int
+		MedianIntervalSeconds func(childComplexity int) int
+		Name                  func(childComplexity int) int
+		P95IntervalSeconds    func(childComplexity int) int
+		SampleCount           func(childComplexity int) int
+		SamplesPerActiveHour  func(childComplexity int) int
+		Source                func(childComplexity int) int
+	}
+
 	SignalString struct {
 		Timestamp func(childComplexity int) int
 		Value     func(childComplexity int) int
@@ -407,6 +527,11 @@ type ComplexityRoot struct {
 		Signals  func(childComplexity int) int
 	}
 
+	Subscription struct {
+		Events        func(childComplexity int, tokenID int, filter *model.EventFilter) int
+		SignalsLatest func(childComplexity int, tokenID int, filter *model.SignalFilter) int
+	}
+
 	VINVC struct {
 		CountryCode            func(childComplexity int) int
 		RawVc                  func(childComplexity int) int
@@ -423,12 +548,18 @@ type ComplexityRoot struct {
 type QueryResolver interface {
 	Signals(ctx context.Context, tokenID int, interval string, from time.Time, to time.Time, filter *model.SignalFilter) ([]*model.SignalAggregations, error)
 	SignalsLatest(ctx context.Context, tokenID int, filter *model.SignalFilter) (*model.SignalCollection, error)
-	AvailableSignals(ctx context.Context, tokenID int, filter *model.SignalFilter) ([]string, error)
+	AvailableSignals(ctx context.Context, tokenID int, filter *model.SignalFilter, from *time.Time, to *time.Time) ([]string, error)
 	SignalsSnapshot(ctx context.Context, tokenID int, filter *model.SignalFilter) (*model.SignalsSnapshotResponse, error)
-	DataSummary(ctx context.Context, tokenID int, filter *model.SignalFilter) (*model.DataSummary, error)
+	DataSummary(ctx context.Context, tokenID int, filter *model.SignalFilter, from *time.Time, to *time.Time) (*model.DataSummary, error)
 	Attestations(ctx context.Context, tokenID *int, subject *string, filter *model.AttestationFilter) ([]*model.Attestation, error)
-	Events(ctx context.Context, tokenID int, from time.Time, to time.Time, filter *model.EventFilter) ([]*model.Event, error)
+	SignalDefinitions(ctx context.Context) ([]*model.SignalDefinition, error)
+	DataCoverage(ctx context.Context, tokenID int, from time.Time, to time.Time, interval string, names []string, minGapSeconds *int, filter *model.SignalFilter) ([]*model.SignalCoverage, error)
+	SamplingStats(ctx context.Context, tokenID int, from time.Time, to time.Time, names []string, filter *model.SignalFilter) ([]*model.SignalSamplingStats, error)
+	Events(ctx context.Context, tokenID int, from time.Time, to time.Time, filter *model.EventFilter, derivedEvents *model.DerivedEventsConfig, limit *int) ([]*model.Event, error)
+	EventsPage(ctx context.Context, tokenID int, from time.Time, to time.Time, filter *model.EventFilter, limit *int, after *time.Time) (*model.EventPage, error)
+	EventsAggregated(ctx context.Context, tokenID int, from time.Time, to time.Time, interval string, filter *model.EventFilter) ([]*model.EventAggregation, error)
 	Segments(ctx context.Context, tokenID int, from time.Time, to time.Time, mechanism model.DetectionMechanism, config *model.SegmentConfig, signalRequests []*model.SegmentSignalRequest, eventRequests []*model.SegmentEventRequest, limit *int, after *time.Time) ([]*model.Segment, error)
+	Segment(ctx context.Context, tokenID int, id string, mechanism model.DetectionMechanism, config *model.SegmentConfig, signalRequests []*model.SegmentSignalRequest, eventRequests []*model.SegmentEventRequest) (*model.Segment, error)
 	DailyActivity(ctx context.Context, tokenID int, from time.Time, to time.Time, mechanism model.DetectionMechanism, config *model.SegmentConfig, signalRequests []*model.SegmentSignalRequest, eventRequests []*model.SegmentEventRequest, timezone *string) ([]*model.DailyActivity, error)
 	VinVCLatest(ctx context.Context, tokenID int) (*model.Vinvc, error)
 }
@@ -551,6 +682,10 @@ type SignalAggregationsResolver interface {
 	ServiceTimeToService(ctx context.Context, obj *model.SignalAggregations, agg model.FloatAggregation, filter *model.SignalFloatFilter) (*float64, error)
 	Speed(ctx context.Context, obj *model.SignalAggregations, agg model.FloatAggregation, filter *model.SignalFloatFilter) (*float64, error)
 }
+type SubscriptionResolver interface {
+	SignalsLatest(ctx context.Context, tokenID int, filter *model.SignalFilter) (<-chan *model.SignalCollection, error)
+	Events(ctx context.Context, tokenID int, filter *model.EventFilter) (<-chan *model.Event, error)
+}
 
 type executableSchema graphql.ExecutableSchemaState[ResolverRoot, DirectiveRoot, ComplexityRoot]
 
@@ -627,6 +762,80 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.Attestation.VehicleTokenID(childComplexity), true
 
+	case "ChargingSession.averagePowerKw":
+		if e.ComplexityRoot.ChargingSession.AveragePowerKw == nil {
+			break
+		}
+
+		return e.ComplexityRoot.ChargingSession.AveragePowerKw(childComplexity), true
+	case "ChargingSession.batteryCapacityKwh":
+		if e.ComplexityRoot.ChargingSession.BatteryCapacityKwh == nil {
+			break
+		}
+
+		return e.ComplexityRoot.ChargingSession.BatteryCapacityKwh(childComplexity), true
+	case "ChargingSession.chargerType":
+		if e.ComplexityRoot.ChargingSession.ChargerType == nil {
+			break
+		}
+
+		return e.ComplexityRoot.ChargingSession.ChargerType(childComplexity), true
+	case "ChargingSession.energyAddedKwh":
+		if e.ComplexityRoot.ChargingSession.EnergyAddedKwh == nil {
+			break
+		}
+
+		return e.ComplexityRoot.ChargingSession.EnergyAddedKwh(childComplexity), true
+	case "ChargingSession.location":
+		if e.ComplexityRoot.ChargingSession.Location == nil {
+			break
+		}
+
+		return e.ComplexityRoot.ChargingSession.Location(childComplexity), true
+	case "ChargingSession.peakPowerKw":
+		if e.ComplexityRoot.ChargingSession.PeakPowerKw == nil {
+			break
+		}
+
+		return e.ComplexityRoot.ChargingSession.PeakPowerKw(childComplexity), true
+	case "ChargingSession.socEnd":
+		if e.ComplexityRoot.ChargingSession.SocEnd == nil {
+			break
+		}
+
+		return e.ComplexityRoot.ChargingSession.SocEnd(childComplexity), true
+	case "ChargingSession.socStart":
+		if e.ComplexityRoot.ChargingSession.SocStart == nil {
+			break
+		}
+
+		return e.ComplexityRoot.ChargingSession.SocStart(childComplexity), true
+
+	case "CoverageBucket.count":
+		if e.ComplexityRoot.CoverageBucket.Count == nil {
+			break
+		}
+
+		return e.ComplexityRoot.CoverageBucket.Count(childComplexity), true
+	case "CoverageBucket.timestamp":
+		if e.ComplexityRoot.CoverageBucket.Timestamp == nil {
+			break
+		}
+
+		return e.ComplexityRoot.CoverageBucket.Timestamp(childComplexity), true
+
+	case "DailyActivity.distanceKm":
+		if e.ComplexityRoot.DailyActivity.DistanceKm == nil {
+			break
+		}
+
+		return e.ComplexityRoot.DailyActivity.DistanceKm(childComplexity), true
+	case "DailyActivity.distanceSource":
+		if e.ComplexityRoot.DailyActivity.DistanceSource == nil {
+			break
+		}
+
+		return e.ComplexityRoot.DailyActivity.DistanceSource(childComplexity), true
 	case "DailyActivity.duration":
 		if e.ComplexityRoot.DailyActivity.Duration == nil {
 			break
@@ -645,6 +854,12 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.ComplexityRoot.DailyActivity.EventCounts(childComplexity), true
+	case "DailyActivity.score":
+		if e.ComplexityRoot.DailyActivity.Score == nil {
+			break
+		}
+
+		return e.ComplexityRoot.DailyActivity.Score(childComplexity), true
 	case "DailyActivity.segmentCount":
 		if e.ComplexityRoot.DailyActivity.SegmentCount == nil {
 			break
@@ -664,6 +879,25 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.DailyActivity.Start(childComplexity), true
 
+	case "DataGap.duration":
+		if e.ComplexityRoot.DataGap.Duration == nil {
+			break
+		}
+
+		return e.ComplexityRoot.DataGap.Duration(childComplexity), true
+	case "DataGap.end":
+		if e.ComplexityRoot.DataGap.End == nil {
+			break
+		}
+
+		return e.ComplexityRoot.DataGap.End(childComplexity), true
+	case "DataGap.start":
+		if e.ComplexityRoot.DataGap.Start == nil {
+			break
+		}
+
+		return e.ComplexityRoot.DataGap.Start(childComplexity), true
+
 	case "DataSummary.availableSignals":
 		if e.ComplexityRoot.DataSummary.AvailableSignals == nil {
 			break
@@ -701,12 +935,55 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.DataSummary.SignalDataSummary(childComplexity), true
 
+	case "DriverScore.harshAcceleration":
+		if e.ComplexityRoot.DriverScore.HarshAcceleration == nil {
+			break
+		}
+
+		return e.ComplexityRoot.DriverScore.HarshAcceleration(childComplexity), true
+	case "DriverScore.harshBraking":
+		if e.ComplexityRoot.DriverScore.HarshBraking == nil {
+			break
+		}
+
+		return e.ComplexityRoot.DriverScore.HarshBraking(childComplexity), true
+	case "DriverScore.harshCornering":
+		if e.ComplexityRoot.DriverScore.HarshCornering == nil {
+			break
+		}
+
+		return e.ComplexityRoot.DriverScore.HarshCornering(childComplexity), true
+	case "DriverScore.nightDriving":
+		if e.ComplexityRoot.DriverScore.NightDriving == nil {
+			break
+		}
+
+		return e.ComplexityRoot.DriverScore.NightDriving(childComplexity), true
+	case "DriverScore.overall":
+		if e.ComplexityRoot.DriverScore.Overall == nil {
+			break
+		}
+
+		return e.ComplexityRoot.DriverScore.Overall(childComplexity), true
+	case "DriverScore.speeding":
+		if e.ComplexityRoot.DriverScore.Speeding == nil {
+			break
+		}
+
+		return e.ComplexityRoot.DriverScore.Speeding(childComplexity), true
+
 	case "Event.durationNs":
 		if e.ComplexityRoot.Event.DurationNs == nil {
 			break
 		}
 
 		return e.ComplexityRoot.Event.DurationNs(childComplexity), true
+	case "Event.location":
+		if e.ComplexityRoot.Event.Location == nil {
+			break
+		}
+
+		return e.ComplexityRoot.Event.Location(childComplexity), true
 	case "Event.metadata":
 		if e.ComplexityRoot.Event.Metadata == nil {
 			break
@@ -732,6 +1009,31 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.Event.Timestamp(childComplexity), true
 
+	case "EventAggregation.count":
+		if e.ComplexityRoot.EventAggregation.Count == nil {
+			break
+		}
+
+		return e.ComplexityRoot.EventAggregation.Count(childComplexity), true
+	case "EventAggregation.durationNs":
+		if e.ComplexityRoot.EventAggregation.DurationNs == nil {
+			break
+		}
+
+		return e.ComplexityRoot.EventAggregation.DurationNs(childComplexity), true
+	case "EventAggregation.name":
+		if e.ComplexityRoot.EventAggregation.Name == nil {
+			break
+		}
+
+		return e.ComplexityRoot.EventAggregation.Name(childComplexity), true
+	case "EventAggregation.timestamp":
+		if e.ComplexityRoot.EventAggregation.Timestamp == nil {
+			break
+		}
+
+		return e.ComplexityRoot.EventAggregation.Timestamp(childComplexity), true
+
 	case "EventCount.count":
 		if e.ComplexityRoot.EventCount.Count == nil {
 			break
@@ -770,6 +1072,44 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.EventDataSummary.NumberOfEvents(childComplexity), true
 
+	case "EventPage.events":
+		if e.ComplexityRoot.EventPage.Events == nil {
+			break
+		}
+
+		return e.ComplexityRoot.EventPage.Events(childComplexity), true
+	case "EventPage.hasMore":
+		if e.ComplexityRoot.EventPage.HasMore == nil {
+			break
+		}
+
+		return e.ComplexityRoot.EventPage.HasMore(childComplexity), true
+	case "EventPage.nextCursor":
+		if e.ComplexityRoot.EventPage.NextCursor == nil {
+			break
+		}
+
+		return e.ComplexityRoot.EventPage.NextCursor(childComplexity), true
+
+	case "FuelDropDetails.endLevel":
+		if e.ComplexityRoot.FuelDropDetails.EndLevel == nil {
+			break
+		}
+
+		return e.ComplexityRoot.FuelDropDetails.EndLevel(childComplexity), true
+	case "FuelDropDetails.litersLost":
+		if e.ComplexityRoot.FuelDropDetails.LitersLost == nil {
+			break
+		}
+
+		return e.ComplexityRoot.FuelDropDetails.LitersLost(childComplexity), true
+	case "FuelDropDetails.startLevel":
+		if e.ComplexityRoot.FuelDropDetails.StartLevel == nil {
+			break
+		}
+
+		return e.ComplexityRoot.FuelDropDetails.StartLevel(childComplexity), true
+
 	case "LatestSignal.name":
 		if e.ComplexityRoot.LatestSignal.Name == nil {
 			break
@@ -841,7 +1181,7 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 			return 0, false
 		}
 
-		return e.ComplexityRoot.Query.AvailableSignals(childComplexity, args["tokenId"].(int), args["filter"].(*model.SignalFilter)), true
+		return e.ComplexityRoot.Query.AvailableSignals(childComplexity, args["tokenId"].(int), args["filter"].(*model.SignalFilter), args["from"].(*time.Time), args["to"].(*time.Time)), true
 	case "Query.dailyActivity":
 		if e.ComplexityRoot.Query.DailyActivity == nil {
 			break
@@ -853,6 +1193,17 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.ComplexityRoot.Query.DailyActivity(childComplexity, args["tokenId"].(int), args["from"].(time.Time), args["to"].(time.Time), args["mechanism"].(model.DetectionMechanism), args["config"].(*model.SegmentConfig), args["signalRequests"].([]*model.SegmentSignalRequest), args["eventRequests"].([]*model.SegmentEventRequest), args["timezone"].(*string)), true
+	case "Query.dataCoverage":
+		if e.ComplexityRoot.Query.DataCoverage == nil {
+			break
+		}
+
+		args, err := ec.field_Query_dataCoverage_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.ComplexityRoot.Query.DataCoverage(childComplexity, args["tokenId"].(int), args["from"].(time.Time), args["to"].(time.Time), args["interval"].(string), args["names"].([]string), args["minGapSeconds"].(*int), args["filter"].(*model.SignalFilter)), true
 	case "Query.dataSummary":
 		if e.ComplexityRoot.Query.DataSummary == nil {
 			break
@@ -863,7 +1214,7 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 			return 0, false
 		}
 
-		return e.ComplexityRoot.Query.DataSummary(childComplexity, args["tokenId"].(int), args["filter"].(*model.SignalFilter)), true
+		return e.ComplexityRoot.Query.DataSummary(childComplexity, args["tokenId"].(int), args["filter"].(*model.SignalFilter), args["from"].(*time.Time), args["to"].(*time.Time)), true
 	case "Query.events":
 		if e.ComplexityRoot.Query.Events == nil {
 			break
@@ -874,8 +1225,52 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 			return 0, false
 		}
 
-		return e.ComplexityRoot.Query.Events(childComplexity, args["tokenId"].(int), args["from"].(time.Time), args["to"].(time.Time), args["filter"].(*model.EventFilter)), true
+		return e.ComplexityRoot.Query.Events(childComplexity, args["tokenId"].(int), args["from"].(time.Time), args["to"].(time.Time), args["filter"].(*model.EventFilter), args["derivedEvents"].(*model.DerivedEventsConfig), args["limit"].(*int)), true
+	case "Query.eventsAggregated":
+		if e.ComplexityRoot.Query.EventsAggregated == nil {
+			break
+		}
+
+		args, err := ec.field_Query_eventsAggregated_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.ComplexityRoot.Query.EventsAggregated(childComplexity, args["tokenId"].(int), args["from"].(time.Time), args["to"].(time.Time), args["interval"].(string), args["filter"].(*model.EventFilter)), true
+	case "Query.eventsPage":
+		if e.ComplexityRoot.Query.EventsPage == nil {
+			break
+		}
+
+		args, err := ec.field_Query_eventsPage_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
 
+		return e.ComplexityRoot.Query.EventsPage(childComplexity, args["tokenId"].(int), args["from"].(time.Time), args["to"].(time.Time), args["filter"].(*model.EventFilter), args["limit"].(*int), args["after"].(*time.Time)), true
+
+	case "Query.samplingStats":
+		if e.ComplexityRoot.Query.SamplingStats == nil {
+			break
+		}
+
+		args, err := ec.field_Query_samplingStats_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.ComplexityRoot.Query.SamplingStats(childComplexity, args["tokenId"].(int), args["from"].(time.Time), args["to"].(time.Time), args["names"].([]string), args["filter"].(*model.SignalFilter)), true
+	case "Query.segment":
+		if e.ComplexityRoot.Query.Segment == nil {
+			break
+		}
+
+		args, err := ec.field_Query_segment_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.ComplexityRoot.Query.Segment(childComplexity, args["tokenId"].(int), args["id"].(string), args["mechanism"].(model.DetectionMechanism), args["config"].(*model.SegmentConfig), args["signalRequests"].([]*model.SegmentSignalRequest), args["eventRequests"].([]*model.SegmentEventRequest)), true
 	case "Query.segments":
 		if e.ComplexityRoot.Query.Segments == nil {
 			break
@@ -887,6 +1282,12 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.ComplexityRoot.Query.Segments(childComplexity, args["tokenId"].(int), args["from"].(time.Time), args["to"].(time.Time), args["mechanism"].(model.DetectionMechanism), args["config"].(*model.SegmentConfig), args["signalRequests"].([]*model.SegmentSignalRequest), args["eventRequests"].([]*model.SegmentEventRequest), args["limit"].(*int), args["after"].(*time.Time)), true
+	case "Query.signalDefinitions":
+		if e.ComplexityRoot.Query.SignalDefinitions == nil {
+			break
+		}
+
+		return e.ComplexityRoot.Query.SignalDefinitions(childComplexity), true
 	case "Query.signals":
 		if e.ComplexityRoot.Query.Signals == nil {
 			break
@@ -932,6 +1333,55 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.Query.VinVCLatest(childComplexity, args["tokenId"].(int)), true
 
+	case "RefuelDetails.estimatedCost":
+		if e.ComplexityRoot.RefuelDetails.EstimatedCost == nil {
+			break
+		}
+
+		return e.ComplexityRoot.RefuelDetails.EstimatedCost(childComplexity), true
+	case "RefuelDetails.litersAdded":
+		if e.ComplexityRoot.RefuelDetails.LitersAdded == nil {
+			break
+		}
+
+		return e.ComplexityRoot.RefuelDetails.LitersAdded(childComplexity), true
+	case "RefuelDetails.peakLevel":
+		if e.ComplexityRoot.RefuelDetails.PeakLevel == nil {
+			break
+		}
+
+		return e.ComplexityRoot.RefuelDetails.PeakLevel(childComplexity), true
+	case "RefuelDetails.tankCapacityLiters":
+		if e.ComplexityRoot.RefuelDetails.TankCapacityLiters == nil {
+			break
+		}
+
+		return e.ComplexityRoot.RefuelDetails.TankCapacityLiters(childComplexity), true
+	case "RefuelDetails.troughLevel":
+		if e.ComplexityRoot.RefuelDetails.TroughLevel == nil {
+			break
+		}
+
+		return e.ComplexityRoot.RefuelDetails.TroughLevel(childComplexity), true
+
+	case "Segment.charging":
+		if e.ComplexityRoot.Segment.Charging == nil {
+			break
+		}
+
+		return e.ComplexityRoot.Segment.Charging(childComplexity), true
+	case "Segment.distanceKm":
+		if e.ComplexityRoot.Segment.DistanceKm == nil {
+			break
+		}
+
+		return e.ComplexityRoot.Segment.DistanceKm(childComplexity), true
+	case "Segment.distanceSource":
+		if e.ComplexityRoot.Segment.DistanceSource == nil {
+			break
+		}
+
+		return e.ComplexityRoot.Segment.DistanceSource(childComplexity), true
 	case "Segment.duration":
 		if e.ComplexityRoot.Segment.Duration == nil {
 			break
@@ -950,12 +1400,48 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.ComplexityRoot.Segment.EventCounts(childComplexity), true
+	case "Segment.fuelDrop":
+		if e.ComplexityRoot.Segment.FuelDrop == nil {
+			break
+		}
+
+		return e.ComplexityRoot.Segment.FuelDrop(childComplexity), true
+	case "Segment.id":
+		if e.ComplexityRoot.Segment.ID == nil {
+			break
+		}
+
+		return e.ComplexityRoot.Segment.ID(childComplexity), true
 	case "Segment.isOngoing":
 		if e.ComplexityRoot.Segment.IsOngoing == nil {
 			break
 		}
 
 		return e.ComplexityRoot.Segment.IsOngoing(childComplexity), true
+	case "Segment.mechanism":
+		if e.ComplexityRoot.Segment.Mechanism == nil {
+			break
+		}
+
+		return e.ComplexityRoot.Segment.Mechanism(childComplexity), true
+	case "Segment.refuel":
+		if e.ComplexityRoot.Segment.Refuel == nil {
+			break
+		}
+
+		return e.ComplexityRoot.Segment.Refuel(childComplexity), true
+	case "Segment.route":
+		if e.ComplexityRoot.Segment.Route == nil {
+			break
+		}
+
+		return e.ComplexityRoot.Segment.Route(childComplexity), true
+	case "Segment.score":
+		if e.ComplexityRoot.Segment.Score == nil {
+			break
+		}
+
+		return e.ComplexityRoot.Segment.Score(childComplexity), true
 	case "Segment.signals":
 		if e.ComplexityRoot.Segment.Signals == nil {
 			break
@@ -974,6 +1460,44 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 		}
 
 		return e.ComplexityRoot.Segment.StartedBeforeRange(childComplexity), true
+	case "Segment.stop":
+		if e.ComplexityRoot.Segment.Stop == nil {
+			break
+		}
+
+		return e.ComplexityRoot.Segment.Stop(childComplexity), true
+
+	case "SegmentRoute.format":
+		if e.ComplexityRoot.SegmentRoute.Format == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SegmentRoute.Format(childComplexity), true
+	case "SegmentRoute.pointCount":
+		if e.ComplexityRoot.SegmentRoute.PointCount == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SegmentRoute.PointCount(childComplexity), true
+	case "SegmentRoute.value":
+		if e.ComplexityRoot.SegmentRoute.Value == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SegmentRoute.Value(childComplexity), true
+
+	case "SegmentStop.centroid":
+		if e.ComplexityRoot.SegmentStop.Centroid == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SegmentStop.Centroid(childComplexity), true
+	case "SegmentStop.ignitionOn":
+		if e.ComplexityRoot.SegmentStop.IgnitionOn == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SegmentStop.IgnitionOn(childComplexity), true
 
 	case "SignalAggregationValue.agg":
 		if e.ComplexityRoot.SignalAggregationValue.Agg == nil {
@@ -2997,6 +3521,31 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.SignalCollection.Speed(childComplexity), true
 
+	case "SignalCoverage.buckets":
+		if e.ComplexityRoot.SignalCoverage.Buckets == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SignalCoverage.Buckets(childComplexity), true
+	case "SignalCoverage.gaps":
+		if e.ComplexityRoot.SignalCoverage.Gaps == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SignalCoverage.Gaps(childComplexity), true
+	case "SignalCoverage.name":
+		if e.ComplexityRoot.SignalCoverage.Name == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SignalCoverage.Name(childComplexity), true
+	case "SignalCoverage.totalCount":
+		if e.ComplexityRoot.SignalCoverage.TotalCount == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SignalCoverage.TotalCount(childComplexity), true
+
 	case "SignalDataSummary.firstSeen":
 		if e.ComplexityRoot.SignalDataSummary.FirstSeen == nil {
 			break
@@ -3022,6 +3571,61 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.SignalDataSummary.NumberOfSignals(childComplexity), true
 
+	case "SignalDefinition.aggregations":
+		if e.ComplexityRoot.SignalDefinition.Aggregations == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SignalDefinition.Aggregations(childComplexity), true
+	case "SignalDefinition.description":
+		if e.ComplexityRoot.SignalDefinition.Description == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SignalDefinition.Description(childComplexity), true
+	case "SignalDefinition.max":
+		if e.ComplexityRoot.SignalDefinition.Max == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SignalDefinition.Max(childComplexity), true
+	case "SignalDefinition.min":
+		if e.ComplexityRoot.SignalDefinition.Min == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SignalDefinition.Min(childComplexity), true
+	case "SignalDefinition.name":
+		if e.ComplexityRoot.SignalDefinition.Name == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SignalDefinition.Name(childComplexity), true
+	case "SignalDefinition.privileges":
+		if e.ComplexityRoot.SignalDefinition.Privileges == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SignalDefinition.Privileges(childComplexity), true
+	case "SignalDefinition.unit":
+		if e.ComplexityRoot.SignalDefinition.Unit == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SignalDefinition.Unit(childComplexity), true
+	case "SignalDefinition.valueType":
+		if e.ComplexityRoot.SignalDefinition.ValueType == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SignalDefinition.ValueType(childComplexity), true
+	case "SignalDefinition.vssPath":
+		if e.ComplexityRoot.SignalDefinition.VssPath == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SignalDefinition.VssPath(childComplexity), true
+
 	case "SignalFloat.timestamp":
 		if e.ComplexityRoot.SignalFloat.Timestamp == nil {
 			break
@@ -3048,6 +3652,55 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.SignalLocation.Value(childComplexity), true
 
+	case "SignalSamplingStats.activeHours":
+		if e.ComplexityRoot.SignalSamplingStats.ActiveHours == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SignalSamplingStats.ActiveHours(childComplexity), true
+	case "SignalSamplingStats.maxIntervalSeconds":
+		if e.ComplexityRoot.SignalSamplingStats.MaxIntervalSeconds == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SignalSamplingStats.MaxIntervalSeconds(childComplexity), true
+	case "SignalSamplingStats.medianIntervalSeconds":
+		if e.ComplexityRoot.SignalSamplingStats.MedianIntervalSeconds == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SignalSamplingStats.MedianIntervalSeconds(childComplexity), true
+	case "SignalSamplingStats.name":
+		if e.ComplexityRoot.SignalSamplingStats.Name == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SignalSamplingStats.Name(childComplexity), true
+	case "SignalSamplingStats.p95IntervalSeconds":
+		if e.ComplexityRoot.SignalSamplingStats.P95IntervalSeconds == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SignalSamplingStats.P95IntervalSeconds(childComplexity), true
+	case "SignalSamplingStats.sampleCount":
+		if e.ComplexityRoot.SignalSamplingStats.SampleCount == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SignalSamplingStats.SampleCount(childComplexity), true
+	case "SignalSamplingStats.samplesPerActiveHour":
+		if e.ComplexityRoot.SignalSamplingStats.SamplesPerActiveHour == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SignalSamplingStats.SamplesPerActiveHour(childComplexity), true
+	case "SignalSamplingStats.source":
+		if e.ComplexityRoot.SignalSamplingStats.Source == nil {
+			break
+		}
+
+		return e.ComplexityRoot.SignalSamplingStats.Source(childComplexity), true
+
 	case "SignalString.timestamp":
 		if e.ComplexityRoot.SignalString.Timestamp == nil {
 			break
@@ -3074,6 +3727,29 @@ func (e *executableSchema) Complexity(ctx context.Context, typeName, field strin
 
 		return e.ComplexityRoot.SignalsSnapshotResponse.Signals(childComplexity), true
 
+	case "Subscription.events":
+		if e.ComplexityRoot.Subscription.Events == nil {
+			break
+		}
+
+		args, err := ec.field_Subscription_events_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.ComplexityRoot.Subscription.Events(childComplexity, args["tokenId"].(int), args["filter"].(*model.EventFilter)), true
+	case "Subscription.signalsLatest":
+		if e.ComplexityRoot.Subscription.SignalsLatest == nil {
+			break
+		}
+
+		args, err := ec.field_Subscription_signalsLatest_args(ctx, rawArgs)
+		if err != nil {
+			return 0, false
+		}
+
+		return e.ComplexityRoot.Subscription.SignalsLatest(childComplexity, args["tokenId"].(int), args["filter"].(*model.SignalFilter)), true
+
 	case "VINVC.countryCode":
 		if e.ComplexityRoot.VINVC.CountryCode == nil {
 			break
@@ -3138,7 +3814,9 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 	ec := newExecutionContext(opCtx, e, make(chan graphql.DeferredResult))
 	inputUnmarshalMap := graphql.BuildUnmarshalerMap(
 		ec.unmarshalInputAttestationFilter,
+		ec.unmarshalInputDerivedEventsConfig,
 		ec.unmarshalInputEventFilter,
+		ec.unmarshalInputEventMetadataFilter,
 		ec.unmarshalInputFilterLocation,
 		ec.unmarshalInputInCircleFilter,
 		ec.unmarshalInputSegmentConfig,
@@ -3183,6 +3861,23 @@ func (e *executableSchema) Exec(ctx context.Context) graphql.ResponseHandler {
 
 			return &response
 		}
+	case ast.Subscription:
+		next := ec._Subscription(ctx, opCtx.Operation.SelectionSet)
+
+		var buf bytes.Buffer
+		return func(ctx context.Context) *graphql.Response {
+			buf.Reset()
+			data := next(ctx)
+
+			if data == nil {
+				return nil
+			}
+			data.MarshalGQL(&buf)
+
+			return &graphql.Response{
+				Data: buf.Bytes(),
+			}
+		}
 
 	default:
 		return graphql.OneShot(graphql.ErrorResponse(ctx, "unsupported GraphQL operation"))
@@ -3315,7 +4010,18 @@ type Query {
     @requiresVehicleToken
     @mcpTool(name: "get_latest_signals", description: "Get the most recent signal values for a vehicle by token ID. Returns the last-seen timestamp for the vehicle.", selection: "lastSeen")
     @mcpExample(description: "Latest speed and battery charge", query: "query Latest($tokenId:Int!) { signalsLatest(tokenId:$tokenId) { lastSeen speed{timestamp value} powertrainTractionBatteryStateOfChargeCurrent{timestamp value} } }")
-  availableSignals(tokenId: Int!, filter: SignalFilter): [String!]
+  availableSignals(
+    tokenId: Int!
+    filter: SignalFilter
+    """
+    Only include signals stored at or after this time. Set together with to; the range may span at most 366 days. Without from and to, all stored signals are considered.
+    """
+    from: Time
+    """
+    Only include signals stored before this time. Set together with from.
+    """
+    to: Time
+  ): [String!]
     @requiresVehicleToken
     @mcpTool(name: "get_available_signals", description: "List queryable signal names that have stored data for a vehicle by token ID.", selection: "")
 
@@ -3327,10 +4033,40 @@ type Query {
     @mcpTool(name: "get_signals_snapshot", description: "Get a point-in-time snapshot of all available signals for a vehicle by token ID. Returns every signal the caller has permission to see.", selection: "lastSeen signals { name timestamp valueNumber valueString valueLocation { latitude longitude hdop } }")
     @mcpExample(description: "Full snapshot of all signals for a vehicle", query: "query Snapshot($tokenId:Int!) { signalsSnapshot(tokenId:$tokenId) { lastSeen signals { name timestamp valueNumber valueString valueLocation { latitude longitude hdop } } } }")
 
-  dataSummary(tokenId: Int!, filter: SignalFilter): DataSummary
+  """
+  Summary of the signals and events stored for a vehicle. Without from and to the summary
+  covers all time; with them it only covers data stored in [from, to), at most 366 days.
+  """
+  dataSummary(
+    tokenId: Int!
+    filter: SignalFilter
+    """
+    Only include data stored at or after this time. Set together with to.
+    """
+    from: Time
+    """
+    Only include data stored before this time. Set together with from.
+    """
+    to: Time
+  ): DataSummary
     @requiresVehicleToken
     @mcpTool(name: "get_data_summary", description: "Get a summary of all data available for a vehicle by token ID. Returns total signal count, available signal names, first/last seen timestamps, and per-signal and per-event breakdowns.", selection: "numberOfSignals availableSignals firstSeen lastSeen signalDataSummary { name numberOfSignals firstSeen lastSeen } eventDataSummary { name numberOfEvents firstSeen lastSeen }")
 }
+"""
+The root subscription type for the GraphQL schema. Subscriptions are served over
+WebSocket; pass the JWT as "Authorization" in the connection init payload.
+"""
+type Subscription {
+  """
+  Streams the latest signals for a vehicle. The server polls for new data on a fixed
+  cadence. The first message contains every selected signal; each later message contains
+  only the selected signals whose timestamp advanced. Each message is billed like a
+  signalsLatest query.
+  """
+  signalsLatest(tokenId: Int!, filter: SignalFilter): SignalCollection
+    @requiresVehicleToken
+}
+
 type SignalAggregations {
   timestamp: Time!
   """
@@ -3547,19 +4283,279 @@ input StringArrayFilter {
   notContainsAll: [String!]
   or: [StringArrayFilter!]
 }
+`, BuiltIn: false},
+	{Name: "../../schema/catalog.graphqls", Input: `extend type Query {
+  """
+  Lists every signal that can be queried, with its VSS path, value type, unit,
+  description, required privileges and allowed aggregations.
+  """
+  signalDefinitions: [SignalDefinition!]!
+    @mcpTool(name: "get_signal_definitions", description: "List every queryable signal with its VSS path, value type, unit, description, required privileges and allowed aggregations. Use to discover signal names before querying.", selection: "name vssPath valueType unit min max description privileges aggregations")
+}
+
+type SignalDefinition {
+  """
+  Signal name as used in queries, e.g. "speed".
+  """
+  name: String!
+  """
+  Full VSS path of the signal, e.g. "Vehicle.Speed".
+  """
+  vssPath: String!
+  """
+  GraphQL type of the signal values: Float, String or Location.
+  """
+  valueType: String!
+  """
+  Unit of the values, if any.
+  """
+  unit: String
+  """
+  Minimum value, if defined.
+  """
+  min: String
+  """
+  Maximum value, if defined.
+  """
+  max: String
+  """
+  Description of the signal.
+  """
+  description: String!
+  """
+  Privileges the caller must all hold to read the signal. Any one of them is
+  enough for currentLocationApproximateCoordinates.
+  """
+  privileges: [Privilege!]!
+  """
+  Aggregations accepted by the signal in signals queries.
+  """
+  aggregations: [String!]!
+}
+`, BuiltIn: false},
+	{Name: "../../schema/coverage.graphqls", Input: `extend type Query {
+  """
+  Returns sample counts per signal in each interval bucket, plus the gaps in which a
+  signal reported nothing for longer than minGapSeconds. Useful for seeing when and
+  which signals stopped flowing for a vehicle.
+  Maximum date range: 31 days.
+  """
+  dataCoverage(
+    tokenId: Int!
+    from: Time!
+    to: Time!
+    """
+    Bucket size as a duration string of whole seconds (e.g., "15m", "1h", "24h").
+    At most 5000 buckets may be requested.
+    """
+    interval: String!
+    """
+    Signal names to report. Names without samples in the range are reported with a
+    single gap spanning the range. Defaults to every signal with samples in the range.
+    """
+    names: [String!]
+    """
+    Minimum gap length in seconds to report. Default: 3600 (1 hour), Min: 60
+    """
+    minGapSeconds: Int = 3600
+    filter: SignalFilter
+  ): [SignalCoverage!]!
+    @requiresVehicleToken
+    @mcpTool(name: "get_data_coverage", description: "Get per-bucket sample counts and gaps for each signal of a vehicle over a time range. Use to find when and which signals stopped reporting.", selection: "name totalCount buckets { timestamp count } gaps { start end duration }")
+}
+
+type SignalCoverage {
+  """
+  Signal name.
+  """
+  name: String!
+  """
+  Total number of samples in the range.
+  """
+  totalCount: Int!
+  """
+  Buckets with at least one sample, in ascending order. Missing buckets have no samples.
+  """
+  buckets: [CoverageBucket!]!
+  """
+  Periods without samples longer than minGapSeconds, in ascending order.
+  """
+  gaps: [DataGap!]!
+}
+
+type CoverageBucket {
+  """
+  Start of the bucket.
+  """
+  timestamp: Time!
+  """
+  Number of samples in the bucket.
+  """
+  count: Int!
+}
+
+type DataGap {
+  """
+  Time of the last sample before the gap, or the start of the range.
+  """
+  start: Time!
+  """
+  Time of the first sample after the gap, or the end of the range.
+  """
+  end: Time!
+  """
+  Length of the gap in seconds.
+  """
+  duration: Int!
+}
+
+extend type Query {
+  """
+  Returns sampling statistics per signal and source over a time range: how often a
+  signal is reported and how irregular the reporting is. Useful for picking segment
+  thresholds such as signalCountThreshold and aggregation intervals.
+  Maximum date range: 31 days.
+  """
+  samplingStats(
+    tokenId: Int!
+    from: Time!
+    to: Time!
+    """
+    Signal names to report. Defaults to every signal with samples in the range.
+    """
+    names: [String!]
+    filter: SignalFilter
+  ): [SignalSamplingStats!]!
+    @requiresVehicleToken
+    @mcpTool(name: "get_sampling_stats", description: "Get sampling statistics per signal and source for a vehicle over a time range: sample count, median/p95/max interval between samples, and samples per active hour.", selection: "name source sampleCount activeHours samplesPerActiveHour medianIntervalSeconds p95IntervalSeconds maxIntervalSeconds")
+}
+
+type SignalSamplingStats {
+  """
+  Signal name.
+  """
+  name: String!
+  """
+  Source connection that reported the samples.
+  """
+  source: String!
+  """
+  Number of samples in the range.
+  """
+  sampleCount: Int!
+  """
+  Number of distinct clock hours with at least one sample.
+  """
+  activeHours: Int!
+  """
+  sampleCount divided by activeHours.
+  """
+  samplesPerActiveHour: Float!
+  """
+  Median time between consecutive samples in seconds. Null if there is only one sample.
+  """
+  medianIntervalSeconds: Float
+  """
+  95th percentile of the time between consecutive samples in seconds. Null if there
+  is only one sample.
+  """
+  p95IntervalSeconds: Float
+  """
+  Longest time between consecutive samples in seconds. Null if there is only one
+  sample.
+  """
+  maxIntervalSeconds: Float
+}
 `, BuiltIn: false},
 	{Name: "../../schema/events.graphqls", Input: `extend type Query {
+  """
+  Returns the events of a vehicle in a time range, newest first. With derivedEvents, harsh
+  driving events derived from signals are added for vehicles whose connection doesn't emit them.
+  Derived events are only returned here: eventsPage, eventsAggregated, the events subscription
+  and segment event counts and driver scores include stored events only.
+  """
   events(
     tokenId: Int!
     from: Time!
     to: Time!
     filter: EventFilter
+    """
+    Also derive behavior.harshAcceleration, behavior.harshBraking and behavior.harshCornering
+    events from the speed and angularVelocityYaw signals. There are no acceleration signals, so
+    longitudinal acceleration is the change of speed between samples. Derived events have the
+    source "telemetry-api:derived", no location, no tags, and metadata {"peak", "threshold",
+    "speedKph"} with accelerations in m/s². They are filtered by filter.name and filter.source;
+    a filter with metadata, location or tags conditions excludes them. With derivedEvents, the
+    date range may span at most 31 days.
+    """
+    derivedEvents: DerivedEventsConfig
+    "Maximum number of events to return, newest first. Default 1000, max 1000. Use eventsPage to read more."
+    limit: Int = 1000
   ): [Event!]
     @requiresVehicleToken
     @requiresAllOfPrivileges(
       privileges: [VEHICLE_NON_LOCATION_DATA, VEHICLE_ALL_TIME_LOCATION]
     )
     @mcpTool(name: "get_events", description: "Get discrete events for a vehicle in a time range. Returns event name, source, timestamp, duration, and optional metadata.", selection: "timestamp name source durationNs metadata")
+
+  """
+  Returns events for a vehicle one page at a time, ordered by timestamp ascending. Events
+  sharing a timestamp are never split across pages, so a page holds more than limit events
+  only when more than limit events share one timestamp. To get the next page, pass the
+  nextCursor of the previous page as after.
+  """
+  eventsPage(
+    tokenId: Int!
+    from: Time!
+    to: Time!
+    filter: EventFilter
+    "Maximum number of events to return. Default 100, max 1000."
+    limit: Int = 100
+    """
+    Cursor for pagination: return only events with timestamp > after (exclusive).
+    Pass the nextCursor of the previous page for the next page.
+    """
+    after: Time
+  ): EventPage!
+    @requiresVehicleToken
+    @requiresAllOfPrivileges(
+      privileges: [VEHICLE_NON_LOCATION_DATA, VEHICLE_ALL_TIME_LOCATION]
+    )
+    @mcpTool(name: "get_events_page", description: "Get one page of events for a vehicle in a time range, oldest first. Pass nextCursor as after to fetch the next page while hasMore is true.", selection: "hasMore nextCursor events { timestamp name source durationNs metadata }")
+
+  """
+  Returns the number of events and their total duration per event name in each
+  interval bucket. Buckets without events are omitted.
+  """
+  eventsAggregated(
+    tokenId: Int!
+    from: Time!
+    to: Time!
+    """
+    Duration string for the buckets (e.g., "5m", "1h", "24h"). Valid units: ms, s, m, h.
+    """
+    interval: String!
+    filter: EventFilter
+  ): [EventAggregation!]!
+    @requiresVehicleToken
+    @requiresAllOfPrivileges(
+      privileges: [VEHICLE_NON_LOCATION_DATA, VEHICLE_ALL_TIME_LOCATION]
+    )
+    @mcpTool(name: "get_events_aggregated", description: "Get event counts and total durations per event name per time bucket for a vehicle. Use instead of get_events when only counts are needed.", selection: "timestamp name count durationNs")
+}
+
+extend type Subscription {
+  """
+  Streams events for a vehicle as they are stored. Only events stored after the subscription
+  starts are sent, each once. An event stored late is sent only if its timestamp is within a
+  lookback window (10 minutes by default) of the time it is stored. Each event is billed like
+  an events query.
+  """
+  events(tokenId: Int!, filter: EventFilter): Event!
+    @requiresVehicleToken
+    @requiresAllOfPrivileges(
+      privileges: [VEHICLE_NON_LOCATION_DATA, VEHICLE_ALL_TIME_LOCATION]
+    )
 }
 
 type Event {
@@ -3570,12 +4566,99 @@ type Event {
   """Duration in nanoseconds."""
   durationNs: Int!
   metadata: String
+  """
+  Location of the vehicle at the time of the event: the latest currentLocationCoordinates
+  sample at or before the event, if it is at most one hour older. Always exact: event queries
+  require VEHICLE_ALL_TIME_LOCATION, and callers with only VEHICLE_APPROXIMATE_LOCATION get no
+  H3-snapped variant, since the location filter would still reveal exact positions.
+  """
+  location: Location
+}
+
+type EventPage {
+  events: [Event!]!
+  """Whether there are more events after this page."""
+  hasMore: Boolean!
+  """Timestamp of the last event, to pass as after for the next page. Null if hasMore is false."""
+  nextCursor: Time
+}
+
+type EventAggregation {
+  """Start of the bucket."""
+  timestamp: Time!
+  name: String!
+  """Number of events in the bucket."""
+  count: Int!
+  """Total duration of the events in the bucket in nanoseconds."""
+  durationNs: Int!
+}
+
+"""
+Thresholds for events derived from signals. Longitudinal acceleration is the change of speed
+between speed samples at most maxSampleGapSeconds apart; changes above 15 m/s² are treated as
+glitches. Lateral acceleration is speed times the angularVelocityYaw yaw rate. Consecutive
+samples over a threshold form one event.
+"""
+input DerivedEventsConfig {
+  """
+  Acceleration (m/s²) above which behavior.harshAcceleration is derived.
+  Default: 3, Min: 0.5, Max: 20
+  """
+  harshAccelerationThreshold: Float = 3
+  """
+  Deceleration (m/s², positive) above which behavior.harshBraking is derived.
+  Default: 4, Min: 0.5, Max: 20
+  """
+  harshBrakingThreshold: Float = 4
+  """
+  Lateral acceleration (m/s²) above which behavior.harshCornering is derived.
+  Default: 4, Min: 0.5, Max: 20
+  """
+  harshCorneringThreshold: Float = 4
+  """
+  Longest gap (seconds) between samples that are differentiated or joined into one event.
+  Connections that report speed less often derive no events unless this is raised, but over
+  longer gaps acceleration is averaged out and short harsh maneuvers are still missed.
+  Default: 5, Min: 1, Max: 60
+  """
+  maxSampleGapSeconds: Int = 5
 }
 
 input EventFilter {
   name: StringValueFilter
   """Source connection that created the event."""
   source: StringValueFilter
+  """
+  Conditions on fields of the event metadata JSON. An event must match all of them.
+  At most 10 conditions.
+  """
+  metadata: [EventMetadataFilter!]
+  """
+  Conditions on the location of the vehicle at the time of the event, as returned in
+  Event.location. Events without a location do not match.
+  """
+  location: SignalLocationFilter
+}
+
+"""
+A condition on one field of the event metadata JSON. Events whose metadata lacks the
+field, or holds a value of another type, do not match. At least one operator is required;
+if several are given, all must hold.
+"""
+input EventMetadataFilter {
+  """
+  Dot-separated object keys leading to the field, e.g. "code" or "acceleration.peak".
+  Keys may contain letters, digits and underscores. At most 5 keys.
+  """
+  path: String!
+  """String value equals."""
+  eq: String
+  """String value is one of."""
+  in: [String!]
+  """Numeric value is greater than."""
+  gt: Float
+  """Numeric value is less than."""
+  lt: Float
 }
 `, BuiltIn: false},
 	{Name: "../../schema/mcp.graphqls", Input: `directive @mcpTool(name: String!, description: String!, selection: String!, readOnly: Boolean = true) on FIELD_DEFINITION
@@ -3618,12 +4701,56 @@ directive @mcpHide on FIELD_DEFINITION
   Recharge: Hybrid detection. Uses charging signals and state of charge for detection.
   """
   recharge
+
+  """
+  Threshold: Segments are periods where the signal thresholdSignal satisfies
+  thresholdFilter, e.g. speed above 120 or state of charge below 10.
+  """
+  threshold
+
+  """
+  Geofence: Segments are visits to the polygon or circle config.geofence, from entry
+  to exit, based on currentLocationCoordinates. The segment duration is the dwell time.
+  """
+  geofence
+
+  """
+  Stops: Segments are stationary periods between trips, where currentLocationCoordinates
+  stays within config.stopRadiusMeters and speed is approximately 0. Speed samples older
+  than config.maxGapSeconds are ignored. The segment duration is the dwell time, and stop
+  reports the centroid and whether ignition was on.
+  """
+  stops
+
+  """
+  Fuel drop: Detects sudden fuel level drops while the vehicle is parked (speed
+  approximately 0 or ignition off), which indicate siphoning or a leak. Segments run from
+  the last high reading to the first stable low reading.
+  """
+  fuelDrop
+
+  """
+  Auto: Chooses a trip mechanism per vehicle from the signals it reports (signal_summary).
+  Uses ignitionDetection when isIgnitionOn is reliable (at least 20 samples, and last seen
+  within 7 days of the vehicle's latest signal), else changePointDetection when at least two of
+  speed, powertrainCombustionEngineSpeed, powertrainTransmissionTravelledDistance and
+  currentLocationCoordinates are reported that way, else frequencyAnalysis.
+  Segment.mechanism reports the chosen mechanism.
+  """
+  auto
 }
 
 extend type Query {
   """
   Returns vehicle usage segments detected using the specified mechanism.
-  Maximum date range: 31 days.
+  Maximum date range: 31 days, or 366 days where the segment store is enabled and covers the
+  range. The store keeps closed segments per vehicle and mechanism for the default detection
+  config, so only the time after the last stored segment is detected again. It is filled in the
+  background from the queried ranges within the last 366 days. At most 31 days of a range it
+  doesn't cover yet are detected from raw signals; longer ranges fail with an error asking to
+  retry in a few minutes. Configs that change detection settings are not stored and limited to
+  31 days. The last 7 days of the store are detected again every 6 hours, so telemetry that
+  arrives late shows up.
 
   Detection mechanisms:
   - ignitionDetection: Uses 'isIgnitionOn' signal with configurable debouncing
@@ -3632,12 +4759,19 @@ extend type Query {
   - idling: Idling segments (engine rpm idle)
   - refuel: Refueling segments (fuel level increased)
   - recharge: Charging segments (battery SoC increased)
+  - threshold: Periods where a signal satisfies a filter (config.thresholdSignal, config.thresholdFilter)
+  - geofence: Visits to a polygon or circle (config.geofence)
+  - stops: Stationary periods between trips (config.stopRadiusMeters)
+  - fuelDrop: Fuel level drops while parked, e.g. theft or leaks (config.minDropPercent)
+  - auto: ignitionDetection, changePointDetection or frequencyAnalysis, chosen per vehicle
 
-  Segment IDs are stable and consistent across queries as long as the segment start
-  is captured in the underlying data source.
+  Segment IDs (Segment.id) are stable and consistent across queries as long as the segment
+  start is captured in the underlying data source. Use segment to look one up again.
 
   Each segment includes summary: signals, start/end location, and (when requested) eventCounts.
-  A default set of signal requests is always applied (e.g. speed, odometer; for refuel/recharge also the level signal at start and end).
+  A default set of signal requests is always applied (e.g. speed, odometer; for refuel/recharge also the level signal at start and end;
+  for recharge also the charging power, AC charging current and battery capacity used for charging;
+  for refuel and fuelDrop also the absolute fuel level at start and end).
   When signalRequests is provided, those requests are added on top of the default set; duplicates (same name and agg) are omitted.
   """
   segments(
@@ -3658,13 +4792,30 @@ extend type Query {
     """
     after: Time
   ): [Segment!]! @requiresVehicleToken @requiresAllOfPrivileges(privileges: [VEHICLE_ALL_TIME_LOCATION, VEHICLE_NON_LOCATION_DATA])
-    @mcpTool(name: "get_trip_segments", description: "Get vehicle trip/activity segments detected using a specified mechanism (frequencyAnalysis, ignitionDetection, changePointDetection, idling, refuel, recharge). Returns start/end locations, duration, and optional signal aggregates and event counts. Maximum date range: 31 days.", selection: "start { timestamp value { latitude longitude } } end { timestamp value { latitude longitude } } duration isOngoing startedBeforeRange signals { name agg value } eventCounts { name count }")
+    @mcpTool(name: "get_trip_segments", description: "Get vehicle trip/activity segments detected using a specified mechanism (frequencyAnalysis, ignitionDetection, changePointDetection, idling, refuel, recharge, threshold, geofence, stops, fuelDrop, auto). Returns start/end locations, duration, and optional signal aggregates and event counts. Maximum date range: 31 days, or 366 days within the last year with the default config where the segment store is enabled; a longer range it doesn't cover yet fails with an error asking to retry in a few minutes.", selection: "id mechanism start { timestamp value { latitude longitude } } end { timestamp value { latitude longitude } } duration isOngoing startedBeforeRange signals { name agg value } eventCounts { name count }")
     @mcpExample(description: "Trip segments with start/end locations and signal aggregates", query: "query Trips($tokenId:Int!,$from:Time!,$to:Time!) { segments(tokenId:$tokenId,from:$from,to:$to,mechanism:frequencyAnalysis) { start{timestamp value{latitude longitude}} end{timestamp value{latitude longitude}} duration isOngoing signals{name agg value} eventCounts{name count} } }")
 
+  """
+  Returns the segment with the given id (Segment.id from segments), re-detected from its start
+  with its summaries. The id does not record the config, so pass the config used when the
+  segment was listed. With auto, the segment is re-detected with the mechanism that produced it.
+  Returns null if the vehicle has no segment with this id.
+  """
+  segment(
+    tokenId: Int!
+    id: ID!
+    mechanism: DetectionMechanism!
+    config: SegmentConfig
+    signalRequests: [SegmentSignalRequest!]
+    eventRequests: [SegmentEventRequest!]
+  ): Segment @requiresVehicleToken @requiresAllOfPrivileges(privileges: [VEHICLE_ALL_TIME_LOCATION, VEHICLE_NON_LOCATION_DATA])
+    @mcpTool(name: "get_trip_segment", description: "Get one vehicle trip/activity segment by the id returned by get_trip_segments, using the same mechanism and config. Returns start/end locations, duration, signal aggregates and event counts, or null if there is no such segment.", selection: "id mechanism start { timestamp value { latitude longitude } } end { timestamp value { latitude longitude } } duration isOngoing startedBeforeRange signals { name agg value } eventCounts { name count }")
+
   """
   Returns one record per calendar day in the date range.
-  Mechanism must be ignitionDetection, frequencyAnalysis, or changePointDetection (idling, refuel, and recharge not allowed).
-  Maximum date range: 31 days.
+  Mechanism must be ignitionDetection, frequencyAnalysis, changePointDetection, auto, or stops (idling, refuel, recharge, threshold, geofence, and fuelDrop not allowed).
+  With stops, segmentCount and duration are the number of stops and the total dwell time.
+  Maximum date range: 31 days, or 366 days where the segment store covers the range, as for segments.
   """
   dailyActivity(
     tokenId: Int!
@@ -3676,7 +4827,7 @@ extend type Query {
     eventRequests: [SegmentEventRequest!]
     timezone: String
   ): [DailyActivity!]! @requiresVehicleToken @requiresAllOfPrivileges(privileges: [VEHICLE_ALL_TIME_LOCATION, VEHICLE_NON_LOCATION_DATA])
-    @mcpTool(name: "get_daily_activity", description: "Get per-day driving activity summaries for a vehicle. Returns segment count, total active duration, and signal aggregates per day. Maximum date range: 31 days.", selection: "segmentCount duration signals { name agg value } eventCounts { name count }")
+    @mcpTool(name: "get_daily_activity", description: "Get per-day driving activity summaries for a vehicle. Returns segment count, total active duration, and signal aggregates per day. Maximum date range: 31 days, or 366 days within the last year with the default config where the segment store is enabled; a longer range it doesn't cover yet fails with an error asking to retry in a few minutes.", selection: "segmentCount duration signals { name agg value } eventCounts { name count }")
     @mcpExample(description: "Daily activity summaries", query: "query Daily($tokenId:Int!,$from:Time!,$to:Time!) { dailyActivity(tokenId:$tokenId,from:$from,to:$to,mechanism:frequencyAnalysis) { segmentCount duration signals{name agg value} eventCounts{name count} } }")
 }
 
@@ -3700,6 +4851,19 @@ type DailyActivity {
   duration: Int!
   signals: [SignalAggregationValue!]!
   eventCounts: [EventCount!]!
+  """
+  Driver score for the day: the average of the scores of the segments in the day,
+  weighted by segment duration. Null if the day has no scored segments.
+  """
+  score: DriverScore
+  """
+  Distance traveled in the day in kilometers: the sum of the distances of the day's segments.
+  Segments that cross midnight count in proportion to the part of their duration in the day.
+  Null if no segment in the day has a distance.
+  """
+  distanceKm: Float
+  """Source of distanceKm; MIXED if the day's segments used different sources."""
+  distanceSource: DistanceSource
 }
 
 input SegmentConfig {
@@ -3736,9 +4900,90 @@ input SegmentConfig {
   [refuel and recharge only] Minimum percent increase within a window to consider it a level-increase window.
   """
   minIncreasePercent: Int = 15
+
+  """
+  [threshold only] Float signal whose samples are tested against thresholdFilter, e.g. "speed".
+  Required for threshold.
+  """
+  thresholdSignal: String
+
+  """
+  [threshold only] Condition a sample must satisfy to be part of a segment. Consecutive
+  matching samples at most maxGapSeconds apart form one segment. Required for threshold.
+  """
+  thresholdFilter: SignalFloatFilter
+
+  """
+  [geofence only] Polygon or circle to detect visits to. A visit ends at the last
+  location sample inside it before samples outside it that go on for more than
+  maxGapSeconds; shorter excursions (GPS jitter at the edge) and gaps in reporting don't
+  end a visit. Required for geofence.
+  """
+  geofence: SignalLocationFilter
+
+  """
+  [stops only] Maximum distance (meters) of a location sample from the stop's centroid for
+  the vehicle to count as stationary. Larger values tolerate more GPS drift.
+  Default: 100, Min: 10, Max: 1000
+  """
+  stopRadiusMeters: Int = 100
+
+  """
+  [recharge only] Usable battery capacity (kWh) used to estimate charging.energyAddedKwh.
+  Defaults to the powertrainTractionBatteryGrossCapacity signal.
+  Min: 1, Max: 1000
+  """
+  batteryCapacityKwh: Float
+
+  """
+  [refuel and fuelDrop only] Fuel tank capacity (liters) used to compute refuel.litersAdded
+  and fuelDrop.litersLost. Without it, they come from the powertrainFuelSystemAbsoluteLevel signal.
+  Min: 1, Max: 2000
+  """
+  fuelTankCapacityLiters: Float
+
+  """
+  [refuel only] Fuel price per liter used to compute refuel.estimatedCost. The cost is in
+  the currency of the price. Must be positive.
+  """
+  fuelPricePerLiter: Float
+
+  """
+  [fuelDrop only] Minimum drop in fuel level (absolute percent of the tank) from the last
+  high reading to the first stable low reading.
+  Default: 10, Min: 1, Max: 100
+  """
+  minDropPercent: Int = 10
+
+  """
+  Douglas-Peucker tolerance (meters) used to simplify Segment.route. Points closer than this
+  to the simplified path are dropped; 0 keeps every point.
+  Default: 10, Min: 0, Max: 1000
+  """
+  routeToleranceMeters: Float = 10
+
+  """
+  Encoding of Segment.route.
+  Default: POLYLINE
+  """
+  routeFormat: RouteFormat = POLYLINE
+}
+
+enum RouteFormat {
+  """Encoded polyline (precision 5), as used by most map SDKs."""
+  POLYLINE
+  """GeoJSON LineString geometry with [longitude, latitude] positions."""
+  GEOJSON
 }
 
 type Segment {
+  """
+  Deterministic ID derived from the vehicle, the mechanism and the start timestamp.
+  Pass it to the segment query to fetch this segment again.
+  """
+  id: ID!
+  """Mechanism that detected the segment. With auto, the mechanism chosen for the vehicle."""
+  mechanism: DetectionMechanism!
   start: SignalLocation!
   """Omitted when isOngoing is true."""
   end: SignalLocation
@@ -3749,6 +4994,155 @@ type Segment {
   startedBeforeRange: Boolean!
   signals: [SignalAggregationValue!]
   eventCounts: [EventCount!]
+  """
+  Driver score for the segment. Only computed for ignitionDetection,
+  frequencyAnalysis and changePointDetection; null for other mechanisms.
+  """
+  score: DriverScore
+  """[stops only] Details of the stop. Null for other mechanisms."""
+  stop: SegmentStop
+  """[recharge only] Details of the charging session. Null for other mechanisms."""
+  charging: ChargingSession
+  """[refuel only] Fuel levels, volume and cost of the refuel. Null for other mechanisms."""
+  refuel: RefuelDetails
+  """[fuelDrop only] Fuel levels and volume of the drop. Null for other mechanisms."""
+  fuelDrop: FuelDropDetails
+  """
+  Path of the segment built from the first currentLocationCoordinates sample of every 10
+  seconds, at most 10000 per segment, and simplified with config.routeToleranceMeters, encoded
+  per config.routeFormat. Routes for all segments are fetched in one extra query, only when this
+  field is selected. Null if the segment has no location samples. Always exact: segment queries
+  require VEHICLE_ALL_TIME_LOCATION and return exact start and end locations, so there is no
+  H3-snapped route for callers with only VEHICLE_APPROXIMATE_LOCATION.
+  """
+  route: SegmentRoute
+  """
+  Distance traveled in kilometers. Uses the odometer delta when the vehicle reports odometer
+  during the segment; otherwise the length of the currentLocationCoordinates path sampled like
+  route, ignoring imprecise fixes (HDOP above 5), moves under 25 m and jumps faster than
+  300 km/h.
+  Null if there is neither odometer nor location data.
+  """
+  distanceKm: Float
+  """Source of distanceKm. Null when distanceKm is null."""
+  distanceSource: DistanceSource
+}
+
+enum DistanceSource {
+  """Difference between the last and first odometer readings."""
+  ODOMETER
+  """Length of the filtered GPS path."""
+  GPS
+  """[dailyActivity only] The day's segments used both sources."""
+  MIXED
+}
+
+type SegmentRoute {
+  format: RouteFormat!
+  """The route in the given format."""
+  value: String!
+  """Number of points in the simplified route."""
+  pointCount: Int!
+}
+
+type FuelDropDetails {
+  """Fuel level (percent) at the last high reading before the drop."""
+  startLevel: Float!
+  """Fuel level (percent) at the first stable reading after the drop."""
+  endLevel: Float!
+  """
+  Fuel lost (liters): the level drop times config.fuelTankCapacityLiters, else the drop of
+  powertrainFuelSystemAbsoluteLevel. Null if unavailable.
+  """
+  litersLost: Float
+}
+
+type RefuelDetails {
+  """Fuel level (percent) at the trough, the last low reading before the refuel."""
+  troughLevel: Float!
+  """Fuel level (percent) at the peak, the first stable reading after the refuel."""
+  peakLevel: Float!
+  """
+  Tank capacity (liters) used to compute litersAdded: config.fuelTankCapacityLiters, else
+  derived from powertrainFuelSystemAbsoluteLevel at the peak. Null if unavailable.
+  """
+  tankCapacityLiters: Float
+  """
+  Fuel added (liters): the level rise times config.fuelTankCapacityLiters, else the rise of
+  powertrainFuelSystemAbsoluteLevel. Null if unavailable.
+  """
+  litersAdded: Float
+  """litersAdded times config.fuelPricePerLiter. Null if either is unavailable."""
+  estimatedCost: Float
+}
+
+type ChargingSession {
+  """State of charge (percent) at the start of the session. Null if unavailable."""
+  socStart: Float
+  """State of charge (percent) at the end of the session. Null if unavailable."""
+  socEnd: Float
+  """
+  Battery capacity (kWh) used to estimate energyAddedKwh: config.batteryCapacityKwh, else
+  the last powertrainTractionBatteryGrossCapacity reading. Null if unavailable.
+  """
+  batteryCapacityKwh: Float
+  """
+  Energy added to the battery (kWh), estimated as the state of charge increase times
+  batteryCapacityKwh. Null if either is unavailable.
+  """
+  energyAddedKwh: Float
+  """Highest powertrainTractionBatteryChargingPower reading (kW). Null if unavailable."""
+  peakPowerKw: Float
+  """Average powertrainTractionBatteryChargingPower reading (kW). Null if unavailable."""
+  averagePowerKw: Float
+  """
+  Charger type: AC if an AC charging current was reported, DC if the vehicle reported no
+  AC current or charged faster than AC chargers can. Null if it can't be told.
+  """
+  chargerType: ChargerType
+  """Location of the vehicle at the start of the session. Null if unavailable."""
+  location: Location
+}
+
+enum ChargerType {
+  AC
+  DC
+}
+
+type SegmentStop {
+  """Mean position of the location samples during the stop."""
+  centroid: Location!
+  """
+  Whether ignition was on at any time during the stop, e.g. while waiting with the engine
+  running. Null if the vehicle has no isIgnitionOn data for the stop.
+  """
+  ignitionOn: Boolean
+}
+
+"""
+Driver behavior score. Every component ranges from 0 (worst) to 100 (best). The
+component weights in the overall score are set by the service operator.
+"""
+type DriverScore {
+  """Weighted average of the components."""
+  overall: Float!
+  """Based on behavior.harshBraking and behavior.extremeBraking events per hour of driving."""
+  harshBraking: Float!
+  """Based on behavior.harshAcceleration events per hour of driving."""
+  harshAcceleration: Float!
+  """Based on behavior.harshCornering events per hour of driving."""
+  harshCornering: Float!
+  """
+  Based on the share of speed samples over the speed limit set by the service operator; it
+  reaches 0 when 20% of the samples are over the limit, so a single glitched sample barely
+  counts. Null if the segments have no speed data; the overall score then leaves it out.
+  """
+  speeding: Float
+  """
+  Share of the driving time not spent at night (22:00 to 05:00), in solar time at the
+  start location, or UTC if the start location is unknown.
+  """
+  nightDriving: Float!
 }
 `, BuiltIn: false},
 	{Name: "../../schema/signals-events_gen.graphqls", Input: `# Code generated  with ` + "`" + `make gql-model` + "`" + ` DO NOT EDIT.
@@ -5747,6 +7141,16 @@ func (ec *executionContext) field_Query_availableSignals_args(ctx context.Contex
 		return nil, err
 	}
 	args["filter"] = arg1
+	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "from", ec.unmarshalOTime2ᚖtimeᚐTime)
+	if err != nil {
+		return nil, err
+	}
+	args["from"] = arg2
+	arg3, err := graphql.ProcessArgField(ctx, rawArgs, "to", ec.unmarshalOTime2ᚖtimeᚐTime)
+	if err != nil {
+		return nil, err
+	}
+	args["to"] = arg3
 	return args, nil
 }
 
@@ -5796,6 +7200,47 @@ func (ec *executionContext) field_Query_dailyActivity_args(ctx context.Context,
 	return args, nil
 }
 
+func (ec *executionContext) field_Query_dataCoverage_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "tokenId", ec.unmarshalNInt2int)
+	if err != nil {
+		return nil, err
+	}
+	args["tokenId"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "from", ec.unmarshalNTime2timeᚐTime)
+	if err != nil {
+		return nil, err
+	}
+	args["from"] = arg1
+	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "to", ec.unmarshalNTime2timeᚐTime)
+	if err != nil {
+		return nil, err
+	}
+	args["to"] = arg2
+	arg3, err := graphql.ProcessArgField(ctx, rawArgs, "interval", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
+	}
+	args["interval"] = arg3
+	arg4, err := graphql.ProcessArgField(ctx, rawArgs, "names", ec.unmarshalOString2ᚕstringᚄ)
+	if err != nil {
+		return nil, err
+	}
+	args["names"] = arg4
+	arg5, err := graphql.ProcessArgField(ctx, rawArgs, "minGapSeconds", ec.unmarshalOInt2ᚖint)
+	if err != nil {
+		return nil, err
+	}
+	args["minGapSeconds"] = arg5
+	arg6, err := graphql.ProcessArgField(ctx, rawArgs, "filter", ec.unmarshalOSignalFilter2ᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐSignalFilter)
+	if err != nil {
+		return nil, err
+	}
+	args["filter"] = arg6
+	return args, nil
+}
+
 func (ec *executionContext) field_Query_dataSummary_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
@@ -5809,6 +7254,83 @@ func (ec *executionContext) field_Query_dataSummary_args(ctx context.Context, ra
 		return nil, err
 	}
 	args["filter"] = arg1
+	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "from", ec.unmarshalOTime2ᚖtimeᚐTime)
+	if err != nil {
+		return nil, err
+	}
+	args["from"] = arg2
+	arg3, err := graphql.ProcessArgField(ctx, rawArgs, "to", ec.unmarshalOTime2ᚖtimeᚐTime)
+	if err != nil {
+		return nil, err
+	}
+	args["to"] = arg3
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_eventsAggregated_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "tokenId", ec.unmarshalNInt2int)
+	if err != nil {
+		return nil, err
+	}
+	args["tokenId"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "from", ec.unmarshalNTime2timeᚐTime)
+	if err != nil {
+		return nil, err
+	}
+	args["from"] = arg1
+	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "to", ec.unmarshalNTime2timeᚐTime)
+	if err != nil {
+		return nil, err
+	}
+	args["to"] = arg2
+	arg3, err := graphql.ProcessArgField(ctx, rawArgs, "interval", ec.unmarshalNString2string)
+	if err != nil {
+		return nil, err
+	}
+	args["interval"] = arg3
+	arg4, err := graphql.ProcessArgField(ctx, rawArgs, "filter", ec.unmarshalOEventFilter2ᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐEventFilter)
+	if err != nil {
+		return nil, err
+	}
+	args["filter"] = arg4
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_eventsPage_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "tokenId", ec.unmarshalNInt2int)
+	if err != nil {
+		return nil, err
+	}
+	args["tokenId"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "from", ec.unmarshalNTime2timeᚐTime)
+	if err != nil {
+		return nil, err
+	}
+	args["from"] = arg1
+	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "to", ec.unmarshalNTime2timeᚐTime)
+	if err != nil {
+		return nil, err
+	}
+	args["to"] = arg2
+	arg3, err := graphql.ProcessArgField(ctx, rawArgs, "filter", ec.unmarshalOEventFilter2ᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐEventFilter)
+	if err != nil {
+		return nil, err
+	}
+	args["filter"] = arg3
+	arg4, err := graphql.ProcessArgField(ctx, rawArgs, "limit", ec.unmarshalOInt2ᚖint)
+	if err != nil {
+		return nil, err
+	}
+	args["limit"] = arg4
+	arg5, err := graphql.ProcessArgField(ctx, rawArgs, "after", ec.unmarshalOTime2ᚖtimeᚐTime)
+	if err != nil {
+		return nil, err
+	}
+	args["after"] = arg5
 	return args, nil
 }
 
@@ -5835,6 +7357,83 @@ func (ec *executionContext) field_Query_events_args(ctx context.Context, rawArgs
 		return nil, err
 	}
 	args["filter"] = arg3
+	arg4, err := graphql.ProcessArgField(ctx, rawArgs, "derivedEvents", ec.unmarshalODerivedEventsConfig2ᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐDerivedEventsConfig)
+	if err != nil {
+		return nil, err
+	}
+	args["derivedEvents"] = arg4
+	arg5, err := graphql.ProcessArgField(ctx, rawArgs, "limit", ec.unmarshalOInt2ᚖint)
+	if err != nil {
+		return nil, err
+	}
+	args["limit"] = arg5
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_samplingStats_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "tokenId", ec.unmarshalNInt2int)
+	if err != nil {
+		return nil, err
+	}
+	args["tokenId"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "from", ec.unmarshalNTime2timeᚐTime)
+	if err != nil {
+		return nil, err
+	}
+	args["from"] = arg1
+	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "to", ec.unmarshalNTime2timeᚐTime)
+	if err != nil {
+		return nil, err
+	}
+	args["to"] = arg2
+	arg3, err := graphql.ProcessArgField(ctx, rawArgs, "names", ec.unmarshalOString2ᚕstringᚄ)
+	if err != nil {
+		return nil, err
+	}
+	args["names"] = arg3
+	arg4, err := graphql.ProcessArgField(ctx, rawArgs, "filter", ec.unmarshalOSignalFilter2ᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐSignalFilter)
+	if err != nil {
+		return nil, err
+	}
+	args["filter"] = arg4
+	return args, nil
+}
+
+func (ec *executionContext) field_Query_segment_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "tokenId", ec.unmarshalNInt2int)
+	if err != nil {
+		return nil, err
+	}
+	args["tokenId"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "id", ec.unmarshalNID2string)
+	if err != nil {
+		return nil, err
+	}
+	args["id"] = arg1
+	arg2, err := graphql.ProcessArgField(ctx, rawArgs, "mechanism", ec.unmarshalNDetectionMechanism2githubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐDetectionMechanism)
+	if err != nil {
+		return nil, err
+	}
+	args["mechanism"] = arg2
+	arg3, err := graphql.ProcessArgField(ctx, rawArgs, "config", ec.unmarshalOSegmentConfig2ᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐSegmentConfig)
+	if err != nil {
+		return nil, err
+	}
+	args["config"] = arg3
+	arg4, err := graphql.ProcessArgField(ctx, rawArgs, "signalRequests", ec.unmarshalOSegmentSignalRequest2ᚕᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐSegmentSignalRequestᚄ)
+	if err != nil {
+		return nil, err
+	}
+	args["signalRequests"] = arg4
+	arg5, err := graphql.ProcessArgField(ctx, rawArgs, "eventRequests", ec.unmarshalOSegmentEventRequest2ᚕᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐSegmentEventRequestᚄ)
+	if err != nil {
+		return nil, err
+	}
+	args["eventRequests"] = arg5
 	return args, nil
 }
 
@@ -7800,6 +9399,38 @@ func (ec *executionContext) field_SignalAggregations_speed_args(ctx context.Cont
 	return args, nil
 }
 
+func (ec *executionContext) field_Subscription_events_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "tokenId", ec.unmarshalNInt2int)
+	if err != nil {
+		return nil, err
+	}
+	args["tokenId"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "filter", ec.unmarshalOEventFilter2ᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐEventFilter)
+	if err != nil {
+		return nil, err
+	}
+	args["filter"] = arg1
+	return args, nil
+}
+
+func (ec *executionContext) field_Subscription_signalsLatest_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
+	var err error
+	args := map[string]any{}
+	arg0, err := graphql.ProcessArgField(ctx, rawArgs, "tokenId", ec.unmarshalNInt2int)
+	if err != nil {
+		return nil, err
+	}
+	args["tokenId"] = arg0
+	arg1, err := graphql.ProcessArgField(ctx, rawArgs, "filter", ec.unmarshalOSignalFilter2ᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐSignalFilter)
+	if err != nil {
+		return nil, err
+	}
+	args["filter"] = arg1
+	return args, nil
+}
+
 func (ec *executionContext) field___Directive_args_args(ctx context.Context, rawArgs map[string]any) (map[string]any, error) {
 	var err error
 	args := map[string]any{}
@@ -8142,272 +9773,254 @@ func (ec *executionContext) fieldContext_Attestation_tags(_ context.Context, fie
 	return fc, nil
 }
 
-func (ec *executionContext) _DailyActivity_start(ctx context.Context, field graphql.CollectedField, obj *model.DailyActivity) (ret graphql.Marshaler) {
+func (ec *executionContext) _ChargingSession_socStart(ctx context.Context, field graphql.CollectedField, obj *model.ChargingSession) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_DailyActivity_start,
+		ec.fieldContext_ChargingSession_socStart,
 		func(ctx context.Context) (any, error) {
-			return obj.Start, nil
+			return obj.SocStart, nil
 		},
 		nil,
-		ec.marshalOSignalLocation2ᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐSignalLocation,
+		ec.marshalOFloat2ᚖfloat64,
 		true,
 		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_DailyActivity_start(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ChargingSession_socStart(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "DailyActivity",
+		Object:     "ChargingSession",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "timestamp":
-				return ec.fieldContext_SignalLocation_timestamp(ctx, field)
-			case "value":
-				return ec.fieldContext_SignalLocation_value(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type SignalLocation", field.Name)
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _DailyActivity_end(ctx context.Context, field graphql.CollectedField, obj *model.DailyActivity) (ret graphql.Marshaler) {
+func (ec *executionContext) _ChargingSession_socEnd(ctx context.Context, field graphql.CollectedField, obj *model.ChargingSession) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_DailyActivity_end,
+		ec.fieldContext_ChargingSession_socEnd,
 		func(ctx context.Context) (any, error) {
-			return obj.End, nil
+			return obj.SocEnd, nil
 		},
 		nil,
-		ec.marshalOSignalLocation2ᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐSignalLocation,
+		ec.marshalOFloat2ᚖfloat64,
 		true,
 		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_DailyActivity_end(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ChargingSession_socEnd(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "DailyActivity",
+		Object:     "ChargingSession",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "timestamp":
-				return ec.fieldContext_SignalLocation_timestamp(ctx, field)
-			case "value":
-				return ec.fieldContext_SignalLocation_value(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type SignalLocation", field.Name)
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _DailyActivity_segmentCount(ctx context.Context, field graphql.CollectedField, obj *model.DailyActivity) (ret graphql.Marshaler) {
+func (ec *executionContext) _ChargingSession_batteryCapacityKwh(ctx context.Context, field graphql.CollectedField, obj *model.ChargingSession) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_DailyActivity_segmentCount,
+		ec.fieldContext_ChargingSession_batteryCapacityKwh,
 		func(ctx context.Context) (any, error) {
-			return obj.SegmentCount, nil
+			return obj.BatteryCapacityKwh, nil
 		},
 		nil,
-		ec.marshalNInt2int,
-		true,
+		ec.marshalOFloat2ᚖfloat64,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_DailyActivity_segmentCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ChargingSession_batteryCapacityKwh(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "DailyActivity",
+		Object:     "ChargingSession",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _DailyActivity_duration(ctx context.Context, field graphql.CollectedField, obj *model.DailyActivity) (ret graphql.Marshaler) {
+func (ec *executionContext) _ChargingSession_energyAddedKwh(ctx context.Context, field graphql.CollectedField, obj *model.ChargingSession) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_DailyActivity_duration,
+		ec.fieldContext_ChargingSession_energyAddedKwh,
 		func(ctx context.Context) (any, error) {
-			return obj.Duration, nil
+			return obj.EnergyAddedKwh, nil
 		},
 		nil,
-		ec.marshalNInt2int,
-		true,
+		ec.marshalOFloat2ᚖfloat64,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_DailyActivity_duration(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ChargingSession_energyAddedKwh(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "DailyActivity",
+		Object:     "ChargingSession",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _DailyActivity_signals(ctx context.Context, field graphql.CollectedField, obj *model.DailyActivity) (ret graphql.Marshaler) {
+func (ec *executionContext) _ChargingSession_peakPowerKw(ctx context.Context, field graphql.CollectedField, obj *model.ChargingSession) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_DailyActivity_signals,
+		ec.fieldContext_ChargingSession_peakPowerKw,
 		func(ctx context.Context) (any, error) {
-			return obj.Signals, nil
+			return obj.PeakPowerKw, nil
 		},
 		nil,
-		ec.marshalNSignalAggregationValue2ᚕᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐSignalAggregationValueᚄ,
-		true,
+		ec.marshalOFloat2ᚖfloat64,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_DailyActivity_signals(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ChargingSession_peakPowerKw(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "DailyActivity",
+		Object:     "ChargingSession",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext_SignalAggregationValue_name(ctx, field)
-			case "agg":
-				return ec.fieldContext_SignalAggregationValue_agg(ctx, field)
-			case "value":
-				return ec.fieldContext_SignalAggregationValue_value(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type SignalAggregationValue", field.Name)
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _DailyActivity_eventCounts(ctx context.Context, field graphql.CollectedField, obj *model.DailyActivity) (ret graphql.Marshaler) {
+func (ec *executionContext) _ChargingSession_averagePowerKw(ctx context.Context, field graphql.CollectedField, obj *model.ChargingSession) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_DailyActivity_eventCounts,
+		ec.fieldContext_ChargingSession_averagePowerKw,
 		func(ctx context.Context) (any, error) {
-			return obj.EventCounts, nil
+			return obj.AveragePowerKw, nil
 		},
 		nil,
-		ec.marshalNEventCount2ᚕᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐEventCountᚄ,
-		true,
+		ec.marshalOFloat2ᚖfloat64,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_DailyActivity_eventCounts(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ChargingSession_averagePowerKw(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "DailyActivity",
+		Object:     "ChargingSession",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "name":
-				return ec.fieldContext_EventCount_name(ctx, field)
-			case "count":
-				return ec.fieldContext_EventCount_count(ctx, field)
-			}
-			return nil, fmt.Errorf("no field named %q was found under type EventCount", field.Name)
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _DataSummary_numberOfSignals(ctx context.Context, field graphql.CollectedField, obj *model.DataSummary) (ret graphql.Marshaler) {
+func (ec *executionContext) _ChargingSession_chargerType(ctx context.Context, field graphql.CollectedField, obj *model.ChargingSession) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_DataSummary_numberOfSignals,
+		ec.fieldContext_ChargingSession_chargerType,
 		func(ctx context.Context) (any, error) {
-			return obj.NumberOfSignals, nil
+			return obj.ChargerType, nil
 		},
 		nil,
-		ec.marshalNUint642uint64,
-		true,
+		ec.marshalOChargerType2ᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐChargerType,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_DataSummary_numberOfSignals(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ChargingSession_chargerType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "DataSummary",
+		Object:     "ChargingSession",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Uint64 does not have child fields")
+			return nil, errors.New("field of type ChargerType does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _DataSummary_availableSignals(ctx context.Context, field graphql.CollectedField, obj *model.DataSummary) (ret graphql.Marshaler) {
+func (ec *executionContext) _ChargingSession_location(ctx context.Context, field graphql.CollectedField, obj *model.ChargingSession) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_DataSummary_availableSignals,
+		ec.fieldContext_ChargingSession_location,
 		func(ctx context.Context) (any, error) {
-			return obj.AvailableSignals, nil
+			return obj.Location, nil
 		},
 		nil,
-		ec.marshalNString2ᚕstringᚄ,
-		true,
+		ec.marshalOLocation2ᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐLocation,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_DataSummary_availableSignals(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_ChargingSession_location(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "DataSummary",
+		Object:     "ChargingSession",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "latitude":
+				return ec.fieldContext_Location_latitude(ctx, field)
+			case "longitude":
+				return ec.fieldContext_Location_longitude(ctx, field)
+			case "hdop":
+				return ec.fieldContext_Location_hdop(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Location", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _DataSummary_firstSeen(ctx context.Context, field graphql.CollectedField, obj *model.DataSummary) (ret graphql.Marshaler) {
+func (ec *executionContext) _CoverageBucket_timestamp(ctx context.Context, field graphql.CollectedField, obj *model.CoverageBucket) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_DataSummary_firstSeen,
+		ec.fieldContext_CoverageBucket_timestamp,
 		func(ctx context.Context) (any, error) {
-			return obj.FirstSeen, nil
+			return obj.Timestamp, nil
 		},
 		nil,
 		ec.marshalNTime2timeᚐTime,
@@ -8416,9 +10029,9 @@ func (ec *executionContext) _DataSummary_firstSeen(ctx context.Context, field gr
 	)
 }
 
-func (ec *executionContext) fieldContext_DataSummary_firstSeen(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CoverageBucket_timestamp(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "DataSummary",
+		Object:     "CoverageBucket",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -8429,451 +10042,471 @@ func (ec *executionContext) fieldContext_DataSummary_firstSeen(_ context.Context
 	return fc, nil
 }
 
-func (ec *executionContext) _DataSummary_lastSeen(ctx context.Context, field graphql.CollectedField, obj *model.DataSummary) (ret graphql.Marshaler) {
+func (ec *executionContext) _CoverageBucket_count(ctx context.Context, field graphql.CollectedField, obj *model.CoverageBucket) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_DataSummary_lastSeen,
+		ec.fieldContext_CoverageBucket_count,
 		func(ctx context.Context) (any, error) {
-			return obj.LastSeen, nil
+			return obj.Count, nil
 		},
 		nil,
-		ec.marshalNTime2timeᚐTime,
+		ec.marshalNInt2int,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_DataSummary_lastSeen(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_CoverageBucket_count(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "DataSummary",
+		Object:     "CoverageBucket",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _DataSummary_signalDataSummary(ctx context.Context, field graphql.CollectedField, obj *model.DataSummary) (ret graphql.Marshaler) {
+func (ec *executionContext) _DailyActivity_start(ctx context.Context, field graphql.CollectedField, obj *model.DailyActivity) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_DataSummary_signalDataSummary,
+		ec.fieldContext_DailyActivity_start,
 		func(ctx context.Context) (any, error) {
-			return obj.SignalDataSummary, nil
+			return obj.Start, nil
 		},
 		nil,
-		ec.marshalNSignalDataSummary2ᚕᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐSignalDataSummaryᚄ,
-		true,
+		ec.marshalOSignalLocation2ᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐSignalLocation,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_DataSummary_signalDataSummary(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DailyActivity_start(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "DataSummary",
+		Object:     "DailyActivity",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "name":
-				return ec.fieldContext_SignalDataSummary_name(ctx, field)
-			case "numberOfSignals":
-				return ec.fieldContext_SignalDataSummary_numberOfSignals(ctx, field)
-			case "firstSeen":
-				return ec.fieldContext_SignalDataSummary_firstSeen(ctx, field)
-			case "lastSeen":
-				return ec.fieldContext_SignalDataSummary_lastSeen(ctx, field)
+			case "timestamp":
+				return ec.fieldContext_SignalLocation_timestamp(ctx, field)
+			case "value":
+				return ec.fieldContext_SignalLocation_value(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type SignalDataSummary", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type SignalLocation", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _DataSummary_eventDataSummary(ctx context.Context, field graphql.CollectedField, obj *model.DataSummary) (ret graphql.Marshaler) {
+func (ec *executionContext) _DailyActivity_end(ctx context.Context, field graphql.CollectedField, obj *model.DailyActivity) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_DataSummary_eventDataSummary,
+		ec.fieldContext_DailyActivity_end,
 		func(ctx context.Context) (any, error) {
-			return obj.EventDataSummary, nil
+			return obj.End, nil
 		},
 		nil,
-		ec.marshalNEventDataSummary2ᚕᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐEventDataSummaryᚄ,
-		true,
+		ec.marshalOSignalLocation2ᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐSignalLocation,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_DataSummary_eventDataSummary(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DailyActivity_end(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "DataSummary",
+		Object:     "DailyActivity",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "name":
-				return ec.fieldContext_EventDataSummary_name(ctx, field)
-			case "numberOfEvents":
-				return ec.fieldContext_EventDataSummary_numberOfEvents(ctx, field)
-			case "firstSeen":
-				return ec.fieldContext_EventDataSummary_firstSeen(ctx, field)
-			case "lastSeen":
-				return ec.fieldContext_EventDataSummary_lastSeen(ctx, field)
+			case "timestamp":
+				return ec.fieldContext_SignalLocation_timestamp(ctx, field)
+			case "value":
+				return ec.fieldContext_SignalLocation_value(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type EventDataSummary", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type SignalLocation", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Event_timestamp(ctx context.Context, field graphql.CollectedField, obj *model.Event) (ret graphql.Marshaler) {
+func (ec *executionContext) _DailyActivity_segmentCount(ctx context.Context, field graphql.CollectedField, obj *model.DailyActivity) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Event_timestamp,
+		ec.fieldContext_DailyActivity_segmentCount,
 		func(ctx context.Context) (any, error) {
-			return obj.Timestamp, nil
+			return obj.SegmentCount, nil
 		},
 		nil,
-		ec.marshalNTime2timeᚐTime,
+		ec.marshalNInt2int,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Event_timestamp(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DailyActivity_segmentCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Event",
+		Object:     "DailyActivity",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Event_name(ctx context.Context, field graphql.CollectedField, obj *model.Event) (ret graphql.Marshaler) {
+func (ec *executionContext) _DailyActivity_duration(ctx context.Context, field graphql.CollectedField, obj *model.DailyActivity) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Event_name,
+		ec.fieldContext_DailyActivity_duration,
 		func(ctx context.Context) (any, error) {
-			return obj.Name, nil
+			return obj.Duration, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNInt2int,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Event_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DailyActivity_duration(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Event",
+		Object:     "DailyActivity",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Event_source(ctx context.Context, field graphql.CollectedField, obj *model.Event) (ret graphql.Marshaler) {
+func (ec *executionContext) _DailyActivity_signals(ctx context.Context, field graphql.CollectedField, obj *model.DailyActivity) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Event_source,
+		ec.fieldContext_DailyActivity_signals,
 		func(ctx context.Context) (any, error) {
-			return obj.Source, nil
+			return obj.Signals, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNSignalAggregationValue2ᚕᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐSignalAggregationValueᚄ,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Event_source(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DailyActivity_signals(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Event",
+		Object:     "DailyActivity",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "name":
+				return ec.fieldContext_SignalAggregationValue_name(ctx, field)
+			case "agg":
+				return ec.fieldContext_SignalAggregationValue_agg(ctx, field)
+			case "value":
+				return ec.fieldContext_SignalAggregationValue_value(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SignalAggregationValue", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Event_durationNs(ctx context.Context, field graphql.CollectedField, obj *model.Event) (ret graphql.Marshaler) {
+func (ec *executionContext) _DailyActivity_eventCounts(ctx context.Context, field graphql.CollectedField, obj *model.DailyActivity) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Event_durationNs,
+		ec.fieldContext_DailyActivity_eventCounts,
 		func(ctx context.Context) (any, error) {
-			return obj.DurationNs, nil
+			return obj.EventCounts, nil
 		},
 		nil,
-		ec.marshalNInt2int,
+		ec.marshalNEventCount2ᚕᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐEventCountᚄ,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_Event_durationNs(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DailyActivity_eventCounts(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Event",
+		Object:     "DailyActivity",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			switch field.Name {
+			case "name":
+				return ec.fieldContext_EventCount_name(ctx, field)
+			case "count":
+				return ec.fieldContext_EventCount_count(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type EventCount", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Event_metadata(ctx context.Context, field graphql.CollectedField, obj *model.Event) (ret graphql.Marshaler) {
+func (ec *executionContext) _DailyActivity_score(ctx context.Context, field graphql.CollectedField, obj *model.DailyActivity) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Event_metadata,
+		ec.fieldContext_DailyActivity_score,
 		func(ctx context.Context) (any, error) {
-			return obj.Metadata, nil
+			return obj.Score, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalODriverScore2ᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐDriverScore,
 		true,
 		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Event_metadata(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DailyActivity_score(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Event",
+		Object:     "DailyActivity",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "overall":
+				return ec.fieldContext_DriverScore_overall(ctx, field)
+			case "harshBraking":
+				return ec.fieldContext_DriverScore_harshBraking(ctx, field)
+			case "harshAcceleration":
+				return ec.fieldContext_DriverScore_harshAcceleration(ctx, field)
+			case "harshCornering":
+				return ec.fieldContext_DriverScore_harshCornering(ctx, field)
+			case "speeding":
+				return ec.fieldContext_DriverScore_speeding(ctx, field)
+			case "nightDriving":
+				return ec.fieldContext_DriverScore_nightDriving(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type DriverScore", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _EventCount_name(ctx context.Context, field graphql.CollectedField, obj *model.EventCount) (ret graphql.Marshaler) {
+func (ec *executionContext) _DailyActivity_distanceKm(ctx context.Context, field graphql.CollectedField, obj *model.DailyActivity) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_EventCount_name,
+		ec.fieldContext_DailyActivity_distanceKm,
 		func(ctx context.Context) (any, error) {
-			return obj.Name, nil
+			return obj.DistanceKm, nil
 		},
 		nil,
-		ec.marshalNString2string,
-		true,
+		ec.marshalOFloat2ᚖfloat64,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_EventCount_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DailyActivity_distanceKm(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "EventCount",
+		Object:     "DailyActivity",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Float does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _EventCount_count(ctx context.Context, field graphql.CollectedField, obj *model.EventCount) (ret graphql.Marshaler) {
+func (ec *executionContext) _DailyActivity_distanceSource(ctx context.Context, field graphql.CollectedField, obj *model.DailyActivity) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_EventCount_count,
+		ec.fieldContext_DailyActivity_distanceSource,
 		func(ctx context.Context) (any, error) {
-			return obj.Count, nil
+			return obj.DistanceSource, nil
 		},
 		nil,
-		ec.marshalNInt2int,
-		true,
+		ec.marshalODistanceSource2ᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐDistanceSource,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_EventCount_count(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DailyActivity_distanceSource(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "EventCount",
+		Object:     "DailyActivity",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Int does not have child fields")
+			return nil, errors.New("field of type DistanceSource does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _EventDataSummary_name(ctx context.Context, field graphql.CollectedField, obj *model.EventDataSummary) (ret graphql.Marshaler) {
+func (ec *executionContext) _DataGap_start(ctx context.Context, field graphql.CollectedField, obj *model.DataGap) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_EventDataSummary_name,
+		ec.fieldContext_DataGap_start,
 		func(ctx context.Context) (any, error) {
-			return obj.Name, nil
+			return obj.Start, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNTime2timeᚐTime,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_EventDataSummary_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DataGap_start(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "EventDataSummary",
+		Object:     "DataGap",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _EventDataSummary_numberOfEvents(ctx context.Context, field graphql.CollectedField, obj *model.EventDataSummary) (ret graphql.Marshaler) {
+func (ec *executionContext) _DataGap_end(ctx context.Context, field graphql.CollectedField, obj *model.DataGap) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_EventDataSummary_numberOfEvents,
+		ec.fieldContext_DataGap_end,
 		func(ctx context.Context) (any, error) {
-			return obj.NumberOfEvents, nil
+			return obj.End, nil
 		},
 		nil,
-		ec.marshalNUint642uint64,
+		ec.marshalNTime2timeᚐTime,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_EventDataSummary_numberOfEvents(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DataGap_end(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "EventDataSummary",
+		Object:     "DataGap",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Uint64 does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _EventDataSummary_firstSeen(ctx context.Context, field graphql.CollectedField, obj *model.EventDataSummary) (ret graphql.Marshaler) {
+func (ec *executionContext) _DataGap_duration(ctx context.Context, field graphql.CollectedField, obj *model.DataGap) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_EventDataSummary_firstSeen,
+		ec.fieldContext_DataGap_duration,
 		func(ctx context.Context) (any, error) {
-			return obj.FirstSeen, nil
+			return obj.Duration, nil
 		},
 		nil,
-		ec.marshalNTime2timeᚐTime,
+		ec.marshalNInt2int,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_EventDataSummary_firstSeen(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DataGap_duration(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "EventDataSummary",
+		Object:     "DataGap",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			return nil, errors.New("field of type Int does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _EventDataSummary_lastSeen(ctx context.Context, field graphql.CollectedField, obj *model.EventDataSummary) (ret graphql.Marshaler) {
+func (ec *executionContext) _DataSummary_numberOfSignals(ctx context.Context, field graphql.CollectedField, obj *model.DataSummary) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_EventDataSummary_lastSeen,
+		ec.fieldContext_DataSummary_numberOfSignals,
 		func(ctx context.Context) (any, error) {
-			return obj.LastSeen, nil
+			return obj.NumberOfSignals, nil
 		},
 		nil,
-		ec.marshalNTime2timeᚐTime,
+		ec.marshalNUint642uint64,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_EventDataSummary_lastSeen(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DataSummary_numberOfSignals(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "EventDataSummary",
+		Object:     "DataSummary",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Time does not have child fields")
+			return nil, errors.New("field of type Uint64 does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LatestSignal_name(ctx context.Context, field graphql.CollectedField, obj *model.LatestSignal) (ret graphql.Marshaler) {
+func (ec *executionContext) _DataSummary_availableSignals(ctx context.Context, field graphql.CollectedField, obj *model.DataSummary) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_LatestSignal_name,
+		ec.fieldContext_DataSummary_availableSignals,
 		func(ctx context.Context) (any, error) {
-			return obj.Name, nil
+			return obj.AvailableSignals, nil
 		},
 		nil,
-		ec.marshalNString2string,
+		ec.marshalNString2ᚕstringᚄ,
 		true,
 		true,
 	)
 }
 
-func (ec *executionContext) fieldContext_LatestSignal_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DataSummary_availableSignals(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LatestSignal",
+		Object:     "DataSummary",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -8884,14 +10517,14 @@ func (ec *executionContext) fieldContext_LatestSignal_name(_ context.Context, fi
 	return fc, nil
 }
 
-func (ec *executionContext) _LatestSignal_timestamp(ctx context.Context, field graphql.CollectedField, obj *model.LatestSignal) (ret graphql.Marshaler) {
+func (ec *executionContext) _DataSummary_firstSeen(ctx context.Context, field graphql.CollectedField, obj *model.DataSummary) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_LatestSignal_timestamp,
+		ec.fieldContext_DataSummary_firstSeen,
 		func(ctx context.Context) (any, error) {
-			return obj.Timestamp, nil
+			return obj.FirstSeen, nil
 		},
 		nil,
 		ec.marshalNTime2timeᚐTime,
@@ -8900,9 +10533,9 @@ func (ec *executionContext) _LatestSignal_timestamp(ctx context.Context, field g
 	)
 }
 
-func (ec *executionContext) fieldContext_LatestSignal_timestamp(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DataSummary_firstSeen(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LatestSignal",
+		Object:     "DataSummary",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -8913,109 +10546,121 @@ func (ec *executionContext) fieldContext_LatestSignal_timestamp(_ context.Contex
 	return fc, nil
 }
 
-func (ec *executionContext) _LatestSignal_valueNumber(ctx context.Context, field graphql.CollectedField, obj *model.LatestSignal) (ret graphql.Marshaler) {
+func (ec *executionContext) _DataSummary_lastSeen(ctx context.Context, field graphql.CollectedField, obj *model.DataSummary) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_LatestSignal_valueNumber,
+		ec.fieldContext_DataSummary_lastSeen,
 		func(ctx context.Context) (any, error) {
-			return obj.ValueNumber, nil
+			return obj.LastSeen, nil
 		},
 		nil,
-		ec.marshalOFloat2ᚖfloat64,
+		ec.marshalNTime2timeᚐTime,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_LatestSignal_valueNumber(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DataSummary_lastSeen(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LatestSignal",
+		Object:     "DataSummary",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type Float does not have child fields")
+			return nil, errors.New("field of type Time does not have child fields")
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LatestSignal_valueString(ctx context.Context, field graphql.CollectedField, obj *model.LatestSignal) (ret graphql.Marshaler) {
+func (ec *executionContext) _DataSummary_signalDataSummary(ctx context.Context, field graphql.CollectedField, obj *model.DataSummary) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_LatestSignal_valueString,
+		ec.fieldContext_DataSummary_signalDataSummary,
 		func(ctx context.Context) (any, error) {
-			return obj.ValueString, nil
+			return obj.SignalDataSummary, nil
 		},
 		nil,
-		ec.marshalOString2ᚖstring,
+		ec.marshalNSignalDataSummary2ᚕᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐSignalDataSummaryᚄ,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_LatestSignal_valueString(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DataSummary_signalDataSummary(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LatestSignal",
+		Object:     "DataSummary",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			return nil, errors.New("field of type String does not have child fields")
+			switch field.Name {
+			case "name":
+				return ec.fieldContext_SignalDataSummary_name(ctx, field)
+			case "numberOfSignals":
+				return ec.fieldContext_SignalDataSummary_numberOfSignals(ctx, field)
+			case "firstSeen":
+				return ec.fieldContext_SignalDataSummary_firstSeen(ctx, field)
+			case "lastSeen":
+				return ec.fieldContext_SignalDataSummary_lastSeen(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SignalDataSummary", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _LatestSignal_valueLocation(ctx context.Context, field graphql.CollectedField, obj *model.LatestSignal) (ret graphql.Marshaler) {
+func (ec *executionContext) _DataSummary_eventDataSummary(ctx context.Context, field graphql.CollectedField, obj *model.DataSummary) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_LatestSignal_valueLocation,
+		ec.fieldContext_DataSummary_eventDataSummary,
 		func(ctx context.Context) (any, error) {
-			return obj.ValueLocation, nil
+			return obj.EventDataSummary, nil
 		},
 		nil,
-		ec.marshalOLocation2ᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐLocation,
+		ec.marshalNEventDataSummary2ᚕᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐEventDataSummaryᚄ,
+		true,
 		true,
-		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_LatestSignal_valueLocation(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DataSummary_eventDataSummary(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "LatestSignal",
+		Object:     "DataSummary",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
-			case "latitude":
-				return ec.fieldContext_Location_latitude(ctx, field)
-			case "longitude":
-				return ec.fieldContext_Location_longitude(ctx, field)
-			case "hdop":
-				return ec.fieldContext_Location_hdop(ctx, field)
+			case "name":
+				return ec.fieldContext_EventDataSummary_name(ctx, field)
+			case "numberOfEvents":
+				return ec.fieldContext_EventDataSummary_numberOfEvents(ctx, field)
+			case "firstSeen":
+				return ec.fieldContext_EventDataSummary_firstSeen(ctx, field)
+			case "lastSeen":
+				return ec.fieldContext_EventDataSummary_lastSeen(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Location", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type EventDataSummary", field.Name)
 		},
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Location_latitude(ctx context.Context, field graphql.CollectedField, obj *model.Location) (ret graphql.Marshaler) {
+func (ec *executionContext) _DriverScore_overall(ctx context.Context, field graphql.CollectedField, obj *model.DriverScore) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Location_latitude,
+		ec.fieldContext_DriverScore_overall,
 		func(ctx context.Context) (any, error) {
-			return obj.Latitude, nil
+			return obj.Overall, nil
 		},
 		nil,
 		ec.marshalNFloat2float64,
@@ -9024,9 +10669,9 @@ func (ec *executionContext) _Location_latitude(ctx context.Context, field graphq
 	)
 }
 
-func (ec *executionContext) fieldContext_Location_latitude(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DriverScore_overall(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Location",
+		Object:     "DriverScore",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -9037,14 +10682,14 @@ func (ec *executionContext) fieldContext_Location_latitude(_ context.Context, fi
 	return fc, nil
 }
 
-func (ec *executionContext) _Location_longitude(ctx context.Context, field graphql.CollectedField, obj *model.Location) (ret graphql.Marshaler) {
+func (ec *executionContext) _DriverScore_harshBraking(ctx context.Context, field graphql.CollectedField, obj *model.DriverScore) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Location_longitude,
+		ec.fieldContext_DriverScore_harshBraking,
 		func(ctx context.Context) (any, error) {
-			return obj.Longitude, nil
+			return obj.HarshBraking, nil
 		},
 		nil,
 		ec.marshalNFloat2float64,
@@ -9053,9 +10698,9 @@ func (ec *executionContext) _Location_longitude(ctx context.Context, field graph
 	)
 }
 
-func (ec *executionContext) fieldContext_Location_longitude(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DriverScore_harshBraking(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Location",
+		Object:     "DriverScore",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -9066,14 +10711,14 @@ func (ec *executionContext) fieldContext_Location_longitude(_ context.Context, f
 	return fc, nil
 }
 
-func (ec *executionContext) _Location_hdop(ctx context.Context, field graphql.CollectedField, obj *model.Location) (ret graphql.Marshaler) {
+func (ec *executionContext) _DriverScore_harshAcceleration(ctx context.Context, field graphql.CollectedField, obj *model.DriverScore) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Location_hdop,
+		ec.fieldContext_DriverScore_harshAcceleration,
 		func(ctx context.Context) (any, error) {
-			return obj.Hdop, nil
+			return obj.HarshAcceleration, nil
 		},
 		nil,
 		ec.marshalNFloat2float64,
@@ -9082,9 +10727,9 @@ func (ec *executionContext) _Location_hdop(ctx context.Context, field graphql.Co
 	)
 }
 
-func (ec *executionContext) fieldContext_Location_hdop(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DriverScore_harshAcceleration(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Location",
+		Object:     "DriverScore",
 		Field:      field,
 		IsMethod:   false,
 		IsResolver: false,
@@ -9095,67 +10740,1054 @@ func (ec *executionContext) fieldContext_Location_hdop(_ context.Context, field
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_signals(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _DriverScore_harshCornering(ctx context.Context, field graphql.CollectedField, obj *model.DriverScore) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query_signals,
+		ec.fieldContext_DriverScore_harshCornering,
 		func(ctx context.Context) (any, error) {
-			fc := graphql.GetFieldContext(ctx)
-			return ec.Resolvers.Query().Signals(ctx, fc.Args["tokenId"].(int), fc.Args["interval"].(string), fc.Args["from"].(time.Time), fc.Args["to"].(time.Time), fc.Args["filter"].(*model.SignalFilter))
+			return obj.HarshCornering, nil
 		},
-		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
-			directive0 := next
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
 
-			directive1 := func(ctx context.Context) (any, error) {
-				if ec.Directives.RequiresVehicleToken == nil {
-					var zeroVal []*model.SignalAggregations
-					return zeroVal, errors.New("directive requiresVehicleToken is not implemented")
-				}
-				return ec.Directives.RequiresVehicleToken(ctx, nil, directive0)
-			}
+func (ec *executionContext) fieldContext_DriverScore_harshCornering(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DriverScore",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
 
-			next = directive1
-			return next
+func (ec *executionContext) _DriverScore_speeding(ctx context.Context, field graphql.CollectedField, obj *model.DriverScore) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DriverScore_speeding,
+		func(ctx context.Context) (any, error) {
+			return obj.Speeding, nil
 		},
-		ec.marshalOSignalAggregations2ᚕᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐSignalAggregationsᚄ,
+		nil,
+		ec.marshalOFloat2ᚖfloat64,
 		true,
 		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query_signals(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_DriverScore_speeding(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
-		Object:     "Query",
+		Object:     "DriverScore",
 		Field:      field,
-		IsMethod:   true,
-		IsResolver: true,
+		IsMethod:   false,
+		IsResolver: false,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
-			switch field.Name {
-			case "timestamp":
-				return ec.fieldContext_SignalAggregations_timestamp(ctx, field)
-			case "currentLocationApproximateCoordinates":
-				return ec.fieldContext_SignalAggregations_currentLocationApproximateCoordinates(ctx, field)
-			case "angularVelocityYaw":
-				return ec.fieldContext_SignalAggregations_angularVelocityYaw(ctx, field)
-			case "bodyLightsIsAirbagWarningOn":
-				return ec.fieldContext_SignalAggregations_bodyLightsIsAirbagWarningOn(ctx, field)
-			case "bodyLockIsLocked":
-				return ec.fieldContext_SignalAggregations_bodyLockIsLocked(ctx, field)
-			case "bodyTrunkFrontIsOpen":
-				return ec.fieldContext_SignalAggregations_bodyTrunkFrontIsOpen(ctx, field)
-			case "bodyTrunkRearIsOpen":
-				return ec.fieldContext_SignalAggregations_bodyTrunkRearIsOpen(ctx, field)
-			case "cabinDoorRow1DriverSideIsOpen":
-				return ec.fieldContext_SignalAggregations_cabinDoorRow1DriverSideIsOpen(ctx, field)
-			case "cabinDoorRow1DriverSideWindowIsOpen":
-				return ec.fieldContext_SignalAggregations_cabinDoorRow1DriverSideWindowIsOpen(ctx, field)
-			case "cabinDoorRow1PassengerSideIsOpen":
-				return ec.fieldContext_SignalAggregations_cabinDoorRow1PassengerSideIsOpen(ctx, field)
-			case "cabinDoorRow1PassengerSideWindowIsOpen":
-				return ec.fieldContext_SignalAggregations_cabinDoorRow1PassengerSideWindowIsOpen(ctx, field)
-			case "cabinDoorRow2DriverSideIsOpen":
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _DriverScore_nightDriving(ctx context.Context, field graphql.CollectedField, obj *model.DriverScore) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_DriverScore_nightDriving,
+		func(ctx context.Context) (any, error) {
+			return obj.NightDriving, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_DriverScore_nightDriving(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "DriverScore",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Event_timestamp(ctx context.Context, field graphql.CollectedField, obj *model.Event) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Event_timestamp,
+		func(ctx context.Context) (any, error) {
+			return obj.Timestamp, nil
+		},
+		nil,
+		ec.marshalNTime2timeᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Event_timestamp(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Event",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Event_name(ctx context.Context, field graphql.CollectedField, obj *model.Event) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Event_name,
+		func(ctx context.Context) (any, error) {
+			return obj.Name, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Event_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Event",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Event_source(ctx context.Context, field graphql.CollectedField, obj *model.Event) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Event_source,
+		func(ctx context.Context) (any, error) {
+			return obj.Source, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Event_source(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Event",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Event_durationNs(ctx context.Context, field graphql.CollectedField, obj *model.Event) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Event_durationNs,
+		func(ctx context.Context) (any, error) {
+			return obj.DurationNs, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Event_durationNs(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Event",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Event_metadata(ctx context.Context, field graphql.CollectedField, obj *model.Event) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Event_metadata,
+		func(ctx context.Context) (any, error) {
+			return obj.Metadata, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Event_metadata(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Event",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Event_location(ctx context.Context, field graphql.CollectedField, obj *model.Event) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Event_location,
+		func(ctx context.Context) (any, error) {
+			return obj.Location, nil
+		},
+		nil,
+		ec.marshalOLocation2ᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐLocation,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Event_location(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Event",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "latitude":
+				return ec.fieldContext_Location_latitude(ctx, field)
+			case "longitude":
+				return ec.fieldContext_Location_longitude(ctx, field)
+			case "hdop":
+				return ec.fieldContext_Location_hdop(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Location", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _EventAggregation_timestamp(ctx context.Context, field graphql.CollectedField, obj *model.EventAggregation) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_EventAggregation_timestamp,
+		func(ctx context.Context) (any, error) {
+			return obj.Timestamp, nil
+		},
+		nil,
+		ec.marshalNTime2timeᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_EventAggregation_timestamp(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "EventAggregation",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _EventAggregation_name(ctx context.Context, field graphql.CollectedField, obj *model.EventAggregation) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_EventAggregation_name,
+		func(ctx context.Context) (any, error) {
+			return obj.Name, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_EventAggregation_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "EventAggregation",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _EventAggregation_count(ctx context.Context, field graphql.CollectedField, obj *model.EventAggregation) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_EventAggregation_count,
+		func(ctx context.Context) (any, error) {
+			return obj.Count, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_EventAggregation_count(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "EventAggregation",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _EventAggregation_durationNs(ctx context.Context, field graphql.CollectedField, obj *model.EventAggregation) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_EventAggregation_durationNs,
+		func(ctx context.Context) (any, error) {
+			return obj.DurationNs, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_EventAggregation_durationNs(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "EventAggregation",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _EventCount_name(ctx context.Context, field graphql.CollectedField, obj *model.EventCount) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_EventCount_name,
+		func(ctx context.Context) (any, error) {
+			return obj.Name, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_EventCount_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "EventCount",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _EventCount_count(ctx context.Context, field graphql.CollectedField, obj *model.EventCount) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_EventCount_count,
+		func(ctx context.Context) (any, error) {
+			return obj.Count, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_EventCount_count(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "EventCount",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _EventDataSummary_name(ctx context.Context, field graphql.CollectedField, obj *model.EventDataSummary) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_EventDataSummary_name,
+		func(ctx context.Context) (any, error) {
+			return obj.Name, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_EventDataSummary_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "EventDataSummary",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _EventDataSummary_numberOfEvents(ctx context.Context, field graphql.CollectedField, obj *model.EventDataSummary) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_EventDataSummary_numberOfEvents,
+		func(ctx context.Context) (any, error) {
+			return obj.NumberOfEvents, nil
+		},
+		nil,
+		ec.marshalNUint642uint64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_EventDataSummary_numberOfEvents(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "EventDataSummary",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Uint64 does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _EventDataSummary_firstSeen(ctx context.Context, field graphql.CollectedField, obj *model.EventDataSummary) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_EventDataSummary_firstSeen,
+		func(ctx context.Context) (any, error) {
+			return obj.FirstSeen, nil
+		},
+		nil,
+		ec.marshalNTime2timeᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_EventDataSummary_firstSeen(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "EventDataSummary",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _EventDataSummary_lastSeen(ctx context.Context, field graphql.CollectedField, obj *model.EventDataSummary) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_EventDataSummary_lastSeen,
+		func(ctx context.Context) (any, error) {
+			return obj.LastSeen, nil
+		},
+		nil,
+		ec.marshalNTime2timeᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_EventDataSummary_lastSeen(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "EventDataSummary",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _EventPage_events(ctx context.Context, field graphql.CollectedField, obj *model.EventPage) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_EventPage_events,
+		func(ctx context.Context) (any, error) {
+			return obj.Events, nil
+		},
+		nil,
+		ec.marshalNEvent2ᚕᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐEventᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_EventPage_events(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "EventPage",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "timestamp":
+				return ec.fieldContext_Event_timestamp(ctx, field)
+			case "name":
+				return ec.fieldContext_Event_name(ctx, field)
+			case "source":
+				return ec.fieldContext_Event_source(ctx, field)
+			case "durationNs":
+				return ec.fieldContext_Event_durationNs(ctx, field)
+			case "metadata":
+				return ec.fieldContext_Event_metadata(ctx, field)
+			case "location":
+				return ec.fieldContext_Event_location(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Event", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _EventPage_hasMore(ctx context.Context, field graphql.CollectedField, obj *model.EventPage) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_EventPage_hasMore,
+		func(ctx context.Context) (any, error) {
+			return obj.HasMore, nil
+		},
+		nil,
+		ec.marshalNBoolean2bool,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_EventPage_hasMore(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "EventPage",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _EventPage_nextCursor(ctx context.Context, field graphql.CollectedField, obj *model.EventPage) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_EventPage_nextCursor,
+		func(ctx context.Context) (any, error) {
+			return obj.NextCursor, nil
+		},
+		nil,
+		ec.marshalOTime2ᚖtimeᚐTime,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_EventPage_nextCursor(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "EventPage",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FuelDropDetails_startLevel(ctx context.Context, field graphql.CollectedField, obj *model.FuelDropDetails) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FuelDropDetails_startLevel,
+		func(ctx context.Context) (any, error) {
+			return obj.StartLevel, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_FuelDropDetails_startLevel(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FuelDropDetails",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FuelDropDetails_endLevel(ctx context.Context, field graphql.CollectedField, obj *model.FuelDropDetails) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FuelDropDetails_endLevel,
+		func(ctx context.Context) (any, error) {
+			return obj.EndLevel, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_FuelDropDetails_endLevel(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FuelDropDetails",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _FuelDropDetails_litersLost(ctx context.Context, field graphql.CollectedField, obj *model.FuelDropDetails) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_FuelDropDetails_litersLost,
+		func(ctx context.Context) (any, error) {
+			return obj.LitersLost, nil
+		},
+		nil,
+		ec.marshalOFloat2ᚖfloat64,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_FuelDropDetails_litersLost(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "FuelDropDetails",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LatestSignal_name(ctx context.Context, field graphql.CollectedField, obj *model.LatestSignal) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_LatestSignal_name,
+		func(ctx context.Context) (any, error) {
+			return obj.Name, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_LatestSignal_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LatestSignal",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LatestSignal_timestamp(ctx context.Context, field graphql.CollectedField, obj *model.LatestSignal) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_LatestSignal_timestamp,
+		func(ctx context.Context) (any, error) {
+			return obj.Timestamp, nil
+		},
+		nil,
+		ec.marshalNTime2timeᚐTime,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_LatestSignal_timestamp(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LatestSignal",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Time does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LatestSignal_valueNumber(ctx context.Context, field graphql.CollectedField, obj *model.LatestSignal) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_LatestSignal_valueNumber,
+		func(ctx context.Context) (any, error) {
+			return obj.ValueNumber, nil
+		},
+		nil,
+		ec.marshalOFloat2ᚖfloat64,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_LatestSignal_valueNumber(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LatestSignal",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LatestSignal_valueString(ctx context.Context, field graphql.CollectedField, obj *model.LatestSignal) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_LatestSignal_valueString,
+		func(ctx context.Context) (any, error) {
+			return obj.ValueString, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_LatestSignal_valueString(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LatestSignal",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _LatestSignal_valueLocation(ctx context.Context, field graphql.CollectedField, obj *model.LatestSignal) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_LatestSignal_valueLocation,
+		func(ctx context.Context) (any, error) {
+			return obj.ValueLocation, nil
+		},
+		nil,
+		ec.marshalOLocation2ᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐLocation,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_LatestSignal_valueLocation(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "LatestSignal",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "latitude":
+				return ec.fieldContext_Location_latitude(ctx, field)
+			case "longitude":
+				return ec.fieldContext_Location_longitude(ctx, field)
+			case "hdop":
+				return ec.fieldContext_Location_hdop(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Location", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Location_latitude(ctx context.Context, field graphql.CollectedField, obj *model.Location) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Location_latitude,
+		func(ctx context.Context) (any, error) {
+			return obj.Latitude, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Location_latitude(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Location",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Location_longitude(ctx context.Context, field graphql.CollectedField, obj *model.Location) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Location_longitude,
+		func(ctx context.Context) (any, error) {
+			return obj.Longitude, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Location_longitude(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Location",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Location_hdop(ctx context.Context, field graphql.CollectedField, obj *model.Location) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Location_hdop,
+		func(ctx context.Context) (any, error) {
+			return obj.Hdop, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Location_hdop(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Location",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_signals(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_signals,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.Resolvers.Query().Signals(ctx, fc.Args["tokenId"].(int), fc.Args["interval"].(string), fc.Args["from"].(time.Time), fc.Args["to"].(time.Time), fc.Args["filter"].(*model.SignalFilter))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				if ec.Directives.RequiresVehicleToken == nil {
+					var zeroVal []*model.SignalAggregations
+					return zeroVal, errors.New("directive requiresVehicleToken is not implemented")
+				}
+				return ec.Directives.RequiresVehicleToken(ctx, nil, directive0)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalOSignalAggregations2ᚕᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐSignalAggregationsᚄ,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_signals(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "timestamp":
+				return ec.fieldContext_SignalAggregations_timestamp(ctx, field)
+			case "currentLocationApproximateCoordinates":
+				return ec.fieldContext_SignalAggregations_currentLocationApproximateCoordinates(ctx, field)
+			case "angularVelocityYaw":
+				return ec.fieldContext_SignalAggregations_angularVelocityYaw(ctx, field)
+			case "bodyLightsIsAirbagWarningOn":
+				return ec.fieldContext_SignalAggregations_bodyLightsIsAirbagWarningOn(ctx, field)
+			case "bodyLockIsLocked":
+				return ec.fieldContext_SignalAggregations_bodyLockIsLocked(ctx, field)
+			case "bodyTrunkFrontIsOpen":
+				return ec.fieldContext_SignalAggregations_bodyTrunkFrontIsOpen(ctx, field)
+			case "bodyTrunkRearIsOpen":
+				return ec.fieldContext_SignalAggregations_bodyTrunkRearIsOpen(ctx, field)
+			case "cabinDoorRow1DriverSideIsOpen":
+				return ec.fieldContext_SignalAggregations_cabinDoorRow1DriverSideIsOpen(ctx, field)
+			case "cabinDoorRow1DriverSideWindowIsOpen":
+				return ec.fieldContext_SignalAggregations_cabinDoorRow1DriverSideWindowIsOpen(ctx, field)
+			case "cabinDoorRow1PassengerSideIsOpen":
+				return ec.fieldContext_SignalAggregations_cabinDoorRow1PassengerSideIsOpen(ctx, field)
+			case "cabinDoorRow1PassengerSideWindowIsOpen":
+				return ec.fieldContext_SignalAggregations_cabinDoorRow1PassengerSideWindowIsOpen(ctx, field)
+			case "cabinDoorRow2DriverSideIsOpen":
 				return ec.fieldContext_SignalAggregations_cabinDoorRow2DriverSideIsOpen(ctx, field)
 			case "cabinDoorRow2DriverSideWindowIsOpen":
 				return ec.fieldContext_SignalAggregations_cabinDoorRow2DriverSideWindowIsOpen(ctx, field)
@@ -9687,7 +12319,7 @@ func (ec *executionContext) _Query_availableSignals(ctx context.Context, field g
 		ec.fieldContext_Query_availableSignals,
 		func(ctx context.Context) (any, error) {
 			fc := graphql.GetFieldContext(ctx)
-			return ec.Resolvers.Query().AvailableSignals(ctx, fc.Args["tokenId"].(int), fc.Args["filter"].(*model.SignalFilter))
+			return ec.Resolvers.Query().AvailableSignals(ctx, fc.Args["tokenId"].(int), fc.Args["filter"].(*model.SignalFilter), fc.Args["from"].(*time.Time), fc.Args["to"].(*time.Time))
 		},
 		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
 			directive0 := next
@@ -9801,7 +12433,7 @@ func (ec *executionContext) _Query_dataSummary(ctx context.Context, field graphq
 		ec.fieldContext_Query_dataSummary,
 		func(ctx context.Context) (any, error) {
 			fc := graphql.GetFieldContext(ctx)
-			return ec.Resolvers.Query().DataSummary(ctx, fc.Args["tokenId"].(int), fc.Args["filter"].(*model.SignalFilter))
+			return ec.Resolvers.Query().DataSummary(ctx, fc.Args["tokenId"].(int), fc.Args["filter"].(*model.SignalFilter), fc.Args["from"].(*time.Time), fc.Args["to"].(*time.Time))
 		},
 		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
 			directive0 := next
@@ -9924,6 +12556,191 @@ func (ec *executionContext) fieldContext_Query_attestations(ctx context.Context,
 	return fc, nil
 }
 
+func (ec *executionContext) _Query_signalDefinitions(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_signalDefinitions,
+		func(ctx context.Context) (any, error) {
+			return ec.Resolvers.Query().SignalDefinitions(ctx)
+		},
+		nil,
+		ec.marshalNSignalDefinition2ᚕᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐSignalDefinitionᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_signalDefinitions(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext_SignalDefinition_name(ctx, field)
+			case "vssPath":
+				return ec.fieldContext_SignalDefinition_vssPath(ctx, field)
+			case "valueType":
+				return ec.fieldContext_SignalDefinition_valueType(ctx, field)
+			case "unit":
+				return ec.fieldContext_SignalDefinition_unit(ctx, field)
+			case "min":
+				return ec.fieldContext_SignalDefinition_min(ctx, field)
+			case "max":
+				return ec.fieldContext_SignalDefinition_max(ctx, field)
+			case "description":
+				return ec.fieldContext_SignalDefinition_description(ctx, field)
+			case "privileges":
+				return ec.fieldContext_SignalDefinition_privileges(ctx, field)
+			case "aggregations":
+				return ec.fieldContext_SignalDefinition_aggregations(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SignalDefinition", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_dataCoverage(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_dataCoverage,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.Resolvers.Query().DataCoverage(ctx, fc.Args["tokenId"].(int), fc.Args["from"].(time.Time), fc.Args["to"].(time.Time), fc.Args["interval"].(string), fc.Args["names"].([]string), fc.Args["minGapSeconds"].(*int), fc.Args["filter"].(*model.SignalFilter))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				if ec.Directives.RequiresVehicleToken == nil {
+					var zeroVal []*model.SignalCoverage
+					return zeroVal, errors.New("directive requiresVehicleToken is not implemented")
+				}
+				return ec.Directives.RequiresVehicleToken(ctx, nil, directive0)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalNSignalCoverage2ᚕᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐSignalCoverageᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_dataCoverage(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext_SignalCoverage_name(ctx, field)
+			case "totalCount":
+				return ec.fieldContext_SignalCoverage_totalCount(ctx, field)
+			case "buckets":
+				return ec.fieldContext_SignalCoverage_buckets(ctx, field)
+			case "gaps":
+				return ec.fieldContext_SignalCoverage_gaps(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SignalCoverage", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_dataCoverage_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_samplingStats(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_samplingStats,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.Resolvers.Query().SamplingStats(ctx, fc.Args["tokenId"].(int), fc.Args["from"].(time.Time), fc.Args["to"].(time.Time), fc.Args["names"].([]string), fc.Args["filter"].(*model.SignalFilter))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				if ec.Directives.RequiresVehicleToken == nil {
+					var zeroVal []*model.SignalSamplingStats
+					return zeroVal, errors.New("directive requiresVehicleToken is not implemented")
+				}
+				return ec.Directives.RequiresVehicleToken(ctx, nil, directive0)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalNSignalSamplingStats2ᚕᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐSignalSamplingStatsᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_samplingStats(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "name":
+				return ec.fieldContext_SignalSamplingStats_name(ctx, field)
+			case "source":
+				return ec.fieldContext_SignalSamplingStats_source(ctx, field)
+			case "sampleCount":
+				return ec.fieldContext_SignalSamplingStats_sampleCount(ctx, field)
+			case "activeHours":
+				return ec.fieldContext_SignalSamplingStats_activeHours(ctx, field)
+			case "samplesPerActiveHour":
+				return ec.fieldContext_SignalSamplingStats_samplesPerActiveHour(ctx, field)
+			case "medianIntervalSeconds":
+				return ec.fieldContext_SignalSamplingStats_medianIntervalSeconds(ctx, field)
+			case "p95IntervalSeconds":
+				return ec.fieldContext_SignalSamplingStats_p95IntervalSeconds(ctx, field)
+			case "maxIntervalSeconds":
+				return ec.fieldContext_SignalSamplingStats_maxIntervalSeconds(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SignalSamplingStats", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_samplingStats_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _Query_events(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -9932,7 +12749,7 @@ func (ec *executionContext) _Query_events(ctx context.Context, field graphql.Col
 		ec.fieldContext_Query_events,
 		func(ctx context.Context) (any, error) {
 			fc := graphql.GetFieldContext(ctx)
-			return ec.Resolvers.Query().Events(ctx, fc.Args["tokenId"].(int), fc.Args["from"].(time.Time), fc.Args["to"].(time.Time), fc.Args["filter"].(*model.EventFilter))
+			return ec.Resolvers.Query().Events(ctx, fc.Args["tokenId"].(int), fc.Args["from"].(time.Time), fc.Args["to"].(time.Time), fc.Args["filter"].(*model.EventFilter), fc.Args["derivedEvents"].(*model.DerivedEventsConfig), fc.Args["limit"].(*int))
 		},
 		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
 			directive0 := next
@@ -9984,8 +12801,262 @@ func (ec *executionContext) fieldContext_Query_events(ctx context.Context, field
 				return ec.fieldContext_Event_durationNs(ctx, field)
 			case "metadata":
 				return ec.fieldContext_Event_metadata(ctx, field)
+			case "location":
+				return ec.fieldContext_Event_location(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Event", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_events_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_eventsPage(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_eventsPage,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.Resolvers.Query().EventsPage(ctx, fc.Args["tokenId"].(int), fc.Args["from"].(time.Time), fc.Args["to"].(time.Time), fc.Args["filter"].(*model.EventFilter), fc.Args["limit"].(*int), fc.Args["after"].(*time.Time))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				if ec.Directives.RequiresVehicleToken == nil {
+					var zeroVal *model.EventPage
+					return zeroVal, errors.New("directive requiresVehicleToken is not implemented")
+				}
+				return ec.Directives.RequiresVehicleToken(ctx, nil, directive0)
+			}
+			directive2 := func(ctx context.Context) (any, error) {
+				privileges, err := ec.unmarshalNPrivilege2ᚕstringᚄ(ctx, []any{"VEHICLE_NON_LOCATION_DATA", "VEHICLE_ALL_TIME_LOCATION"})
+				if err != nil {
+					var zeroVal *model.EventPage
+					return zeroVal, err
+				}
+				if ec.Directives.RequiresAllOfPrivileges == nil {
+					var zeroVal *model.EventPage
+					return zeroVal, errors.New("directive requiresAllOfPrivileges is not implemented")
+				}
+				return ec.Directives.RequiresAllOfPrivileges(ctx, nil, directive1, privileges)
+			}
+
+			next = directive2
+			return next
+		},
+		ec.marshalNEventPage2ᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐEventPage,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_eventsPage(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "events":
+				return ec.fieldContext_EventPage_events(ctx, field)
+			case "hasMore":
+				return ec.fieldContext_EventPage_hasMore(ctx, field)
+			case "nextCursor":
+				return ec.fieldContext_EventPage_nextCursor(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type EventPage", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_eventsPage_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_eventsAggregated(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_eventsAggregated,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.Resolvers.Query().EventsAggregated(ctx, fc.Args["tokenId"].(int), fc.Args["from"].(time.Time), fc.Args["to"].(time.Time), fc.Args["interval"].(string), fc.Args["filter"].(*model.EventFilter))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				if ec.Directives.RequiresVehicleToken == nil {
+					var zeroVal []*model.EventAggregation
+					return zeroVal, errors.New("directive requiresVehicleToken is not implemented")
+				}
+				return ec.Directives.RequiresVehicleToken(ctx, nil, directive0)
+			}
+			directive2 := func(ctx context.Context) (any, error) {
+				privileges, err := ec.unmarshalNPrivilege2ᚕstringᚄ(ctx, []any{"VEHICLE_NON_LOCATION_DATA", "VEHICLE_ALL_TIME_LOCATION"})
+				if err != nil {
+					var zeroVal []*model.EventAggregation
+					return zeroVal, err
+				}
+				if ec.Directives.RequiresAllOfPrivileges == nil {
+					var zeroVal []*model.EventAggregation
+					return zeroVal, errors.New("directive requiresAllOfPrivileges is not implemented")
+				}
+				return ec.Directives.RequiresAllOfPrivileges(ctx, nil, directive1, privileges)
+			}
+
+			next = directive2
+			return next
+		},
+		ec.marshalNEventAggregation2ᚕᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐEventAggregationᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_eventsAggregated(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "timestamp":
+				return ec.fieldContext_EventAggregation_timestamp(ctx, field)
+			case "name":
+				return ec.fieldContext_EventAggregation_name(ctx, field)
+			case "count":
+				return ec.fieldContext_EventAggregation_count(ctx, field)
+			case "durationNs":
+				return ec.fieldContext_EventAggregation_durationNs(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type EventAggregation", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Query_eventsAggregated_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Query_segments(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Query_segments,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.Resolvers.Query().Segments(ctx, fc.Args["tokenId"].(int), fc.Args["from"].(time.Time), fc.Args["to"].(time.Time), fc.Args["mechanism"].(model.DetectionMechanism), fc.Args["config"].(*model.SegmentConfig), fc.Args["signalRequests"].([]*model.SegmentSignalRequest), fc.Args["eventRequests"].([]*model.SegmentEventRequest), fc.Args["limit"].(*int), fc.Args["after"].(*time.Time))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				if ec.Directives.RequiresVehicleToken == nil {
+					var zeroVal []*model.Segment
+					return zeroVal, errors.New("directive requiresVehicleToken is not implemented")
+				}
+				return ec.Directives.RequiresVehicleToken(ctx, nil, directive0)
+			}
+			directive2 := func(ctx context.Context) (any, error) {
+				privileges, err := ec.unmarshalNPrivilege2ᚕstringᚄ(ctx, []any{"VEHICLE_ALL_TIME_LOCATION", "VEHICLE_NON_LOCATION_DATA"})
+				if err != nil {
+					var zeroVal []*model.Segment
+					return zeroVal, err
+				}
+				if ec.Directives.RequiresAllOfPrivileges == nil {
+					var zeroVal []*model.Segment
+					return zeroVal, errors.New("directive requiresAllOfPrivileges is not implemented")
+				}
+				return ec.Directives.RequiresAllOfPrivileges(ctx, nil, directive1, privileges)
+			}
+
+			next = directive2
+			return next
+		},
+		ec.marshalNSegment2ᚕᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐSegmentᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Query_segments(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Query",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "id":
+				return ec.fieldContext_Segment_id(ctx, field)
+			case "mechanism":
+				return ec.fieldContext_Segment_mechanism(ctx, field)
+			case "start":
+				return ec.fieldContext_Segment_start(ctx, field)
+			case "end":
+				return ec.fieldContext_Segment_end(ctx, field)
+			case "duration":
+				return ec.fieldContext_Segment_duration(ctx, field)
+			case "isOngoing":
+				return ec.fieldContext_Segment_isOngoing(ctx, field)
+			case "startedBeforeRange":
+				return ec.fieldContext_Segment_startedBeforeRange(ctx, field)
+			case "signals":
+				return ec.fieldContext_Segment_signals(ctx, field)
+			case "eventCounts":
+				return ec.fieldContext_Segment_eventCounts(ctx, field)
+			case "score":
+				return ec.fieldContext_Segment_score(ctx, field)
+			case "stop":
+				return ec.fieldContext_Segment_stop(ctx, field)
+			case "charging":
+				return ec.fieldContext_Segment_charging(ctx, field)
+			case "refuel":
+				return ec.fieldContext_Segment_refuel(ctx, field)
+			case "fuelDrop":
+				return ec.fieldContext_Segment_fuelDrop(ctx, field)
+			case "route":
+				return ec.fieldContext_Segment_route(ctx, field)
+			case "distanceKm":
+				return ec.fieldContext_Segment_distanceKm(ctx, field)
+			case "distanceSource":
+				return ec.fieldContext_Segment_distanceSource(ctx, field)
 			}
-			return nil, fmt.Errorf("no field named %q was found under type Event", field.Name)
+			return nil, fmt.Errorf("no field named %q was found under type Segment", field.Name)
 		},
 	}
 	defer func() {
@@ -9995,29 +13066,29 @@ func (ec *executionContext) fieldContext_Query_events(ctx context.Context, field
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_events_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Query_segments_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
 	return fc, nil
 }
 
-func (ec *executionContext) _Query_segments(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
+func (ec *executionContext) _Query_segment(ctx context.Context, field graphql.CollectedField) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
 		ec.OperationContext,
 		field,
-		ec.fieldContext_Query_segments,
+		ec.fieldContext_Query_segment,
 		func(ctx context.Context) (any, error) {
 			fc := graphql.GetFieldContext(ctx)
-			return ec.Resolvers.Query().Segments(ctx, fc.Args["tokenId"].(int), fc.Args["from"].(time.Time), fc.Args["to"].(time.Time), fc.Args["mechanism"].(model.DetectionMechanism), fc.Args["config"].(*model.SegmentConfig), fc.Args["signalRequests"].([]*model.SegmentSignalRequest), fc.Args["eventRequests"].([]*model.SegmentEventRequest), fc.Args["limit"].(*int), fc.Args["after"].(*time.Time))
+			return ec.Resolvers.Query().Segment(ctx, fc.Args["tokenId"].(int), fc.Args["id"].(string), fc.Args["mechanism"].(model.DetectionMechanism), fc.Args["config"].(*model.SegmentConfig), fc.Args["signalRequests"].([]*model.SegmentSignalRequest), fc.Args["eventRequests"].([]*model.SegmentEventRequest))
 		},
 		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
 			directive0 := next
 
 			directive1 := func(ctx context.Context) (any, error) {
 				if ec.Directives.RequiresVehicleToken == nil {
-					var zeroVal []*model.Segment
+					var zeroVal *model.Segment
 					return zeroVal, errors.New("directive requiresVehicleToken is not implemented")
 				}
 				return ec.Directives.RequiresVehicleToken(ctx, nil, directive0)
@@ -10025,11 +13096,11 @@ func (ec *executionContext) _Query_segments(ctx context.Context, field graphql.C
 			directive2 := func(ctx context.Context) (any, error) {
 				privileges, err := ec.unmarshalNPrivilege2ᚕstringᚄ(ctx, []any{"VEHICLE_ALL_TIME_LOCATION", "VEHICLE_NON_LOCATION_DATA"})
 				if err != nil {
-					var zeroVal []*model.Segment
+					var zeroVal *model.Segment
 					return zeroVal, err
 				}
 				if ec.Directives.RequiresAllOfPrivileges == nil {
-					var zeroVal []*model.Segment
+					var zeroVal *model.Segment
 					return zeroVal, errors.New("directive requiresAllOfPrivileges is not implemented")
 				}
 				return ec.Directives.RequiresAllOfPrivileges(ctx, nil, directive1, privileges)
@@ -10038,13 +13109,13 @@ func (ec *executionContext) _Query_segments(ctx context.Context, field graphql.C
 			next = directive2
 			return next
 		},
-		ec.marshalNSegment2ᚕᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐSegmentᚄ,
-		true,
+		ec.marshalOSegment2ᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐSegment,
 		true,
+		false,
 	)
 }
 
-func (ec *executionContext) fieldContext_Query_segments(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+func (ec *executionContext) fieldContext_Query_segment(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
 	fc = &graphql.FieldContext{
 		Object:     "Query",
 		Field:      field,
@@ -10052,6 +13123,10 @@ func (ec *executionContext) fieldContext_Query_segments(ctx context.Context, fie
 		IsResolver: true,
 		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
 			switch field.Name {
+			case "id":
+				return ec.fieldContext_Segment_id(ctx, field)
+			case "mechanism":
+				return ec.fieldContext_Segment_mechanism(ctx, field)
 			case "start":
 				return ec.fieldContext_Segment_start(ctx, field)
 			case "end":
@@ -10066,6 +13141,22 @@ func (ec *executionContext) fieldContext_Query_segments(ctx context.Context, fie
 				return ec.fieldContext_Segment_signals(ctx, field)
 			case "eventCounts":
 				return ec.fieldContext_Segment_eventCounts(ctx, field)
+			case "score":
+				return ec.fieldContext_Segment_score(ctx, field)
+			case "stop":
+				return ec.fieldContext_Segment_stop(ctx, field)
+			case "charging":
+				return ec.fieldContext_Segment_charging(ctx, field)
+			case "refuel":
+				return ec.fieldContext_Segment_refuel(ctx, field)
+			case "fuelDrop":
+				return ec.fieldContext_Segment_fuelDrop(ctx, field)
+			case "route":
+				return ec.fieldContext_Segment_route(ctx, field)
+			case "distanceKm":
+				return ec.fieldContext_Segment_distanceKm(ctx, field)
+			case "distanceSource":
+				return ec.fieldContext_Segment_distanceSource(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type Segment", field.Name)
 		},
@@ -10077,7 +13168,7 @@ func (ec *executionContext) fieldContext_Query_segments(ctx context.Context, fie
 		}
 	}()
 	ctx = graphql.WithFieldContext(ctx, fc)
-	if fc.Args, err = ec.field_Query_segments_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+	if fc.Args, err = ec.field_Query_segment_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
 		ec.Error(ctx, err)
 		return fc, err
 	}
@@ -10146,6 +13237,12 @@ func (ec *executionContext) fieldContext_Query_dailyActivity(ctx context.Context
 				return ec.fieldContext_DailyActivity_signals(ctx, field)
 			case "eventCounts":
 				return ec.fieldContext_DailyActivity_eventCounts(ctx, field)
+			case "score":
+				return ec.fieldContext_DailyActivity_score(ctx, field)
+			case "distanceKm":
+				return ec.fieldContext_DailyActivity_distanceKm(ctx, field)
+			case "distanceSource":
+				return ec.fieldContext_DailyActivity_distanceSource(ctx, field)
 			}
 			return nil, fmt.Errorf("no field named %q was found under type DailyActivity", field.Name)
 		},
@@ -10358,6 +13455,209 @@ func (ec *executionContext) fieldContext_Query___schema(_ context.Context, field
 	return fc, nil
 }
 
+func (ec *executionContext) _RefuelDetails_troughLevel(ctx context.Context, field graphql.CollectedField, obj *model.RefuelDetails) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RefuelDetails_troughLevel,
+		func(ctx context.Context) (any, error) {
+			return obj.TroughLevel, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RefuelDetails_troughLevel(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RefuelDetails",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RefuelDetails_peakLevel(ctx context.Context, field graphql.CollectedField, obj *model.RefuelDetails) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RefuelDetails_peakLevel,
+		func(ctx context.Context) (any, error) {
+			return obj.PeakLevel, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_RefuelDetails_peakLevel(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RefuelDetails",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RefuelDetails_tankCapacityLiters(ctx context.Context, field graphql.CollectedField, obj *model.RefuelDetails) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RefuelDetails_tankCapacityLiters,
+		func(ctx context.Context) (any, error) {
+			return obj.TankCapacityLiters, nil
+		},
+		nil,
+		ec.marshalOFloat2ᚖfloat64,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_RefuelDetails_tankCapacityLiters(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RefuelDetails",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RefuelDetails_litersAdded(ctx context.Context, field graphql.CollectedField, obj *model.RefuelDetails) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RefuelDetails_litersAdded,
+		func(ctx context.Context) (any, error) {
+			return obj.LitersAdded, nil
+		},
+		nil,
+		ec.marshalOFloat2ᚖfloat64,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_RefuelDetails_litersAdded(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RefuelDetails",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _RefuelDetails_estimatedCost(ctx context.Context, field graphql.CollectedField, obj *model.RefuelDetails) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_RefuelDetails_estimatedCost,
+		func(ctx context.Context) (any, error) {
+			return obj.EstimatedCost, nil
+		},
+		nil,
+		ec.marshalOFloat2ᚖfloat64,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_RefuelDetails_estimatedCost(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "RefuelDetails",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Segment_id(ctx context.Context, field graphql.CollectedField, obj *model.Segment) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Segment_id,
+		func(ctx context.Context) (any, error) {
+			return obj.ID, nil
+		},
+		nil,
+		ec.marshalNID2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Segment_id(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Segment",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type ID does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Segment_mechanism(ctx context.Context, field graphql.CollectedField, obj *model.Segment) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Segment_mechanism,
+		func(ctx context.Context) (any, error) {
+			return obj.Mechanism, nil
+		},
+		nil,
+		ec.marshalNDetectionMechanism2githubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐDetectionMechanism,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Segment_mechanism(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Segment",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type DetectionMechanism does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _Segment_start(ctx context.Context, field graphql.CollectedField, obj *model.Segment) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -10587,6 +13887,457 @@ func (ec *executionContext) fieldContext_Segment_eventCounts(_ context.Context,
 	return fc, nil
 }
 
+func (ec *executionContext) _Segment_score(ctx context.Context, field graphql.CollectedField, obj *model.Segment) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Segment_score,
+		func(ctx context.Context) (any, error) {
+			return obj.Score, nil
+		},
+		nil,
+		ec.marshalODriverScore2ᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐDriverScore,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Segment_score(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Segment",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "overall":
+				return ec.fieldContext_DriverScore_overall(ctx, field)
+			case "harshBraking":
+				return ec.fieldContext_DriverScore_harshBraking(ctx, field)
+			case "harshAcceleration":
+				return ec.fieldContext_DriverScore_harshAcceleration(ctx, field)
+			case "harshCornering":
+				return ec.fieldContext_DriverScore_harshCornering(ctx, field)
+			case "speeding":
+				return ec.fieldContext_DriverScore_speeding(ctx, field)
+			case "nightDriving":
+				return ec.fieldContext_DriverScore_nightDriving(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type DriverScore", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Segment_stop(ctx context.Context, field graphql.CollectedField, obj *model.Segment) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Segment_stop,
+		func(ctx context.Context) (any, error) {
+			return obj.Stop, nil
+		},
+		nil,
+		ec.marshalOSegmentStop2ᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐSegmentStop,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Segment_stop(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Segment",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "centroid":
+				return ec.fieldContext_SegmentStop_centroid(ctx, field)
+			case "ignitionOn":
+				return ec.fieldContext_SegmentStop_ignitionOn(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SegmentStop", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Segment_charging(ctx context.Context, field graphql.CollectedField, obj *model.Segment) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Segment_charging,
+		func(ctx context.Context) (any, error) {
+			return obj.Charging, nil
+		},
+		nil,
+		ec.marshalOChargingSession2ᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐChargingSession,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Segment_charging(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Segment",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "socStart":
+				return ec.fieldContext_ChargingSession_socStart(ctx, field)
+			case "socEnd":
+				return ec.fieldContext_ChargingSession_socEnd(ctx, field)
+			case "batteryCapacityKwh":
+				return ec.fieldContext_ChargingSession_batteryCapacityKwh(ctx, field)
+			case "energyAddedKwh":
+				return ec.fieldContext_ChargingSession_energyAddedKwh(ctx, field)
+			case "peakPowerKw":
+				return ec.fieldContext_ChargingSession_peakPowerKw(ctx, field)
+			case "averagePowerKw":
+				return ec.fieldContext_ChargingSession_averagePowerKw(ctx, field)
+			case "chargerType":
+				return ec.fieldContext_ChargingSession_chargerType(ctx, field)
+			case "location":
+				return ec.fieldContext_ChargingSession_location(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type ChargingSession", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Segment_refuel(ctx context.Context, field graphql.CollectedField, obj *model.Segment) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Segment_refuel,
+		func(ctx context.Context) (any, error) {
+			return obj.Refuel, nil
+		},
+		nil,
+		ec.marshalORefuelDetails2ᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐRefuelDetails,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Segment_refuel(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Segment",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "troughLevel":
+				return ec.fieldContext_RefuelDetails_troughLevel(ctx, field)
+			case "peakLevel":
+				return ec.fieldContext_RefuelDetails_peakLevel(ctx, field)
+			case "tankCapacityLiters":
+				return ec.fieldContext_RefuelDetails_tankCapacityLiters(ctx, field)
+			case "litersAdded":
+				return ec.fieldContext_RefuelDetails_litersAdded(ctx, field)
+			case "estimatedCost":
+				return ec.fieldContext_RefuelDetails_estimatedCost(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type RefuelDetails", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Segment_fuelDrop(ctx context.Context, field graphql.CollectedField, obj *model.Segment) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Segment_fuelDrop,
+		func(ctx context.Context) (any, error) {
+			return obj.FuelDrop, nil
+		},
+		nil,
+		ec.marshalOFuelDropDetails2ᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐFuelDropDetails,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Segment_fuelDrop(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Segment",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "startLevel":
+				return ec.fieldContext_FuelDropDetails_startLevel(ctx, field)
+			case "endLevel":
+				return ec.fieldContext_FuelDropDetails_endLevel(ctx, field)
+			case "litersLost":
+				return ec.fieldContext_FuelDropDetails_litersLost(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type FuelDropDetails", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Segment_route(ctx context.Context, field graphql.CollectedField, obj *model.Segment) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Segment_route,
+		func(ctx context.Context) (any, error) {
+			return obj.Route, nil
+		},
+		nil,
+		ec.marshalOSegmentRoute2ᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐSegmentRoute,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Segment_route(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Segment",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "format":
+				return ec.fieldContext_SegmentRoute_format(ctx, field)
+			case "value":
+				return ec.fieldContext_SegmentRoute_value(ctx, field)
+			case "pointCount":
+				return ec.fieldContext_SegmentRoute_pointCount(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SegmentRoute", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Segment_distanceKm(ctx context.Context, field graphql.CollectedField, obj *model.Segment) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Segment_distanceKm,
+		func(ctx context.Context) (any, error) {
+			return obj.DistanceKm, nil
+		},
+		nil,
+		ec.marshalOFloat2ᚖfloat64,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Segment_distanceKm(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Segment",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Segment_distanceSource(ctx context.Context, field graphql.CollectedField, obj *model.Segment) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Segment_distanceSource,
+		func(ctx context.Context) (any, error) {
+			return obj.DistanceSource, nil
+		},
+		nil,
+		ec.marshalODistanceSource2ᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐDistanceSource,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Segment_distanceSource(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Segment",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type DistanceSource does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SegmentRoute_format(ctx context.Context, field graphql.CollectedField, obj *model.SegmentRoute) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SegmentRoute_format,
+		func(ctx context.Context) (any, error) {
+			return obj.Format, nil
+		},
+		nil,
+		ec.marshalNRouteFormat2githubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐRouteFormat,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SegmentRoute_format(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SegmentRoute",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type RouteFormat does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SegmentRoute_value(ctx context.Context, field graphql.CollectedField, obj *model.SegmentRoute) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SegmentRoute_value,
+		func(ctx context.Context) (any, error) {
+			return obj.Value, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SegmentRoute_value(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SegmentRoute",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SegmentRoute_pointCount(ctx context.Context, field graphql.CollectedField, obj *model.SegmentRoute) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SegmentRoute_pointCount,
+		func(ctx context.Context) (any, error) {
+			return obj.PointCount, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SegmentRoute_pointCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SegmentRoute",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SegmentStop_centroid(ctx context.Context, field graphql.CollectedField, obj *model.SegmentStop) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SegmentStop_centroid,
+		func(ctx context.Context) (any, error) {
+			return obj.Centroid, nil
+		},
+		nil,
+		ec.marshalNLocation2ᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐLocation,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SegmentStop_centroid(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SegmentStop",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "latitude":
+				return ec.fieldContext_Location_latitude(ctx, field)
+			case "longitude":
+				return ec.fieldContext_Location_longitude(ctx, field)
+			case "hdop":
+				return ec.fieldContext_Location_hdop(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Location", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SegmentStop_ignitionOn(ctx context.Context, field graphql.CollectedField, obj *model.SegmentStop) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SegmentStop_ignitionOn,
+		func(ctx context.Context) (any, error) {
+			return obj.IgnitionOn, nil
+		},
+		nil,
+		ec.marshalOBoolean2ᚖbool,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_SegmentStop_ignitionOn(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SegmentStop",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Boolean does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _SignalAggregationValue_name(ctx context.Context, field graphql.CollectedField, obj *model.SignalAggregationValue) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -26309,6 +30060,136 @@ func (ec *executionContext) fieldContext_SignalCollection_speed(_ context.Contex
 	return fc, nil
 }
 
+func (ec *executionContext) _SignalCoverage_name(ctx context.Context, field graphql.CollectedField, obj *model.SignalCoverage) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SignalCoverage_name,
+		func(ctx context.Context) (any, error) {
+			return obj.Name, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SignalCoverage_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SignalCoverage",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SignalCoverage_totalCount(ctx context.Context, field graphql.CollectedField, obj *model.SignalCoverage) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SignalCoverage_totalCount,
+		func(ctx context.Context) (any, error) {
+			return obj.TotalCount, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SignalCoverage_totalCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SignalCoverage",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SignalCoverage_buckets(ctx context.Context, field graphql.CollectedField, obj *model.SignalCoverage) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SignalCoverage_buckets,
+		func(ctx context.Context) (any, error) {
+			return obj.Buckets, nil
+		},
+		nil,
+		ec.marshalNCoverageBucket2ᚕᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐCoverageBucketᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SignalCoverage_buckets(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SignalCoverage",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "timestamp":
+				return ec.fieldContext_CoverageBucket_timestamp(ctx, field)
+			case "count":
+				return ec.fieldContext_CoverageBucket_count(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type CoverageBucket", field.Name)
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SignalCoverage_gaps(ctx context.Context, field graphql.CollectedField, obj *model.SignalCoverage) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SignalCoverage_gaps,
+		func(ctx context.Context) (any, error) {
+			return obj.Gaps, nil
+		},
+		nil,
+		ec.marshalNDataGap2ᚕᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐDataGapᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SignalCoverage_gaps(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SignalCoverage",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "start":
+				return ec.fieldContext_DataGap_start(ctx, field)
+			case "end":
+				return ec.fieldContext_DataGap_end(ctx, field)
+			case "duration":
+				return ec.fieldContext_DataGap_duration(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type DataGap", field.Name)
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _SignalDataSummary_name(ctx context.Context, field graphql.CollectedField, obj *model.SignalDataSummary) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -26425,6 +30306,267 @@ func (ec *executionContext) fieldContext_SignalDataSummary_lastSeen(_ context.Co
 	return fc, nil
 }
 
+func (ec *executionContext) _SignalDefinition_name(ctx context.Context, field graphql.CollectedField, obj *model.SignalDefinition) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SignalDefinition_name,
+		func(ctx context.Context) (any, error) {
+			return obj.Name, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SignalDefinition_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SignalDefinition",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SignalDefinition_vssPath(ctx context.Context, field graphql.CollectedField, obj *model.SignalDefinition) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SignalDefinition_vssPath,
+		func(ctx context.Context) (any, error) {
+			return obj.VssPath, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SignalDefinition_vssPath(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SignalDefinition",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SignalDefinition_valueType(ctx context.Context, field graphql.CollectedField, obj *model.SignalDefinition) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SignalDefinition_valueType,
+		func(ctx context.Context) (any, error) {
+			return obj.ValueType, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SignalDefinition_valueType(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SignalDefinition",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SignalDefinition_unit(ctx context.Context, field graphql.CollectedField, obj *model.SignalDefinition) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SignalDefinition_unit,
+		func(ctx context.Context) (any, error) {
+			return obj.Unit, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_SignalDefinition_unit(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SignalDefinition",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SignalDefinition_min(ctx context.Context, field graphql.CollectedField, obj *model.SignalDefinition) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SignalDefinition_min,
+		func(ctx context.Context) (any, error) {
+			return obj.Min, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_SignalDefinition_min(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SignalDefinition",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SignalDefinition_max(ctx context.Context, field graphql.CollectedField, obj *model.SignalDefinition) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SignalDefinition_max,
+		func(ctx context.Context) (any, error) {
+			return obj.Max, nil
+		},
+		nil,
+		ec.marshalOString2ᚖstring,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_SignalDefinition_max(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SignalDefinition",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SignalDefinition_description(ctx context.Context, field graphql.CollectedField, obj *model.SignalDefinition) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SignalDefinition_description,
+		func(ctx context.Context) (any, error) {
+			return obj.Description, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SignalDefinition_description(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SignalDefinition",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SignalDefinition_privileges(ctx context.Context, field graphql.CollectedField, obj *model.SignalDefinition) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SignalDefinition_privileges,
+		func(ctx context.Context) (any, error) {
+			return obj.Privileges, nil
+		},
+		nil,
+		ec.marshalNPrivilege2ᚕstringᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SignalDefinition_privileges(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SignalDefinition",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Privilege does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SignalDefinition_aggregations(ctx context.Context, field graphql.CollectedField, obj *model.SignalDefinition) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SignalDefinition_aggregations,
+		func(ctx context.Context) (any, error) {
+			return obj.Aggregations, nil
+		},
+		nil,
+		ec.marshalNString2ᚕstringᚄ,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SignalDefinition_aggregations(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SignalDefinition",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _SignalFloat_timestamp(ctx context.Context, field graphql.CollectedField, obj *model.SignalFloat) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -26549,6 +30691,238 @@ func (ec *executionContext) fieldContext_SignalLocation_value(_ context.Context,
 	return fc, nil
 }
 
+func (ec *executionContext) _SignalSamplingStats_name(ctx context.Context, field graphql.CollectedField, obj *model.SignalSamplingStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SignalSamplingStats_name,
+		func(ctx context.Context) (any, error) {
+			return obj.Name, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SignalSamplingStats_name(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SignalSamplingStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SignalSamplingStats_source(ctx context.Context, field graphql.CollectedField, obj *model.SignalSamplingStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SignalSamplingStats_source,
+		func(ctx context.Context) (any, error) {
+			return obj.Source, nil
+		},
+		nil,
+		ec.marshalNString2string,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SignalSamplingStats_source(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SignalSamplingStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type String does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SignalSamplingStats_sampleCount(ctx context.Context, field graphql.CollectedField, obj *model.SignalSamplingStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SignalSamplingStats_sampleCount,
+		func(ctx context.Context) (any, error) {
+			return obj.SampleCount, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SignalSamplingStats_sampleCount(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SignalSamplingStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SignalSamplingStats_activeHours(ctx context.Context, field graphql.CollectedField, obj *model.SignalSamplingStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SignalSamplingStats_activeHours,
+		func(ctx context.Context) (any, error) {
+			return obj.ActiveHours, nil
+		},
+		nil,
+		ec.marshalNInt2int,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SignalSamplingStats_activeHours(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SignalSamplingStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Int does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SignalSamplingStats_samplesPerActiveHour(ctx context.Context, field graphql.CollectedField, obj *model.SignalSamplingStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SignalSamplingStats_samplesPerActiveHour,
+		func(ctx context.Context) (any, error) {
+			return obj.SamplesPerActiveHour, nil
+		},
+		nil,
+		ec.marshalNFloat2float64,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_SignalSamplingStats_samplesPerActiveHour(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SignalSamplingStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SignalSamplingStats_medianIntervalSeconds(ctx context.Context, field graphql.CollectedField, obj *model.SignalSamplingStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SignalSamplingStats_medianIntervalSeconds,
+		func(ctx context.Context) (any, error) {
+			return obj.MedianIntervalSeconds, nil
+		},
+		nil,
+		ec.marshalOFloat2ᚖfloat64,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_SignalSamplingStats_medianIntervalSeconds(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SignalSamplingStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SignalSamplingStats_p95IntervalSeconds(ctx context.Context, field graphql.CollectedField, obj *model.SignalSamplingStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SignalSamplingStats_p95IntervalSeconds,
+		func(ctx context.Context) (any, error) {
+			return obj.P95IntervalSeconds, nil
+		},
+		nil,
+		ec.marshalOFloat2ᚖfloat64,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_SignalSamplingStats_p95IntervalSeconds(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SignalSamplingStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _SignalSamplingStats_maxIntervalSeconds(ctx context.Context, field graphql.CollectedField, obj *model.SignalSamplingStats) (ret graphql.Marshaler) {
+	return graphql.ResolveField(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_SignalSamplingStats_maxIntervalSeconds,
+		func(ctx context.Context) (any, error) {
+			return obj.MaxIntervalSeconds, nil
+		},
+		nil,
+		ec.marshalOFloat2ᚖfloat64,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_SignalSamplingStats_maxIntervalSeconds(_ context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "SignalSamplingStats",
+		Field:      field,
+		IsMethod:   false,
+		IsResolver: false,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			return nil, errors.New("field of type Float does not have child fields")
+		},
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _SignalString_timestamp(ctx context.Context, field graphql.CollectedField, obj *model.SignalString) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -26677,6 +31051,378 @@ func (ec *executionContext) fieldContext_SignalsSnapshotResponse_signals(_ conte
 	return fc, nil
 }
 
+func (ec *executionContext) _Subscription_signalsLatest(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
+	return graphql.ResolveFieldStream(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Subscription_signalsLatest,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.Resolvers.Subscription().SignalsLatest(ctx, fc.Args["tokenId"].(int), fc.Args["filter"].(*model.SignalFilter))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				if ec.Directives.RequiresVehicleToken == nil {
+					var zeroVal *model.SignalCollection
+					return zeroVal, errors.New("directive requiresVehicleToken is not implemented")
+				}
+				return ec.Directives.RequiresVehicleToken(ctx, nil, directive0)
+			}
+
+			next = directive1
+			return next
+		},
+		ec.marshalOSignalCollection2ᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐSignalCollection,
+		true,
+		false,
+	)
+}
+
+func (ec *executionContext) fieldContext_Subscription_signalsLatest(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Subscription",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "lastSeen":
+				return ec.fieldContext_SignalCollection_lastSeen(ctx, field)
+			case "currentLocationApproximateCoordinates":
+				return ec.fieldContext_SignalCollection_currentLocationApproximateCoordinates(ctx, field)
+			case "angularVelocityYaw":
+				return ec.fieldContext_SignalCollection_angularVelocityYaw(ctx, field)
+			case "bodyLightsIsAirbagWarningOn":
+				return ec.fieldContext_SignalCollection_bodyLightsIsAirbagWarningOn(ctx, field)
+			case "bodyLockIsLocked":
+				return ec.fieldContext_SignalCollection_bodyLockIsLocked(ctx, field)
+			case "bodyTrunkFrontIsOpen":
+				return ec.fieldContext_SignalCollection_bodyTrunkFrontIsOpen(ctx, field)
+			case "bodyTrunkRearIsOpen":
+				return ec.fieldContext_SignalCollection_bodyTrunkRearIsOpen(ctx, field)
+			case "cabinDoorRow1DriverSideIsOpen":
+				return ec.fieldContext_SignalCollection_cabinDoorRow1DriverSideIsOpen(ctx, field)
+			case "cabinDoorRow1DriverSideWindowIsOpen":
+				return ec.fieldContext_SignalCollection_cabinDoorRow1DriverSideWindowIsOpen(ctx, field)
+			case "cabinDoorRow1PassengerSideIsOpen":
+				return ec.fieldContext_SignalCollection_cabinDoorRow1PassengerSideIsOpen(ctx, field)
+			case "cabinDoorRow1PassengerSideWindowIsOpen":
+				return ec.fieldContext_SignalCollection_cabinDoorRow1PassengerSideWindowIsOpen(ctx, field)
+			case "cabinDoorRow2DriverSideIsOpen":
+				return ec.fieldContext_SignalCollection_cabinDoorRow2DriverSideIsOpen(ctx, field)
+			case "cabinDoorRow2DriverSideWindowIsOpen":
+				return ec.fieldContext_SignalCollection_cabinDoorRow2DriverSideWindowIsOpen(ctx, field)
+			case "cabinDoorRow2PassengerSideIsOpen":
+				return ec.fieldContext_SignalCollection_cabinDoorRow2PassengerSideIsOpen(ctx, field)
+			case "cabinDoorRow2PassengerSideWindowIsOpen":
+				return ec.fieldContext_SignalCollection_cabinDoorRow2PassengerSideWindowIsOpen(ctx, field)
+			case "cabinSeatRow1DriverSideIsBelted":
+				return ec.fieldContext_SignalCollection_cabinSeatRow1DriverSideIsBelted(ctx, field)
+			case "cabinSeatRow1PassengerSideIsBelted":
+				return ec.fieldContext_SignalCollection_cabinSeatRow1PassengerSideIsBelted(ctx, field)
+			case "cabinSeatRow2DriverSideIsBelted":
+				return ec.fieldContext_SignalCollection_cabinSeatRow2DriverSideIsBelted(ctx, field)
+			case "cabinSeatRow2MiddleIsBelted":
+				return ec.fieldContext_SignalCollection_cabinSeatRow2MiddleIsBelted(ctx, field)
+			case "cabinSeatRow2PassengerSideIsBelted":
+				return ec.fieldContext_SignalCollection_cabinSeatRow2PassengerSideIsBelted(ctx, field)
+			case "cabinSeatRow3DriverSideIsBelted":
+				return ec.fieldContext_SignalCollection_cabinSeatRow3DriverSideIsBelted(ctx, field)
+			case "cabinSeatRow3PassengerSideIsBelted":
+				return ec.fieldContext_SignalCollection_cabinSeatRow3PassengerSideIsBelted(ctx, field)
+			case "chassisAxleRow1WheelLeftSpeed":
+				return ec.fieldContext_SignalCollection_chassisAxleRow1WheelLeftSpeed(ctx, field)
+			case "chassisAxleRow1WheelLeftTirePressure":
+				return ec.fieldContext_SignalCollection_chassisAxleRow1WheelLeftTirePressure(ctx, field)
+			case "chassisAxleRow1WheelRightSpeed":
+				return ec.fieldContext_SignalCollection_chassisAxleRow1WheelRightSpeed(ctx, field)
+			case "chassisAxleRow1WheelRightTirePressure":
+				return ec.fieldContext_SignalCollection_chassisAxleRow1WheelRightTirePressure(ctx, field)
+			case "chassisAxleRow2WheelLeftTirePressure":
+				return ec.fieldContext_SignalCollection_chassisAxleRow2WheelLeftTirePressure(ctx, field)
+			case "chassisAxleRow2WheelRightTirePressure":
+				return ec.fieldContext_SignalCollection_chassisAxleRow2WheelRightTirePressure(ctx, field)
+			case "chassisAxleRow3Weight":
+				return ec.fieldContext_SignalCollection_chassisAxleRow3Weight(ctx, field)
+			case "chassisAxleRow4Weight":
+				return ec.fieldContext_SignalCollection_chassisAxleRow4Weight(ctx, field)
+			case "chassisAxleRow5Weight":
+				return ec.fieldContext_SignalCollection_chassisAxleRow5Weight(ctx, field)
+			case "chassisBrakeABSIsWarningOn":
+				return ec.fieldContext_SignalCollection_chassisBrakeABSIsWarningOn(ctx, field)
+			case "chassisBrakeCircuit1PressurePrimary":
+				return ec.fieldContext_SignalCollection_chassisBrakeCircuit1PressurePrimary(ctx, field)
+			case "chassisBrakeCircuit2PressurePrimary":
+				return ec.fieldContext_SignalCollection_chassisBrakeCircuit2PressurePrimary(ctx, field)
+			case "chassisBrakeIsPedalPressed":
+				return ec.fieldContext_SignalCollection_chassisBrakeIsPedalPressed(ctx, field)
+			case "chassisBrakePedalPosition":
+				return ec.fieldContext_SignalCollection_chassisBrakePedalPosition(ctx, field)
+			case "chassisParkingBrakeIsEngaged":
+				return ec.fieldContext_SignalCollection_chassisParkingBrakeIsEngaged(ctx, field)
+			case "chassisTireSystemIsWarningOn":
+				return ec.fieldContext_SignalCollection_chassisTireSystemIsWarningOn(ctx, field)
+			case "connectivityCellularIsJammingDetected":
+				return ec.fieldContext_SignalCollection_connectivityCellularIsJammingDetected(ctx, field)
+			case "currentLocationAltitude":
+				return ec.fieldContext_SignalCollection_currentLocationAltitude(ctx, field)
+			case "currentLocationCoordinates":
+				return ec.fieldContext_SignalCollection_currentLocationCoordinates(ctx, field)
+			case "currentLocationHeading":
+				return ec.fieldContext_SignalCollection_currentLocationHeading(ctx, field)
+			case "exteriorAirTemperature":
+				return ec.fieldContext_SignalCollection_exteriorAirTemperature(ctx, field)
+			case "isIgnitionOn":
+				return ec.fieldContext_SignalCollection_isIgnitionOn(ctx, field)
+			case "lowVoltageBatteryCurrentVoltage":
+				return ec.fieldContext_SignalCollection_lowVoltageBatteryCurrentVoltage(ctx, field)
+			case "obdBarometricPressure":
+				return ec.fieldContext_SignalCollection_obdBarometricPressure(ctx, field)
+			case "obdCommandedEGR":
+				return ec.fieldContext_SignalCollection_obdCommandedEGR(ctx, field)
+			case "obdCommandedEVAP":
+				return ec.fieldContext_SignalCollection_obdCommandedEVAP(ctx, field)
+			case "obdDTCList":
+				return ec.fieldContext_SignalCollection_obdDTCList(ctx, field)
+			case "obdDistanceSinceDTCClear":
+				return ec.fieldContext_SignalCollection_obdDistanceSinceDTCClear(ctx, field)
+			case "obdDistanceWithMIL":
+				return ec.fieldContext_SignalCollection_obdDistanceWithMIL(ctx, field)
+			case "obdEngineLoad":
+				return ec.fieldContext_SignalCollection_obdEngineLoad(ctx, field)
+			case "obdEthanolPercent":
+				return ec.fieldContext_SignalCollection_obdEthanolPercent(ctx, field)
+			case "obdFuelPressure":
+				return ec.fieldContext_SignalCollection_obdFuelPressure(ctx, field)
+			case "obdFuelRailPressure":
+				return ec.fieldContext_SignalCollection_obdFuelRailPressure(ctx, field)
+			case "obdFuelRate":
+				return ec.fieldContext_SignalCollection_obdFuelRate(ctx, field)
+			case "obdFuelTypeName":
+				return ec.fieldContext_SignalCollection_obdFuelTypeName(ctx, field)
+			case "obdIntakeTemp":
+				return ec.fieldContext_SignalCollection_obdIntakeTemp(ctx, field)
+			case "obdIsEngineBlocked":
+				return ec.fieldContext_SignalCollection_obdIsEngineBlocked(ctx, field)
+			case "obdIsPTOActive":
+				return ec.fieldContext_SignalCollection_obdIsPTOActive(ctx, field)
+			case "obdIsPluggedIn":
+				return ec.fieldContext_SignalCollection_obdIsPluggedIn(ctx, field)
+			case "obdLongTermFuelTrim1":
+				return ec.fieldContext_SignalCollection_obdLongTermFuelTrim1(ctx, field)
+			case "obdLongTermFuelTrim2":
+				return ec.fieldContext_SignalCollection_obdLongTermFuelTrim2(ctx, field)
+			case "obdMAP":
+				return ec.fieldContext_SignalCollection_obdMAP(ctx, field)
+			case "obdMaxMAF":
+				return ec.fieldContext_SignalCollection_obdMaxMAF(ctx, field)
+			case "obdO2WRSensor1Voltage":
+				return ec.fieldContext_SignalCollection_obdO2WRSensor1Voltage(ctx, field)
+			case "obdO2WRSensor2Voltage":
+				return ec.fieldContext_SignalCollection_obdO2WRSensor2Voltage(ctx, field)
+			case "obdOilTemperature":
+				return ec.fieldContext_SignalCollection_obdOilTemperature(ctx, field)
+			case "obdRunTime":
+				return ec.fieldContext_SignalCollection_obdRunTime(ctx, field)
+			case "obdShortTermFuelTrim1":
+				return ec.fieldContext_SignalCollection_obdShortTermFuelTrim1(ctx, field)
+			case "obdStatusDTCCount":
+				return ec.fieldContext_SignalCollection_obdStatusDTCCount(ctx, field)
+			case "obdThrottlePosition":
+				return ec.fieldContext_SignalCollection_obdThrottlePosition(ctx, field)
+			case "obdWarmupsSinceDTCClear":
+				return ec.fieldContext_SignalCollection_obdWarmupsSinceDTCClear(ctx, field)
+			case "powertrainCombustionEngineDieselExhaustFluidCapacity":
+				return ec.fieldContext_SignalCollection_powertrainCombustionEngineDieselExhaustFluidCapacity(ctx, field)
+			case "powertrainCombustionEngineDieselExhaustFluidLevel":
+				return ec.fieldContext_SignalCollection_powertrainCombustionEngineDieselExhaustFluidLevel(ctx, field)
+			case "powertrainCombustionEngineECT":
+				return ec.fieldContext_SignalCollection_powertrainCombustionEngineECT(ctx, field)
+			case "powertrainCombustionEngineEOP":
+				return ec.fieldContext_SignalCollection_powertrainCombustionEngineEOP(ctx, field)
+			case "powertrainCombustionEngineEOT":
+				return ec.fieldContext_SignalCollection_powertrainCombustionEngineEOT(ctx, field)
+			case "powertrainCombustionEngineEngineOilLevel":
+				return ec.fieldContext_SignalCollection_powertrainCombustionEngineEngineOilLevel(ctx, field)
+			case "powertrainCombustionEngineEngineOilRelativeLevel":
+				return ec.fieldContext_SignalCollection_powertrainCombustionEngineEngineOilRelativeLevel(ctx, field)
+			case "powertrainCombustionEngineMAF":
+				return ec.fieldContext_SignalCollection_powertrainCombustionEngineMAF(ctx, field)
+			case "powertrainCombustionEngineSpeed":
+				return ec.fieldContext_SignalCollection_powertrainCombustionEngineSpeed(ctx, field)
+			case "powertrainCombustionEngineTPS":
+				return ec.fieldContext_SignalCollection_powertrainCombustionEngineTPS(ctx, field)
+			case "powertrainCombustionEngineTorque":
+				return ec.fieldContext_SignalCollection_powertrainCombustionEngineTorque(ctx, field)
+			case "powertrainCombustionEngineTorquePercent":
+				return ec.fieldContext_SignalCollection_powertrainCombustionEngineTorquePercent(ctx, field)
+			case "powertrainFuelSystemAbsoluteLevel":
+				return ec.fieldContext_SignalCollection_powertrainFuelSystemAbsoluteLevel(ctx, field)
+			case "powertrainFuelSystemAccumulatedConsumption":
+				return ec.fieldContext_SignalCollection_powertrainFuelSystemAccumulatedConsumption(ctx, field)
+			case "powertrainFuelSystemRelativeLevel":
+				return ec.fieldContext_SignalCollection_powertrainFuelSystemRelativeLevel(ctx, field)
+			case "powertrainFuelSystemSupportedFuelTypes":
+				return ec.fieldContext_SignalCollection_powertrainFuelSystemSupportedFuelTypes(ctx, field)
+			case "powertrainRange":
+				return ec.fieldContext_SignalCollection_powertrainRange(ctx, field)
+			case "powertrainTractionBatteryChargingAddedEnergy":
+				return ec.fieldContext_SignalCollection_powertrainTractionBatteryChargingAddedEnergy(ctx, field)
+			case "powertrainTractionBatteryChargingChargeCurrentAC":
+				return ec.fieldContext_SignalCollection_powertrainTractionBatteryChargingChargeCurrentAC(ctx, field)
+			case "powertrainTractionBatteryChargingChargeLimit":
+				return ec.fieldContext_SignalCollection_powertrainTractionBatteryChargingChargeLimit(ctx, field)
+			case "powertrainTractionBatteryChargingChargeVoltageUnknownType":
+				return ec.fieldContext_SignalCollection_powertrainTractionBatteryChargingChargeVoltageUnknownType(ctx, field)
+			case "powertrainTractionBatteryChargingIsCharging":
+				return ec.fieldContext_SignalCollection_powertrainTractionBatteryChargingIsCharging(ctx, field)
+			case "powertrainTractionBatteryChargingIsChargingCableConnected":
+				return ec.fieldContext_SignalCollection_powertrainTractionBatteryChargingIsChargingCableConnected(ctx, field)
+			case "powertrainTractionBatteryChargingPower":
+				return ec.fieldContext_SignalCollection_powertrainTractionBatteryChargingPower(ctx, field)
+			case "powertrainTractionBatteryCurrentPower":
+				return ec.fieldContext_SignalCollection_powertrainTractionBatteryCurrentPower(ctx, field)
+			case "powertrainTractionBatteryCurrentVoltage":
+				return ec.fieldContext_SignalCollection_powertrainTractionBatteryCurrentVoltage(ctx, field)
+			case "powertrainTractionBatteryGrossCapacity":
+				return ec.fieldContext_SignalCollection_powertrainTractionBatteryGrossCapacity(ctx, field)
+			case "powertrainTractionBatteryRange":
+				return ec.fieldContext_SignalCollection_powertrainTractionBatteryRange(ctx, field)
+			case "powertrainTractionBatteryStateOfChargeCurrent":
+				return ec.fieldContext_SignalCollection_powertrainTractionBatteryStateOfChargeCurrent(ctx, field)
+			case "powertrainTractionBatteryStateOfChargeCurrentEnergy":
+				return ec.fieldContext_SignalCollection_powertrainTractionBatteryStateOfChargeCurrentEnergy(ctx, field)
+			case "powertrainTractionBatteryStateOfHealth":
+				return ec.fieldContext_SignalCollection_powertrainTractionBatteryStateOfHealth(ctx, field)
+			case "powertrainTractionBatteryTemperatureAverage":
+				return ec.fieldContext_SignalCollection_powertrainTractionBatteryTemperatureAverage(ctx, field)
+			case "powertrainTransmissionActualGear":
+				return ec.fieldContext_SignalCollection_powertrainTransmissionActualGear(ctx, field)
+			case "powertrainTransmissionActualGearRatio":
+				return ec.fieldContext_SignalCollection_powertrainTransmissionActualGearRatio(ctx, field)
+			case "powertrainTransmissionCurrentGear":
+				return ec.fieldContext_SignalCollection_powertrainTransmissionCurrentGear(ctx, field)
+			case "powertrainTransmissionIsClutchSwitchOperated":
+				return ec.fieldContext_SignalCollection_powertrainTransmissionIsClutchSwitchOperated(ctx, field)
+			case "powertrainTransmissionRetarderActualTorque":
+				return ec.fieldContext_SignalCollection_powertrainTransmissionRetarderActualTorque(ctx, field)
+			case "powertrainTransmissionRetarderTorqueMode":
+				return ec.fieldContext_SignalCollection_powertrainTransmissionRetarderTorqueMode(ctx, field)
+			case "powertrainTransmissionSelectedGear":
+				return ec.fieldContext_SignalCollection_powertrainTransmissionSelectedGear(ctx, field)
+			case "powertrainTransmissionTemperature":
+				return ec.fieldContext_SignalCollection_powertrainTransmissionTemperature(ctx, field)
+			case "powertrainTransmissionTravelledDistance":
+				return ec.fieldContext_SignalCollection_powertrainTransmissionTravelledDistance(ctx, field)
+			case "powertrainType":
+				return ec.fieldContext_SignalCollection_powertrainType(ctx, field)
+			case "serviceDistanceToService":
+				return ec.fieldContext_SignalCollection_serviceDistanceToService(ctx, field)
+			case "serviceTimeToService":
+				return ec.fieldContext_SignalCollection_serviceTimeToService(ctx, field)
+			case "speed":
+				return ec.fieldContext_SignalCollection_speed(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type SignalCollection", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Subscription_signalsLatest_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
+func (ec *executionContext) _Subscription_events(ctx context.Context, field graphql.CollectedField) (ret func(ctx context.Context) graphql.Marshaler) {
+	return graphql.ResolveFieldStream(
+		ctx,
+		ec.OperationContext,
+		field,
+		ec.fieldContext_Subscription_events,
+		func(ctx context.Context) (any, error) {
+			fc := graphql.GetFieldContext(ctx)
+			return ec.Resolvers.Subscription().Events(ctx, fc.Args["tokenId"].(int), fc.Args["filter"].(*model.EventFilter))
+		},
+		func(ctx context.Context, next graphql.Resolver) graphql.Resolver {
+			directive0 := next
+
+			directive1 := func(ctx context.Context) (any, error) {
+				if ec.Directives.RequiresVehicleToken == nil {
+					var zeroVal *model.Event
+					return zeroVal, errors.New("directive requiresVehicleToken is not implemented")
+				}
+				return ec.Directives.RequiresVehicleToken(ctx, nil, directive0)
+			}
+			directive2 := func(ctx context.Context) (any, error) {
+				privileges, err := ec.unmarshalNPrivilege2ᚕstringᚄ(ctx, []any{"VEHICLE_NON_LOCATION_DATA", "VEHICLE_ALL_TIME_LOCATION"})
+				if err != nil {
+					var zeroVal *model.Event
+					return zeroVal, err
+				}
+				if ec.Directives.RequiresAllOfPrivileges == nil {
+					var zeroVal *model.Event
+					return zeroVal, errors.New("directive requiresAllOfPrivileges is not implemented")
+				}
+				return ec.Directives.RequiresAllOfPrivileges(ctx, nil, directive1, privileges)
+			}
+
+			next = directive2
+			return next
+		},
+		ec.marshalNEvent2ᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐEvent,
+		true,
+		true,
+	)
+}
+
+func (ec *executionContext) fieldContext_Subscription_events(ctx context.Context, field graphql.CollectedField) (fc *graphql.FieldContext, err error) {
+	fc = &graphql.FieldContext{
+		Object:     "Subscription",
+		Field:      field,
+		IsMethod:   true,
+		IsResolver: true,
+		Child: func(ctx context.Context, field graphql.CollectedField) (*graphql.FieldContext, error) {
+			switch field.Name {
+			case "timestamp":
+				return ec.fieldContext_Event_timestamp(ctx, field)
+			case "name":
+				return ec.fieldContext_Event_name(ctx, field)
+			case "source":
+				return ec.fieldContext_Event_source(ctx, field)
+			case "durationNs":
+				return ec.fieldContext_Event_durationNs(ctx, field)
+			case "metadata":
+				return ec.fieldContext_Event_metadata(ctx, field)
+			case "location":
+				return ec.fieldContext_Event_location(ctx, field)
+			}
+			return nil, fmt.Errorf("no field named %q was found under type Event", field.Name)
+		},
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = ec.Recover(ctx, r)
+			ec.Error(ctx, err)
+		}
+	}()
+	ctx = graphql.WithFieldContext(ctx, fc)
+	if fc.Args, err = ec.field_Subscription_events_args(ctx, field.ArgumentMap(ec.Variables)); err != nil {
+		ec.Error(ctx, err)
+		return fc, err
+	}
+	return fc, nil
+}
+
 func (ec *executionContext) _VINVC_vehicleTokenId(ctx context.Context, field graphql.CollectedField, obj *model.Vinvc) (ret graphql.Marshaler) {
 	return graphql.ResolveField(
 		ctx,
@@ -28470,6 +33216,70 @@ func (ec *executionContext) unmarshalInputAttestationFilter(ctx context.Context,
 	return it, nil
 }
 
+func (ec *executionContext) unmarshalInputDerivedEventsConfig(ctx context.Context, obj any) (model.DerivedEventsConfig, error) {
+	var it model.DerivedEventsConfig
+	if obj == nil {
+		return it, nil
+	}
+
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	if _, present := asMap["harshAccelerationThreshold"]; !present {
+		asMap["harshAccelerationThreshold"] = 3
+	}
+	if _, present := asMap["harshBrakingThreshold"]; !present {
+		asMap["harshBrakingThreshold"] = 4
+	}
+	if _, present := asMap["harshCorneringThreshold"]; !present {
+		asMap["harshCorneringThreshold"] = 4
+	}
+	if _, present := asMap["maxSampleGapSeconds"]; !present {
+		asMap["maxSampleGapSeconds"] = 5
+	}
+
+	fieldsInOrder := [...]string{"harshAccelerationThreshold", "harshBrakingThreshold", "harshCorneringThreshold", "maxSampleGapSeconds"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "harshAccelerationThreshold":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("harshAccelerationThreshold"))
+			data, err := ec.unmarshalOFloat2ᚖfloat64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.HarshAccelerationThreshold = data
+		case "harshBrakingThreshold":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("harshBrakingThreshold"))
+			data, err := ec.unmarshalOFloat2ᚖfloat64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.HarshBrakingThreshold = data
+		case "harshCorneringThreshold":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("harshCorneringThreshold"))
+			data, err := ec.unmarshalOFloat2ᚖfloat64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.HarshCorneringThreshold = data
+		case "maxSampleGapSeconds":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("maxSampleGapSeconds"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.MaxSampleGapSeconds = data
+		}
+	}
+	return it, nil
+}
+
 func (ec *executionContext) unmarshalInputEventFilter(ctx context.Context, obj any) (model.EventFilter, error) {
 	var it model.EventFilter
 	if obj == nil {
@@ -28481,7 +33291,7 @@ func (ec *executionContext) unmarshalInputEventFilter(ctx context.Context, obj a
 		asMap[k] = v
 	}
 
-	fieldsInOrder := [...]string{"name", "source", "tags"}
+	fieldsInOrder := [...]string{"name", "source", "metadata", "location", "tags"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -28502,6 +33312,20 @@ func (ec *executionContext) unmarshalInputEventFilter(ctx context.Context, obj a
 				return it, err
 			}
 			it.Source = data
+		case "metadata":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("metadata"))
+			data, err := ec.unmarshalOEventMetadataFilter2ᚕᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐEventMetadataFilterᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Metadata = data
+		case "location":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("location"))
+			data, err := ec.unmarshalOSignalLocationFilter2ᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐSignalLocationFilter(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Location = data
 		case "tags":
 			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("tags"))
 			data, err := ec.unmarshalOStringArrayFilter2ᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐStringArrayFilter(ctx, v)
@@ -28514,6 +33338,64 @@ func (ec *executionContext) unmarshalInputEventFilter(ctx context.Context, obj a
 	return it, nil
 }
 
+func (ec *executionContext) unmarshalInputEventMetadataFilter(ctx context.Context, obj any) (model.EventMetadataFilter, error) {
+	var it model.EventMetadataFilter
+	if obj == nil {
+		return it, nil
+	}
+
+	asMap := map[string]any{}
+	for k, v := range obj.(map[string]any) {
+		asMap[k] = v
+	}
+
+	fieldsInOrder := [...]string{"path", "eq", "in", "gt", "lt"}
+	for _, k := range fieldsInOrder {
+		v, ok := asMap[k]
+		if !ok {
+			continue
+		}
+		switch k {
+		case "path":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("path"))
+			data, err := ec.unmarshalNString2string(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Path = data
+		case "eq":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("eq"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Eq = data
+		case "in":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("in"))
+			data, err := ec.unmarshalOString2ᚕstringᚄ(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.In = data
+		case "gt":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("gt"))
+			data, err := ec.unmarshalOFloat2ᚖfloat64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Gt = data
+		case "lt":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("lt"))
+			data, err := ec.unmarshalOFloat2ᚖfloat64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Lt = data
+		}
+	}
+	return it, nil
+}
+
 func (ec *executionContext) unmarshalInputFilterLocation(ctx context.Context, obj any) (model.FilterLocation, error) {
 	var it model.FilterLocation
 	if obj == nil {
@@ -28614,8 +33496,20 @@ func (ec *executionContext) unmarshalInputSegmentConfig(ctx context.Context, obj
 	if _, present := asMap["minIncreasePercent"]; !present {
 		asMap["minIncreasePercent"] = 15
 	}
+	if _, present := asMap["stopRadiusMeters"]; !present {
+		asMap["stopRadiusMeters"] = 100
+	}
+	if _, present := asMap["minDropPercent"]; !present {
+		asMap["minDropPercent"] = 10
+	}
+	if _, present := asMap["routeToleranceMeters"]; !present {
+		asMap["routeToleranceMeters"] = 10
+	}
+	if _, present := asMap["routeFormat"]; !present {
+		asMap["routeFormat"] = "POLYLINE"
+	}
 
-	fieldsInOrder := [...]string{"maxGapSeconds", "minSegmentDurationSeconds", "signalCountThreshold", "maxIdleRpm", "minIncreasePercent"}
+	fieldsInOrder := [...]string{"maxGapSeconds", "minSegmentDurationSeconds", "signalCountThreshold", "maxIdleRpm", "minIncreasePercent", "thresholdSignal", "thresholdFilter", "geofence", "stopRadiusMeters", "batteryCapacityKwh", "fuelTankCapacityLiters", "fuelPricePerLiter", "minDropPercent", "routeToleranceMeters", "routeFormat"}
 	for _, k := range fieldsInOrder {
 		v, ok := asMap[k]
 		if !ok {
@@ -28657,6 +33551,76 @@ func (ec *executionContext) unmarshalInputSegmentConfig(ctx context.Context, obj
 				return it, err
 			}
 			it.MinIncreasePercent = data
+		case "thresholdSignal":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("thresholdSignal"))
+			data, err := ec.unmarshalOString2ᚖstring(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ThresholdSignal = data
+		case "thresholdFilter":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("thresholdFilter"))
+			data, err := ec.unmarshalOSignalFloatFilter2ᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐSignalFloatFilter(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.ThresholdFilter = data
+		case "geofence":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("geofence"))
+			data, err := ec.unmarshalOSignalLocationFilter2ᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐSignalLocationFilter(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.Geofence = data
+		case "stopRadiusMeters":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("stopRadiusMeters"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.StopRadiusMeters = data
+		case "batteryCapacityKwh":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("batteryCapacityKwh"))
+			data, err := ec.unmarshalOFloat2ᚖfloat64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.BatteryCapacityKwh = data
+		case "fuelTankCapacityLiters":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("fuelTankCapacityLiters"))
+			data, err := ec.unmarshalOFloat2ᚖfloat64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.FuelTankCapacityLiters = data
+		case "fuelPricePerLiter":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("fuelPricePerLiter"))
+			data, err := ec.unmarshalOFloat2ᚖfloat64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.FuelPricePerLiter = data
+		case "minDropPercent":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("minDropPercent"))
+			data, err := ec.unmarshalOInt2ᚖint(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.MinDropPercent = data
+		case "routeToleranceMeters":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("routeToleranceMeters"))
+			data, err := ec.unmarshalOFloat2ᚖfloat64(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.RouteToleranceMeters = data
+		case "routeFormat":
+			ctx := graphql.WithPathContext(ctx, graphql.NewPathWithField("routeFormat"))
+			data, err := ec.unmarshalORouteFormat2ᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐRouteFormat(ctx, v)
+			if err != nil {
+				return it, err
+			}
+			it.RouteFormat = data
 		}
 	}
 	return it, nil
@@ -29091,38 +34055,312 @@ func (ec *executionContext) _Attestation(ctx context.Context, sel ast.SelectionS
 	return out
 }
 
-var dailyActivityImplementors = []string{"DailyActivity"}
+var chargingSessionImplementors = []string{"ChargingSession"}
+
+func (ec *executionContext) _ChargingSession(ctx context.Context, sel ast.SelectionSet, obj *model.ChargingSession) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, chargingSessionImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("ChargingSession")
+		case "socStart":
+			out.Values[i] = ec._ChargingSession_socStart(ctx, field, obj)
+		case "socEnd":
+			out.Values[i] = ec._ChargingSession_socEnd(ctx, field, obj)
+		case "batteryCapacityKwh":
+			out.Values[i] = ec._ChargingSession_batteryCapacityKwh(ctx, field, obj)
+		case "energyAddedKwh":
+			out.Values[i] = ec._ChargingSession_energyAddedKwh(ctx, field, obj)
+		case "peakPowerKw":
+			out.Values[i] = ec._ChargingSession_peakPowerKw(ctx, field, obj)
+		case "averagePowerKw":
+			out.Values[i] = ec._ChargingSession_averagePowerKw(ctx, field, obj)
+		case "chargerType":
+			out.Values[i] = ec._ChargingSession_chargerType(ctx, field, obj)
+		case "location":
+			out.Values[i] = ec._ChargingSession_location(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.Deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.ProcessDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var coverageBucketImplementors = []string{"CoverageBucket"}
+
+func (ec *executionContext) _CoverageBucket(ctx context.Context, sel ast.SelectionSet, obj *model.CoverageBucket) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, coverageBucketImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("CoverageBucket")
+		case "timestamp":
+			out.Values[i] = ec._CoverageBucket_timestamp(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "count":
+			out.Values[i] = ec._CoverageBucket_count(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.Deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.ProcessDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var dailyActivityImplementors = []string{"DailyActivity"}
+
+func (ec *executionContext) _DailyActivity(ctx context.Context, sel ast.SelectionSet, obj *model.DailyActivity) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, dailyActivityImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("DailyActivity")
+		case "start":
+			out.Values[i] = ec._DailyActivity_start(ctx, field, obj)
+		case "end":
+			out.Values[i] = ec._DailyActivity_end(ctx, field, obj)
+		case "segmentCount":
+			out.Values[i] = ec._DailyActivity_segmentCount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "duration":
+			out.Values[i] = ec._DailyActivity_duration(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "signals":
+			out.Values[i] = ec._DailyActivity_signals(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "eventCounts":
+			out.Values[i] = ec._DailyActivity_eventCounts(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "score":
+			out.Values[i] = ec._DailyActivity_score(ctx, field, obj)
+		case "distanceKm":
+			out.Values[i] = ec._DailyActivity_distanceKm(ctx, field, obj)
+		case "distanceSource":
+			out.Values[i] = ec._DailyActivity_distanceSource(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.Deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.ProcessDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var dataGapImplementors = []string{"DataGap"}
+
+func (ec *executionContext) _DataGap(ctx context.Context, sel ast.SelectionSet, obj *model.DataGap) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, dataGapImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("DataGap")
+		case "start":
+			out.Values[i] = ec._DataGap_start(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "end":
+			out.Values[i] = ec._DataGap_end(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "duration":
+			out.Values[i] = ec._DataGap_duration(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.Deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.ProcessDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var dataSummaryImplementors = []string{"DataSummary"}
+
+func (ec *executionContext) _DataSummary(ctx context.Context, sel ast.SelectionSet, obj *model.DataSummary) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, dataSummaryImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("DataSummary")
+		case "numberOfSignals":
+			out.Values[i] = ec._DataSummary_numberOfSignals(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "availableSignals":
+			out.Values[i] = ec._DataSummary_availableSignals(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "firstSeen":
+			out.Values[i] = ec._DataSummary_firstSeen(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "lastSeen":
+			out.Values[i] = ec._DataSummary_lastSeen(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "signalDataSummary":
+			out.Values[i] = ec._DataSummary_signalDataSummary(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "eventDataSummary":
+			out.Values[i] = ec._DataSummary_eventDataSummary(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.Deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.ProcessDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var driverScoreImplementors = []string{"DriverScore"}
 
-func (ec *executionContext) _DailyActivity(ctx context.Context, sel ast.SelectionSet, obj *model.DailyActivity) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, dailyActivityImplementors)
+func (ec *executionContext) _DriverScore(ctx context.Context, sel ast.SelectionSet, obj *model.DriverScore) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, driverScoreImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("DailyActivity")
-		case "start":
-			out.Values[i] = ec._DailyActivity_start(ctx, field, obj)
-		case "end":
-			out.Values[i] = ec._DailyActivity_end(ctx, field, obj)
-		case "segmentCount":
-			out.Values[i] = ec._DailyActivity_segmentCount(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("DriverScore")
+		case "overall":
+			out.Values[i] = ec._DriverScore_overall(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "duration":
-			out.Values[i] = ec._DailyActivity_duration(ctx, field, obj)
+		case "harshBraking":
+			out.Values[i] = ec._DriverScore_harshBraking(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "signals":
-			out.Values[i] = ec._DailyActivity_signals(ctx, field, obj)
+		case "harshAcceleration":
+			out.Values[i] = ec._DriverScore_harshAcceleration(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "eventCounts":
-			out.Values[i] = ec._DailyActivity_eventCounts(ctx, field, obj)
+		case "harshCornering":
+			out.Values[i] = ec._DriverScore_harshCornering(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "speeding":
+			out.Values[i] = ec._DriverScore_speeding(ctx, field, obj)
+		case "nightDriving":
+			out.Values[i] = ec._DriverScore_nightDriving(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
@@ -29149,47 +34387,41 @@ func (ec *executionContext) _DailyActivity(ctx context.Context, sel ast.Selectio
 	return out
 }
 
-var dataSummaryImplementors = []string{"DataSummary"}
+var eventImplementors = []string{"Event"}
 
-func (ec *executionContext) _DataSummary(ctx context.Context, sel ast.SelectionSet, obj *model.DataSummary) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, dataSummaryImplementors)
+func (ec *executionContext) _Event(ctx context.Context, sel ast.SelectionSet, obj *model.Event) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, eventImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("DataSummary")
-		case "numberOfSignals":
-			out.Values[i] = ec._DataSummary_numberOfSignals(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "availableSignals":
-			out.Values[i] = ec._DataSummary_availableSignals(ctx, field, obj)
-			if out.Values[i] == graphql.Null {
-				out.Invalids++
-			}
-		case "firstSeen":
-			out.Values[i] = ec._DataSummary_firstSeen(ctx, field, obj)
+			out.Values[i] = graphql.MarshalString("Event")
+		case "timestamp":
+			out.Values[i] = ec._Event_timestamp(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "lastSeen":
-			out.Values[i] = ec._DataSummary_lastSeen(ctx, field, obj)
+		case "name":
+			out.Values[i] = ec._Event_name(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "signalDataSummary":
-			out.Values[i] = ec._DataSummary_signalDataSummary(ctx, field, obj)
+		case "source":
+			out.Values[i] = ec._Event_source(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "eventDataSummary":
-			out.Values[i] = ec._DataSummary_eventDataSummary(ctx, field, obj)
+		case "durationNs":
+			out.Values[i] = ec._Event_durationNs(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
+		case "metadata":
+			out.Values[i] = ec._Event_metadata(ctx, field, obj)
+		case "location":
+			out.Values[i] = ec._Event_location(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -29213,39 +34445,37 @@ func (ec *executionContext) _DataSummary(ctx context.Context, sel ast.SelectionS
 	return out
 }
 
-var eventImplementors = []string{"Event"}
+var eventAggregationImplementors = []string{"EventAggregation"}
 
-func (ec *executionContext) _Event(ctx context.Context, sel ast.SelectionSet, obj *model.Event) graphql.Marshaler {
-	fields := graphql.CollectFields(ec.OperationContext, sel, eventImplementors)
+func (ec *executionContext) _EventAggregation(ctx context.Context, sel ast.SelectionSet, obj *model.EventAggregation) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, eventAggregationImplementors)
 
 	out := graphql.NewFieldSet(fields)
 	deferred := make(map[string]*graphql.FieldSet)
 	for i, field := range fields {
 		switch field.Name {
 		case "__typename":
-			out.Values[i] = graphql.MarshalString("Event")
+			out.Values[i] = graphql.MarshalString("EventAggregation")
 		case "timestamp":
-			out.Values[i] = ec._Event_timestamp(ctx, field, obj)
+			out.Values[i] = ec._EventAggregation_timestamp(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
 		case "name":
-			out.Values[i] = ec._Event_name(ctx, field, obj)
+			out.Values[i] = ec._EventAggregation_name(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "source":
-			out.Values[i] = ec._Event_source(ctx, field, obj)
+		case "count":
+			out.Values[i] = ec._EventAggregation_count(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
 		case "durationNs":
-			out.Values[i] = ec._Event_durationNs(ctx, field, obj)
+			out.Values[i] = ec._EventAggregation_durationNs(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
 				out.Invalids++
 			}
-		case "metadata":
-			out.Values[i] = ec._Event_metadata(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -29367,6 +34597,98 @@ func (ec *executionContext) _EventDataSummary(ctx context.Context, sel ast.Selec
 	return out
 }
 
+var eventPageImplementors = []string{"EventPage"}
+
+func (ec *executionContext) _EventPage(ctx context.Context, sel ast.SelectionSet, obj *model.EventPage) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, eventPageImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("EventPage")
+		case "events":
+			out.Values[i] = ec._EventPage_events(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "hasMore":
+			out.Values[i] = ec._EventPage_hasMore(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "nextCursor":
+			out.Values[i] = ec._EventPage_nextCursor(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.Deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.ProcessDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var fuelDropDetailsImplementors = []string{"FuelDropDetails"}
+
+func (ec *executionContext) _FuelDropDetails(ctx context.Context, sel ast.SelectionSet, obj *model.FuelDropDetails) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, fuelDropDetailsImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("FuelDropDetails")
+		case "startLevel":
+			out.Values[i] = ec._FuelDropDetails_startLevel(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "endLevel":
+			out.Values[i] = ec._FuelDropDetails_endLevel(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "litersLost":
+			out.Values[i] = ec._FuelDropDetails_litersLost(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.Deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.ProcessDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
 var latestSignalImplementors = []string{"LatestSignal"}
 
 func (ec *executionContext) _LatestSignal(ctx context.Context, sel ast.SelectionSet, obj *model.LatestSignal) graphql.Marshaler {
@@ -29598,6 +34920,72 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 			}
 
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "signalDefinitions":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_signalDefinitions(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "dataCoverage":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_dataCoverage(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "samplingStats":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_samplingStats(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
 		case "events":
 			field := field
@@ -29617,6 +35005,50 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 			}
 
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "eventsPage":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_eventsPage(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "eventsAggregated":
+			field := field
+
+			innerFunc := func(ctx context.Context, fs *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_eventsAggregated(ctx, field)
+				if res == graphql.Null {
+					atomic.AddUint32(&fs.Invalids, 1)
+				}
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
 		case "segments":
 			field := field
@@ -29639,6 +35071,25 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
 			}
 
+			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
+		case "segment":
+			field := field
+
+			innerFunc := func(ctx context.Context, _ *graphql.FieldSet) (res graphql.Marshaler) {
+				defer func() {
+					if r := recover(); r != nil {
+						ec.Error(ctx, ec.Recover(ctx, r))
+					}
+				}()
+				res = ec._Query_segment(ctx, field)
+				return res
+			}
+
+			rrm := func(ctx context.Context) graphql.Marshaler {
+				return ec.OperationContext.RootResolverMiddleware(ctx,
+					func(ctx context.Context) graphql.Marshaler { return innerFunc(ctx, out) })
+			}
+
 			out.Concurrently(i, func(ctx context.Context) graphql.Marshaler { return rrm(innerCtx) })
 		case "dailyActivity":
 			field := field
@@ -29712,6 +35163,56 @@ func (ec *executionContext) _Query(ctx context.Context, sel ast.SelectionSet) gr
 	return out
 }
 
+var refuelDetailsImplementors = []string{"RefuelDetails"}
+
+func (ec *executionContext) _RefuelDetails(ctx context.Context, sel ast.SelectionSet, obj *model.RefuelDetails) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, refuelDetailsImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("RefuelDetails")
+		case "troughLevel":
+			out.Values[i] = ec._RefuelDetails_troughLevel(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "peakLevel":
+			out.Values[i] = ec._RefuelDetails_peakLevel(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "tankCapacityLiters":
+			out.Values[i] = ec._RefuelDetails_tankCapacityLiters(ctx, field, obj)
+		case "litersAdded":
+			out.Values[i] = ec._RefuelDetails_litersAdded(ctx, field, obj)
+		case "estimatedCost":
+			out.Values[i] = ec._RefuelDetails_estimatedCost(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.Deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.ProcessDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
 var segmentImplementors = []string{"Segment"}
 
 func (ec *executionContext) _Segment(ctx context.Context, sel ast.SelectionSet, obj *model.Segment) graphql.Marshaler {
@@ -29723,6 +35224,16 @@ func (ec *executionContext) _Segment(ctx context.Context, sel ast.SelectionSet,
 		switch field.Name {
 		case "__typename":
 			out.Values[i] = graphql.MarshalString("Segment")
+		case "id":
+			out.Values[i] = ec._Segment_id(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "mechanism":
+			out.Values[i] = ec._Segment_mechanism(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
 		case "start":
 			out.Values[i] = ec._Segment_start(ctx, field, obj)
 			if out.Values[i] == graphql.Null {
@@ -29749,6 +35260,112 @@ func (ec *executionContext) _Segment(ctx context.Context, sel ast.SelectionSet,
 			out.Values[i] = ec._Segment_signals(ctx, field, obj)
 		case "eventCounts":
 			out.Values[i] = ec._Segment_eventCounts(ctx, field, obj)
+		case "score":
+			out.Values[i] = ec._Segment_score(ctx, field, obj)
+		case "stop":
+			out.Values[i] = ec._Segment_stop(ctx, field, obj)
+		case "charging":
+			out.Values[i] = ec._Segment_charging(ctx, field, obj)
+		case "refuel":
+			out.Values[i] = ec._Segment_refuel(ctx, field, obj)
+		case "fuelDrop":
+			out.Values[i] = ec._Segment_fuelDrop(ctx, field, obj)
+		case "route":
+			out.Values[i] = ec._Segment_route(ctx, field, obj)
+		case "distanceKm":
+			out.Values[i] = ec._Segment_distanceKm(ctx, field, obj)
+		case "distanceSource":
+			out.Values[i] = ec._Segment_distanceSource(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.Deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.ProcessDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var segmentRouteImplementors = []string{"SegmentRoute"}
+
+func (ec *executionContext) _SegmentRoute(ctx context.Context, sel ast.SelectionSet, obj *model.SegmentRoute) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, segmentRouteImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("SegmentRoute")
+		case "format":
+			out.Values[i] = ec._SegmentRoute_format(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "value":
+			out.Values[i] = ec._SegmentRoute_value(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "pointCount":
+			out.Values[i] = ec._SegmentRoute_pointCount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.Deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.ProcessDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
+var segmentStopImplementors = []string{"SegmentStop"}
+
+func (ec *executionContext) _SegmentStop(ctx context.Context, sel ast.SelectionSet, obj *model.SegmentStop) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, segmentStopImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("SegmentStop")
+		case "centroid":
+			out.Values[i] = ec._SegmentStop_centroid(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "ignitionOn":
+			out.Values[i] = ec._SegmentStop_ignitionOn(ctx, field, obj)
 		default:
 			panic("unknown field " + strconv.Quote(field.Name))
 		}
@@ -33991,6 +39608,60 @@ func (ec *executionContext) _SignalCollection(ctx context.Context, sel ast.Selec
 	return out
 }
 
+var signalCoverageImplementors = []string{"SignalCoverage"}
+
+func (ec *executionContext) _SignalCoverage(ctx context.Context, sel ast.SelectionSet, obj *model.SignalCoverage) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, signalCoverageImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("SignalCoverage")
+		case "name":
+			out.Values[i] = ec._SignalCoverage_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "totalCount":
+			out.Values[i] = ec._SignalCoverage_totalCount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "buckets":
+			out.Values[i] = ec._SignalCoverage_buckets(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "gaps":
+			out.Values[i] = ec._SignalCoverage_gaps(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.Deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.ProcessDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
 var signalDataSummaryImplementors = []string{"SignalDataSummary"}
 
 func (ec *executionContext) _SignalDataSummary(ctx context.Context, sel ast.SelectionSet, obj *model.SignalDataSummary) graphql.Marshaler {
@@ -34045,6 +39716,76 @@ func (ec *executionContext) _SignalDataSummary(ctx context.Context, sel ast.Sele
 	return out
 }
 
+var signalDefinitionImplementors = []string{"SignalDefinition"}
+
+func (ec *executionContext) _SignalDefinition(ctx context.Context, sel ast.SelectionSet, obj *model.SignalDefinition) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, signalDefinitionImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("SignalDefinition")
+		case "name":
+			out.Values[i] = ec._SignalDefinition_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "vssPath":
+			out.Values[i] = ec._SignalDefinition_vssPath(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "valueType":
+			out.Values[i] = ec._SignalDefinition_valueType(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "unit":
+			out.Values[i] = ec._SignalDefinition_unit(ctx, field, obj)
+		case "min":
+			out.Values[i] = ec._SignalDefinition_min(ctx, field, obj)
+		case "max":
+			out.Values[i] = ec._SignalDefinition_max(ctx, field, obj)
+		case "description":
+			out.Values[i] = ec._SignalDefinition_description(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "privileges":
+			out.Values[i] = ec._SignalDefinition_privileges(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "aggregations":
+			out.Values[i] = ec._SignalDefinition_aggregations(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.Deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.ProcessDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
 var signalFloatImplementors = []string{"SignalFloat"}
 
 func (ec *executionContext) _SignalFloat(ctx context.Context, sel ast.SelectionSet, obj *model.SignalFloat) graphql.Marshaler {
@@ -34133,6 +39874,71 @@ func (ec *executionContext) _SignalLocation(ctx context.Context, sel ast.Selecti
 	return out
 }
 
+var signalSamplingStatsImplementors = []string{"SignalSamplingStats"}
+
+func (ec *executionContext) _SignalSamplingStats(ctx context.Context, sel ast.SelectionSet, obj *model.SignalSamplingStats) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, signalSamplingStatsImplementors)
+
+	out := graphql.NewFieldSet(fields)
+	deferred := make(map[string]*graphql.FieldSet)
+	for i, field := range fields {
+		switch field.Name {
+		case "__typename":
+			out.Values[i] = graphql.MarshalString("SignalSamplingStats")
+		case "name":
+			out.Values[i] = ec._SignalSamplingStats_name(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "source":
+			out.Values[i] = ec._SignalSamplingStats_source(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "sampleCount":
+			out.Values[i] = ec._SignalSamplingStats_sampleCount(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "activeHours":
+			out.Values[i] = ec._SignalSamplingStats_activeHours(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "samplesPerActiveHour":
+			out.Values[i] = ec._SignalSamplingStats_samplesPerActiveHour(ctx, field, obj)
+			if out.Values[i] == graphql.Null {
+				out.Invalids++
+			}
+		case "medianIntervalSeconds":
+			out.Values[i] = ec._SignalSamplingStats_medianIntervalSeconds(ctx, field, obj)
+		case "p95IntervalSeconds":
+			out.Values[i] = ec._SignalSamplingStats_p95IntervalSeconds(ctx, field, obj)
+		case "maxIntervalSeconds":
+			out.Values[i] = ec._SignalSamplingStats_maxIntervalSeconds(ctx, field, obj)
+		default:
+			panic("unknown field " + strconv.Quote(field.Name))
+		}
+	}
+	out.Dispatch(ctx)
+	if out.Invalids > 0 {
+		return graphql.Null
+	}
+
+	atomic.AddInt32(&ec.Deferred, int32(len(deferred)))
+
+	for label, dfs := range deferred {
+		ec.ProcessDeferredGroup(graphql.DeferredGroup{
+			Label:    label,
+			Path:     graphql.GetPath(ctx),
+			FieldSet: dfs,
+			Context:  ctx,
+		})
+	}
+
+	return out
+}
+
 var signalStringImplementors = []string{"SignalString"}
 
 func (ec *executionContext) _SignalString(ctx context.Context, sel ast.SelectionSet, obj *model.SignalString) graphql.Marshaler {
@@ -34218,6 +40024,28 @@ func (ec *executionContext) _SignalsSnapshotResponse(ctx context.Context, sel as
 	return out
 }
 
+var subscriptionImplementors = []string{"Subscription"}
+
+func (ec *executionContext) _Subscription(ctx context.Context, sel ast.SelectionSet) func(ctx context.Context) graphql.Marshaler {
+	fields := graphql.CollectFields(ec.OperationContext, sel, subscriptionImplementors)
+	ctx = graphql.WithFieldContext(ctx, &graphql.FieldContext{
+		Object: "Subscription",
+	})
+	if len(fields) != 1 {
+		graphql.AddErrorf(ctx, "must subscribe to exactly one stream")
+		return nil
+	}
+
+	switch fields[0].Name {
+	case "signalsLatest":
+		return ec._Subscription_signalsLatest(ctx, fields[0])
+	case "events":
+		return ec._Subscription_events(ctx, fields[0])
+	default:
+		panic("unknown field " + strconv.Quote(fields[0].Name))
+	}
+}
+
 var vINVCImplementors = []string{"VINVC"}
 
 func (ec *executionContext) _VINVC(ctx context.Context, sel ast.SelectionSet, obj *model.Vinvc) graphql.Marshaler {
@@ -34640,6 +40468,32 @@ func (ec *executionContext) marshalNBoolean2bool(ctx context.Context, sel ast.Se
 	return res
 }
 
+func (ec *executionContext) marshalNCoverageBucket2ᚕᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐCoverageBucketᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.CoverageBucket) graphql.Marshaler {
+	ret := graphql.MarshalSliceConcurrently(ctx, len(v), 0, false, func(ctx context.Context, i int) graphql.Marshaler {
+		fc := graphql.GetFieldContext(ctx)
+		fc.Result = &v[i]
+		return ec.marshalNCoverageBucket2ᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐCoverageBucket(ctx, sel, v[i])
+	})
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNCoverageBucket2ᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐCoverageBucket(ctx context.Context, sel ast.SelectionSet, v *model.CoverageBucket) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._CoverageBucket(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalNDailyActivity2ᚕᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐDailyActivityᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.DailyActivity) graphql.Marshaler {
 	ret := graphql.MarshalSliceConcurrently(ctx, len(v), 0, false, func(ctx context.Context, i int) graphql.Marshaler {
 		fc := graphql.GetFieldContext(ctx)
@@ -34666,6 +40520,32 @@ func (ec *executionContext) marshalNDailyActivity2ᚖgithubᚗcomᚋDIMOᚑNetwo
 	return ec._DailyActivity(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalNDataGap2ᚕᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐDataGapᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.DataGap) graphql.Marshaler {
+	ret := graphql.MarshalSliceConcurrently(ctx, len(v), 0, false, func(ctx context.Context, i int) graphql.Marshaler {
+		fc := graphql.GetFieldContext(ctx)
+		fc.Result = &v[i]
+		return ec.marshalNDataGap2ᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐDataGap(ctx, sel, v[i])
+	})
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNDataGap2ᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐDataGap(ctx context.Context, sel ast.SelectionSet, v *model.DataGap) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._DataGap(ctx, sel, v)
+}
+
 func (ec *executionContext) unmarshalNDetectionMechanism2githubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐDetectionMechanism(ctx context.Context, v any) (model.DetectionMechanism, error) {
 	var res model.DetectionMechanism
 	err := res.UnmarshalGQL(v)
@@ -34676,6 +40556,26 @@ func (ec *executionContext) marshalNDetectionMechanism2githubᚗcomᚋDIMOᚑNet
 	return v
 }
 
+func (ec *executionContext) marshalNEvent2githubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐEvent(ctx context.Context, sel ast.SelectionSet, v model.Event) graphql.Marshaler {
+	return ec._Event(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNEvent2ᚕᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐEventᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.Event) graphql.Marshaler {
+	ret := graphql.MarshalSliceConcurrently(ctx, len(v), 0, false, func(ctx context.Context, i int) graphql.Marshaler {
+		fc := graphql.GetFieldContext(ctx)
+		fc.Result = &v[i]
+		return ec.marshalNEvent2ᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐEvent(ctx, sel, v[i])
+	})
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
 func (ec *executionContext) marshalNEvent2ᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐEvent(ctx context.Context, sel ast.SelectionSet, v *model.Event) graphql.Marshaler {
 	if v == nil {
 		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
@@ -34686,6 +40586,32 @@ func (ec *executionContext) marshalNEvent2ᚖgithubᚗcomᚋDIMOᚑNetworkᚋtel
 	return ec._Event(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalNEventAggregation2ᚕᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐEventAggregationᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.EventAggregation) graphql.Marshaler {
+	ret := graphql.MarshalSliceConcurrently(ctx, len(v), 0, false, func(ctx context.Context, i int) graphql.Marshaler {
+		fc := graphql.GetFieldContext(ctx)
+		fc.Result = &v[i]
+		return ec.marshalNEventAggregation2ᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐEventAggregation(ctx, sel, v[i])
+	})
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNEventAggregation2ᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐEventAggregation(ctx context.Context, sel ast.SelectionSet, v *model.EventAggregation) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._EventAggregation(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalNEventCount2ᚕᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐEventCountᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.EventCount) graphql.Marshaler {
 	ret := graphql.MarshalSliceConcurrently(ctx, len(v), 0, false, func(ctx context.Context, i int) graphql.Marshaler {
 		fc := graphql.GetFieldContext(ctx)
@@ -34738,6 +40664,25 @@ func (ec *executionContext) marshalNEventDataSummary2ᚖgithubᚗcomᚋDIMOᚑNe
 	return ec._EventDataSummary(ctx, sel, v)
 }
 
+func (ec *executionContext) unmarshalNEventMetadataFilter2ᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐEventMetadataFilter(ctx context.Context, v any) (*model.EventMetadataFilter, error) {
+	res, err := ec.unmarshalInputEventMetadataFilter(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNEventPage2githubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐEventPage(ctx context.Context, sel ast.SelectionSet, v model.EventPage) graphql.Marshaler {
+	return ec._EventPage(ctx, sel, &v)
+}
+
+func (ec *executionContext) marshalNEventPage2ᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐEventPage(ctx context.Context, sel ast.SelectionSet, v *model.EventPage) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._EventPage(ctx, sel, v)
+}
+
 func (ec *executionContext) unmarshalNFilterLocation2ᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐFilterLocation(ctx context.Context, v any) (*model.FilterLocation, error) {
 	res, err := ec.unmarshalInputFilterLocation(ctx, v)
 	return &res, graphql.ErrorOnPath(ctx, err)
@@ -34769,6 +40714,22 @@ func (ec *executionContext) marshalNFloatAggregation2githubᚗcomᚋDIMOᚑNetwo
 	return v
 }
 
+func (ec *executionContext) unmarshalNID2string(ctx context.Context, v any) (string, error) {
+	res, err := graphql.UnmarshalID(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNID2string(ctx context.Context, sel ast.SelectionSet, v string) graphql.Marshaler {
+	_ = sel
+	res := graphql.MarshalID(v)
+	if res == graphql.Null {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+	}
+	return res
+}
+
 func (ec *executionContext) unmarshalNInt2int(ctx context.Context, v any) (int, error) {
 	res, err := graphql.UnmarshalInt(v)
 	return res, graphql.ErrorOnPath(ctx, err)
@@ -34900,6 +40861,16 @@ func (ec *executionContext) marshalNPrivilege2ᚕstringᚄ(ctx context.Context,
 	return ret
 }
 
+func (ec *executionContext) unmarshalNRouteFormat2githubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐRouteFormat(ctx context.Context, v any) (model.RouteFormat, error) {
+	var res model.RouteFormat
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalNRouteFormat2githubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐRouteFormat(ctx context.Context, sel ast.SelectionSet, v model.RouteFormat) graphql.Marshaler {
+	return v
+}
+
 func (ec *executionContext) marshalNSegment2ᚕᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐSegmentᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.Segment) graphql.Marshaler {
 	ret := graphql.MarshalSliceConcurrently(ctx, len(v), 0, false, func(ctx context.Context, i int) graphql.Marshaler {
 		fc := graphql.GetFieldContext(ctx)
@@ -34972,6 +40943,32 @@ func (ec *executionContext) marshalNSignalAggregations2ᚖgithubᚗcomᚋDIMOᚑ
 	return ec._SignalAggregations(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalNSignalCoverage2ᚕᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐSignalCoverageᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.SignalCoverage) graphql.Marshaler {
+	ret := graphql.MarshalSliceConcurrently(ctx, len(v), 0, false, func(ctx context.Context, i int) graphql.Marshaler {
+		fc := graphql.GetFieldContext(ctx)
+		fc.Result = &v[i]
+		return ec.marshalNSignalCoverage2ᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐSignalCoverage(ctx, sel, v[i])
+	})
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNSignalCoverage2ᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐSignalCoverage(ctx context.Context, sel ast.SelectionSet, v *model.SignalCoverage) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._SignalCoverage(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalNSignalDataSummary2ᚕᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐSignalDataSummaryᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.SignalDataSummary) graphql.Marshaler {
 	ret := graphql.MarshalSliceConcurrently(ctx, len(v), 0, false, func(ctx context.Context, i int) graphql.Marshaler {
 		fc := graphql.GetFieldContext(ctx)
@@ -34998,6 +40995,32 @@ func (ec *executionContext) marshalNSignalDataSummary2ᚖgithubᚗcomᚋDIMOᚑN
 	return ec._SignalDataSummary(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalNSignalDefinition2ᚕᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐSignalDefinitionᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.SignalDefinition) graphql.Marshaler {
+	ret := graphql.MarshalSliceConcurrently(ctx, len(v), 0, false, func(ctx context.Context, i int) graphql.Marshaler {
+		fc := graphql.GetFieldContext(ctx)
+		fc.Result = &v[i]
+		return ec.marshalNSignalDefinition2ᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐSignalDefinition(ctx, sel, v[i])
+	})
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNSignalDefinition2ᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐSignalDefinition(ctx context.Context, sel ast.SelectionSet, v *model.SignalDefinition) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._SignalDefinition(ctx, sel, v)
+}
+
 func (ec *executionContext) unmarshalNSignalFloatFilter2ᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐSignalFloatFilter(ctx context.Context, v any) (*model.SignalFloatFilter, error) {
 	res, err := ec.unmarshalInputSignalFloatFilter(ctx, v)
 	return &res, graphql.ErrorOnPath(ctx, err)
@@ -35013,6 +41036,32 @@ func (ec *executionContext) marshalNSignalLocation2ᚖgithubᚗcomᚋDIMOᚑNetw
 	return ec._SignalLocation(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalNSignalSamplingStats2ᚕᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐSignalSamplingStatsᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.SignalSamplingStats) graphql.Marshaler {
+	ret := graphql.MarshalSliceConcurrently(ctx, len(v), 0, false, func(ctx context.Context, i int) graphql.Marshaler {
+		fc := graphql.GetFieldContext(ctx)
+		fc.Result = &v[i]
+		return ec.marshalNSignalSamplingStats2ᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐSignalSamplingStats(ctx, sel, v[i])
+	})
+
+	for _, e := range ret {
+		if e == graphql.Null {
+			return graphql.Null
+		}
+	}
+
+	return ret
+}
+
+func (ec *executionContext) marshalNSignalSamplingStats2ᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐSignalSamplingStats(ctx context.Context, sel ast.SelectionSet, v *model.SignalSamplingStats) graphql.Marshaler {
+	if v == nil {
+		if !graphql.HasFieldError(ctx, graphql.GetFieldContext(ctx)) {
+			graphql.AddErrorf(ctx, "the requested element is null which the schema does not allow")
+		}
+		return graphql.Null
+	}
+	return ec._SignalSamplingStats(ctx, sel, v)
+}
+
 func (ec *executionContext) unmarshalNString2string(ctx context.Context, v any) (string, error) {
 	res, err := graphql.UnmarshalString(v)
 	return res, graphql.ErrorOnPath(ctx, err)
@@ -35328,6 +41377,29 @@ func (ec *executionContext) marshalOBoolean2ᚖbool(ctx context.Context, sel ast
 	return res
 }
 
+func (ec *executionContext) unmarshalOChargerType2ᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐChargerType(ctx context.Context, v any) (*model.ChargerType, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(model.ChargerType)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalOChargerType2ᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐChargerType(ctx context.Context, sel ast.SelectionSet, v *model.ChargerType) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
+func (ec *executionContext) marshalOChargingSession2ᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐChargingSession(ctx context.Context, sel ast.SelectionSet, v *model.ChargingSession) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._ChargingSession(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalODataSummary2ᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐDataSummary(ctx context.Context, sel ast.SelectionSet, v *model.DataSummary) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
@@ -35335,6 +41407,37 @@ func (ec *executionContext) marshalODataSummary2ᚖgithubᚗcomᚋDIMOᚑNetwork
 	return ec._DataSummary(ctx, sel, v)
 }
 
+func (ec *executionContext) unmarshalODerivedEventsConfig2ᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐDerivedEventsConfig(ctx context.Context, v any) (*model.DerivedEventsConfig, error) {
+	if v == nil {
+		return nil, nil
+	}
+	res, err := ec.unmarshalInputDerivedEventsConfig(ctx, v)
+	return &res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) unmarshalODistanceSource2ᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐDistanceSource(ctx context.Context, v any) (*model.DistanceSource, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(model.DistanceSource)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalODistanceSource2ᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐDistanceSource(ctx context.Context, sel ast.SelectionSet, v *model.DistanceSource) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
+func (ec *executionContext) marshalODriverScore2ᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐDriverScore(ctx context.Context, sel ast.SelectionSet, v *model.DriverScore) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._DriverScore(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalOEvent2ᚕᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐEventᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.Event) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
@@ -35381,6 +41484,24 @@ func (ec *executionContext) unmarshalOEventFilter2ᚖgithubᚗcomᚋDIMOᚑNetwo
 	return &res, graphql.ErrorOnPath(ctx, err)
 }
 
+func (ec *executionContext) unmarshalOEventMetadataFilter2ᚕᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐEventMetadataFilterᚄ(ctx context.Context, v any) ([]*model.EventMetadataFilter, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var vSlice []any
+	vSlice = graphql.CoerceList(v)
+	var err error
+	res := make([]*model.EventMetadataFilter, len(vSlice))
+	for i := range vSlice {
+		ctx := graphql.WithPathContext(ctx, graphql.NewPathWithIndex(i))
+		res[i], err = ec.unmarshalNEventMetadataFilter2ᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐEventMetadataFilter(ctx, vSlice[i])
+		if err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
 func (ec *executionContext) unmarshalOFilterLocation2ᚕᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐFilterLocationᚄ(ctx context.Context, v any) ([]*model.FilterLocation, error) {
 	if v == nil {
 		return nil, nil
@@ -35452,6 +41573,13 @@ func (ec *executionContext) marshalOFloat2ᚖfloat64(ctx context.Context, sel as
 	return graphql.WrapContextMarshaler(ctx, res)
 }
 
+func (ec *executionContext) marshalOFuelDropDetails2ᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐFuelDropDetails(ctx context.Context, sel ast.SelectionSet, v *model.FuelDropDetails) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._FuelDropDetails(ctx, sel, v)
+}
+
 func (ec *executionContext) unmarshalOInCircleFilter2ᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐInCircleFilter(ctx context.Context, v any) (*model.InCircleFilter, error) {
 	if v == nil {
 		return nil, nil
@@ -35485,6 +41613,36 @@ func (ec *executionContext) marshalOLocation2ᚖgithubᚗcomᚋDIMOᚑNetworkᚋ
 	return ec._Location(ctx, sel, v)
 }
 
+func (ec *executionContext) marshalORefuelDetails2ᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐRefuelDetails(ctx context.Context, sel ast.SelectionSet, v *model.RefuelDetails) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._RefuelDetails(ctx, sel, v)
+}
+
+func (ec *executionContext) unmarshalORouteFormat2ᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐRouteFormat(ctx context.Context, v any) (*model.RouteFormat, error) {
+	if v == nil {
+		return nil, nil
+	}
+	var res = new(model.RouteFormat)
+	err := res.UnmarshalGQL(v)
+	return res, graphql.ErrorOnPath(ctx, err)
+}
+
+func (ec *executionContext) marshalORouteFormat2ᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐRouteFormat(ctx context.Context, sel ast.SelectionSet, v *model.RouteFormat) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return v
+}
+
+func (ec *executionContext) marshalOSegment2ᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐSegment(ctx context.Context, sel ast.SelectionSet, v *model.Segment) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._Segment(ctx, sel, v)
+}
+
 func (ec *executionContext) unmarshalOSegmentConfig2ᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐSegmentConfig(ctx context.Context, v any) (*model.SegmentConfig, error) {
 	if v == nil {
 		return nil, nil
@@ -35511,6 +41669,13 @@ func (ec *executionContext) unmarshalOSegmentEventRequest2ᚕᚖgithubᚗcomᚋD
 	return res, nil
 }
 
+func (ec *executionContext) marshalOSegmentRoute2ᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐSegmentRoute(ctx context.Context, sel ast.SelectionSet, v *model.SegmentRoute) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._SegmentRoute(ctx, sel, v)
+}
+
 func (ec *executionContext) unmarshalOSegmentSignalRequest2ᚕᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐSegmentSignalRequestᚄ(ctx context.Context, v any) ([]*model.SegmentSignalRequest, error) {
 	if v == nil {
 		return nil, nil
@@ -35529,6 +41694,13 @@ func (ec *executionContext) unmarshalOSegmentSignalRequest2ᚕᚖgithubᚗcomᚋ
 	return res, nil
 }
 
+func (ec *executionContext) marshalOSegmentStop2ᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐSegmentStop(ctx context.Context, sel ast.SelectionSet, v *model.SegmentStop) graphql.Marshaler {
+	if v == nil {
+		return graphql.Null
+	}
+	return ec._SegmentStop(ctx, sel, v)
+}
+
 func (ec *executionContext) marshalOSignalAggregationValue2ᚕᚖgithubᚗcomᚋDIMOᚑNetworkᚋtelemetryᚑapiᚋinternalᚋgraphᚋmodelᚐSignalAggregationValueᚄ(ctx context.Context, sel ast.SelectionSet, v []*model.SignalAggregationValue) graphql.Marshaler {
 	if v == nil {
 		return graphql.Null
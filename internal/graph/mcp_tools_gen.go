@@ -48,8 +48,10 @@ var MCPTools = []mcpserver.ToolDefinition{
 		Args: []mcpserver.ArgDefinition{
 			{Name: "tokenId", Type: "integer", Description: "tokenId (Int!, required)", Required: true, ItemsType: ""},
 			{Name: "filter", Type: "object", Description: "filter (SignalFilter, optional)", Required: false, ItemsType: ""},
+			{Name: "from", Type: "string", Description: "Only include signals stored at or after this time. Set together with to; the range may span at most 366 days. Without from and to, all stored signals are considered.", Required: false, ItemsType: ""},
+			{Name: "to", Type: "string", Description: "Only include signals stored before this time. Set together with from.", Required: false, ItemsType: ""},
 		},
-		Query: "query($tokenId: Int!, $filter: SignalFilter) { availableSignals(tokenId: $tokenId, filter: $filter) }",
+		Query: "query($tokenId: Int!, $filter: SignalFilter, $from: Time, $to: Time) { availableSignals(tokenId: $tokenId, filter: $filter, from: $from, to: $to) }",
 		Annotations: &mcp.ToolAnnotations{
 			ReadOnlyHint:    true,
 			DestructiveHint: boolPtr(false),
@@ -78,8 +80,10 @@ var MCPTools = []mcpserver.ToolDefinition{
 		Args: []mcpserver.ArgDefinition{
 			{Name: "tokenId", Type: "integer", Description: "tokenId (Int!, required)", Required: true, ItemsType: ""},
 			{Name: "filter", Type: "object", Description: "filter (SignalFilter, optional)", Required: false, ItemsType: ""},
+			{Name: "from", Type: "string", Description: "Only include data stored at or after this time. Set together with to.", Required: false, ItemsType: ""},
+			{Name: "to", Type: "string", Description: "Only include data stored before this time. Set together with from.", Required: false, ItemsType: ""},
 		},
-		Query: "query($tokenId: Int!, $filter: SignalFilter) { dataSummary(tokenId: $tokenId, filter: $filter) { numberOfSignals availableSignals firstSeen lastSeen signalDataSummary { name numberOfSignals firstSeen lastSeen } eventDataSummary { name numberOfEvents firstSeen lastSeen } } }",
+		Query: "query($tokenId: Int!, $filter: SignalFilter, $from: Time, $to: Time) { dataSummary(tokenId: $tokenId, filter: $filter, from: $from, to: $to) { numberOfSignals availableSignals firstSeen lastSeen signalDataSummary { name numberOfSignals firstSeen lastSeen } eventDataSummary { name numberOfEvents firstSeen lastSeen } } }",
 		Annotations: &mcp.ToolAnnotations{
 			ReadOnlyHint:    true,
 			DestructiveHint: boolPtr(false),
@@ -103,6 +107,56 @@ var MCPTools = []mcpserver.ToolDefinition{
 			IdempotentHint:  true,
 		},
 	},
+	{
+		Name:        "telemetry_get_signal_definitions",
+		Description: "List every queryable signal with its VSS path, value type, unit, description, required privileges and allowed aggregations. Use to discover signal names before querying.",
+		Args:        []mcpserver.ArgDefinition{},
+		Query:       "query { signalDefinitions { name vssPath valueType unit min max description privileges aggregations } }",
+		Annotations: &mcp.ToolAnnotations{
+			ReadOnlyHint:    true,
+			DestructiveHint: boolPtr(false),
+			OpenWorldHint:   boolPtr(false),
+			IdempotentHint:  true,
+		},
+	},
+	{
+		Name:        "telemetry_get_data_coverage",
+		Description: "Get per-bucket sample counts and gaps for each signal of a vehicle over a time range. Use to find when and which signals stopped reporting.",
+		Args: []mcpserver.ArgDefinition{
+			{Name: "tokenId", Type: "integer", Description: "tokenId (Int!, required)", Required: true, ItemsType: ""},
+			{Name: "from", Type: "string", Description: "from (Time!, required)", Required: true, ItemsType: ""},
+			{Name: "to", Type: "string", Description: "to (Time!, required)", Required: true, ItemsType: ""},
+			{Name: "interval", Type: "string", Description: "Bucket size as a duration string of whole seconds (e.g., \"15m\", \"1h\", \"24h\").\nAt most 5000 buckets may be requested.", Required: true, ItemsType: ""},
+			{Name: "names", Type: "array", Description: "Signal names to report. Names without samples in the range are reported with a\nsingle gap spanning the range. Defaults to every signal with samples in the range.", Required: false, ItemsType: "string"},
+			{Name: "minGapSeconds", Type: "integer", Description: "Minimum gap length in seconds to report. Default: 3600 (1 hour), Min: 60", Required: false, ItemsType: ""},
+			{Name: "filter", Type: "object", Description: "filter (SignalFilter, optional)", Required: false, ItemsType: ""},
+		},
+		Query: "query($tokenId: Int!, $from: Time!, $to: Time!, $interval: String!, $names: [String!], $minGapSeconds: Int, $filter: SignalFilter) { dataCoverage(tokenId: $tokenId, from: $from, to: $to, interval: $interval, names: $names, minGapSeconds: $minGapSeconds, filter: $filter) { name totalCount buckets { timestamp count } gaps { start end duration } } }",
+		Annotations: &mcp.ToolAnnotations{
+			ReadOnlyHint:    true,
+			DestructiveHint: boolPtr(false),
+			OpenWorldHint:   boolPtr(false),
+			IdempotentHint:  true,
+		},
+	},
+	{
+		Name:        "telemetry_get_sampling_stats",
+		Description: "Get sampling statistics per signal and source for a vehicle over a time range: sample count, median/p95/max interval between samples, and samples per active hour.",
+		Args: []mcpserver.ArgDefinition{
+			{Name: "tokenId", Type: "integer", Description: "tokenId (Int!, required)", Required: true, ItemsType: ""},
+			{Name: "from", Type: "string", Description: "from (Time!, required)", Required: true, ItemsType: ""},
+			{Name: "to", Type: "string", Description: "to (Time!, required)", Required: true, ItemsType: ""},
+			{Name: "names", Type: "array", Description: "Signal names to report. Defaults to every signal with samples in the range.", Required: false, ItemsType: "string"},
+			{Name: "filter", Type: "object", Description: "filter (SignalFilter, optional)", Required: false, ItemsType: ""},
+		},
+		Query: "query($tokenId: Int!, $from: Time!, $to: Time!, $names: [String!], $filter: SignalFilter) { samplingStats(tokenId: $tokenId, from: $from, to: $to, names: $names, filter: $filter) { name source sampleCount activeHours samplesPerActiveHour medianIntervalSeconds p95IntervalSeconds maxIntervalSeconds } }",
+		Annotations: &mcp.ToolAnnotations{
+			ReadOnlyHint:    true,
+			DestructiveHint: boolPtr(false),
+			OpenWorldHint:   boolPtr(false),
+			IdempotentHint:  true,
+		},
+	},
 	{
 		Name:        "telemetry_get_events",
 		Description: "Get discrete events for a vehicle in a time range. Returns event name, source, timestamp, duration, and optional metadata.",
@@ -111,8 +165,47 @@ var MCPTools = []mcpserver.ToolDefinition{
 			{Name: "from", Type: "string", Description: "from (Time!, required)", Required: true, ItemsType: ""},
 			{Name: "to", Type: "string", Description: "to (Time!, required)", Required: true, ItemsType: ""},
 			{Name: "filter", Type: "object", Description: "filter (EventFilter, optional)", Required: false, ItemsType: ""},
+			{Name: "derivedEvents", Type: "object", Description: "Also derive behavior.harshAcceleration, behavior.harshBraking and behavior.harshCornering\nevents from the speed and angularVelocityYaw signals. There are no acceleration signals, so\nlongitudinal acceleration is the change of speed between samples. Derived events have the\nsource \"telemetry-api:derived\", no location, no tags, and metadata {\"peak\", \"threshold\",\n\"speedKph\"} with accelerations in m/s². They are filtered by filter.name and filter.source;\na filter with metadata, location or tags conditions excludes them. With derivedEvents, the\ndate range may span at most 31 days.", Required: false, ItemsType: ""},
+			{Name: "limit", Type: "integer", Description: "Maximum number of events to return, newest first. Default 1000, max 1000. Use eventsPage to read more.", Required: false, ItemsType: ""},
+		},
+		Query: "query($tokenId: Int!, $from: Time!, $to: Time!, $filter: EventFilter, $derivedEvents: DerivedEventsConfig, $limit: Int) { events(tokenId: $tokenId, from: $from, to: $to, filter: $filter, derivedEvents: $derivedEvents, limit: $limit) { timestamp name source durationNs metadata } }",
+		Annotations: &mcp.ToolAnnotations{
+			ReadOnlyHint:    true,
+			DestructiveHint: boolPtr(false),
+			OpenWorldHint:   boolPtr(false),
+			IdempotentHint:  true,
+		},
+	},
+	{
+		Name:        "telemetry_get_events_page",
+		Description: "Get one page of events for a vehicle in a time range, oldest first. Pass nextCursor as after to fetch the next page while hasMore is true.",
+		Args: []mcpserver.ArgDefinition{
+			{Name: "tokenId", Type: "integer", Description: "tokenId (Int!, required)", Required: true, ItemsType: ""},
+			{Name: "from", Type: "string", Description: "from (Time!, required)", Required: true, ItemsType: ""},
+			{Name: "to", Type: "string", Description: "to (Time!, required)", Required: true, ItemsType: ""},
+			{Name: "filter", Type: "object", Description: "filter (EventFilter, optional)", Required: false, ItemsType: ""},
+			{Name: "limit", Type: "integer", Description: "Maximum number of events to return. Default 100, max 1000.", Required: false, ItemsType: ""},
+			{Name: "after", Type: "string", Description: "Cursor for pagination: return only events with timestamp > after (exclusive).\nPass the nextCursor of the previous page for the next page.", Required: false, ItemsType: ""},
 		},
-		Query: "query($tokenId: Int!, $from: Time!, $to: Time!, $filter: EventFilter) { events(tokenId: $tokenId, from: $from, to: $to, filter: $filter) { timestamp name source durationNs metadata } }",
+		Query: "query($tokenId: Int!, $from: Time!, $to: Time!, $filter: EventFilter, $limit: Int, $after: Time) { eventsPage(tokenId: $tokenId, from: $from, to: $to, filter: $filter, limit: $limit, after: $after) { hasMore nextCursor events { timestamp name source durationNs metadata } } }",
+		Annotations: &mcp.ToolAnnotations{
+			ReadOnlyHint:    true,
+			DestructiveHint: boolPtr(false),
+			OpenWorldHint:   boolPtr(false),
+			IdempotentHint:  true,
+		},
+	},
+	{
+		Name:        "telemetry_get_events_aggregated",
+		Description: "Get event counts and total durations per event name per time bucket for a vehicle. Use instead of get_events when only counts are needed.",
+		Args: []mcpserver.ArgDefinition{
+			{Name: "tokenId", Type: "integer", Description: "tokenId (Int!, required)", Required: true, ItemsType: ""},
+			{Name: "from", Type: "string", Description: "from (Time!, required)", Required: true, ItemsType: ""},
+			{Name: "to", Type: "string", Description: "to (Time!, required)", Required: true, ItemsType: ""},
+			{Name: "interval", Type: "string", Description: "Duration string for the buckets (e.g., \"5m\", \"1h\", \"24h\"). Valid units: ms, s, m, h.", Required: true, ItemsType: ""},
+			{Name: "filter", Type: "object", Description: "filter (EventFilter, optional)", Required: false, ItemsType: ""},
+		},
+		Query: "query($tokenId: Int!, $from: Time!, $to: Time!, $interval: String!, $filter: EventFilter) { eventsAggregated(tokenId: $tokenId, from: $from, to: $to, interval: $interval, filter: $filter) { timestamp name count durationNs } }",
 		Annotations: &mcp.ToolAnnotations{
 			ReadOnlyHint:    true,
 			DestructiveHint: boolPtr(false),
@@ -122,19 +215,38 @@ var MCPTools = []mcpserver.ToolDefinition{
 	},
 	{
 		Name:        "telemetry_get_trip_segments",
-		Description: "Get vehicle trip/activity segments detected using a specified mechanism (frequencyAnalysis, ignitionDetection, changePointDetection, idling, refuel, recharge). Returns start/end locations, duration, and optional signal aggregates and event counts. Maximum date range: 31 days.",
+		Description: "Get vehicle trip/activity segments detected using a specified mechanism (frequencyAnalysis, ignitionDetection, changePointDetection, idling, refuel, recharge, threshold, geofence, stops, fuelDrop, auto). Returns start/end locations, duration, and optional signal aggregates and event counts. Maximum date range: 31 days, or 366 days within the last year with the default config where the segment store is enabled; a longer range it doesn't cover yet fails with an error asking to retry in a few minutes.",
 		Args: []mcpserver.ArgDefinition{
 			{Name: "tokenId", Type: "integer", Description: "tokenId (Int!, required)", Required: true, ItemsType: ""},
 			{Name: "from", Type: "string", Description: "from (Time!, required)", Required: true, ItemsType: ""},
 			{Name: "to", Type: "string", Description: "to (Time!, required)", Required: true, ItemsType: ""},
-			{Name: "mechanism", Type: "string", Description: "mechanism (DetectionMechanism!, required)", Required: true, ItemsType: "", EnumValues: []string{"ignitionDetection", "frequencyAnalysis", "changePointDetection", "idling", "refuel", "recharge"}},
+			{Name: "mechanism", Type: "string", Description: "mechanism (DetectionMechanism!, required)", Required: true, ItemsType: "", EnumValues: []string{"ignitionDetection", "frequencyAnalysis", "changePointDetection", "idling", "refuel", "recharge", "threshold", "geofence", "stops", "fuelDrop", "auto"}},
 			{Name: "config", Type: "object", Description: "config (SegmentConfig, optional)", Required: false, ItemsType: ""},
 			{Name: "signalRequests", Type: "array", Description: "signalRequests ([SegmentSignalRequest!], optional)", Required: false, ItemsType: "object"},
 			{Name: "eventRequests", Type: "array", Description: "eventRequests ([SegmentEventRequest!], optional)", Required: false, ItemsType: "object"},
 			{Name: "limit", Type: "integer", Description: "Maximum number of segments to return. Default 100, max 200.", Required: false, ItemsType: ""},
 			{Name: "after", Type: "string", Description: "Cursor for pagination: return only segments with startTime > after (exclusive).\nPass the startTime of the last segment from the previous page for the next page.", Required: false, ItemsType: ""},
 		},
-		Query: "query($tokenId: Int!, $from: Time!, $to: Time!, $mechanism: DetectionMechanism!, $config: SegmentConfig, $signalRequests: [SegmentSignalRequest!], $eventRequests: [SegmentEventRequest!], $limit: Int, $after: Time) { segments(tokenId: $tokenId, from: $from, to: $to, mechanism: $mechanism, config: $config, signalRequests: $signalRequests, eventRequests: $eventRequests, limit: $limit, after: $after) { start { timestamp value { latitude longitude } } end { timestamp value { latitude longitude } } duration isOngoing startedBeforeRange signals { name agg value } eventCounts { name count } } }",
+		Query: "query($tokenId: Int!, $from: Time!, $to: Time!, $mechanism: DetectionMechanism!, $config: SegmentConfig, $signalRequests: [SegmentSignalRequest!], $eventRequests: [SegmentEventRequest!], $limit: Int, $after: Time) { segments(tokenId: $tokenId, from: $from, to: $to, mechanism: $mechanism, config: $config, signalRequests: $signalRequests, eventRequests: $eventRequests, limit: $limit, after: $after) { id mechanism start { timestamp value { latitude longitude } } end { timestamp value { latitude longitude } } duration isOngoing startedBeforeRange signals { name agg value } eventCounts { name count } } }",
+		Annotations: &mcp.ToolAnnotations{
+			ReadOnlyHint:    true,
+			DestructiveHint: boolPtr(false),
+			OpenWorldHint:   boolPtr(false),
+			IdempotentHint:  true,
+		},
+	},
+	{
+		Name:        "telemetry_get_trip_segment",
+		Description: "Get one vehicle trip/activity segment by the id returned by get_trip_segments, using the same mechanism and config. Returns start/end locations, duration, signal aggregates and event counts, or null if there is no such segment.",
+		Args: []mcpserver.ArgDefinition{
+			{Name: "tokenId", Type: "integer", Description: "tokenId (Int!, required)", Required: true, ItemsType: ""},
+			{Name: "id", Type: "string", Description: "id (ID!, required)", Required: true, ItemsType: ""},
+			{Name: "mechanism", Type: "string", Description: "mechanism (DetectionMechanism!, required)", Required: true, ItemsType: "", EnumValues: []string{"ignitionDetection", "frequencyAnalysis", "changePointDetection", "idling", "refuel", "recharge", "threshold", "geofence", "stops", "fuelDrop", "auto"}},
+			{Name: "config", Type: "object", Description: "config (SegmentConfig, optional)", Required: false, ItemsType: ""},
+			{Name: "signalRequests", Type: "array", Description: "signalRequests ([SegmentSignalRequest!], optional)", Required: false, ItemsType: "object"},
+			{Name: "eventRequests", Type: "array", Description: "eventRequests ([SegmentEventRequest!], optional)", Required: false, ItemsType: "object"},
+		},
+		Query: "query($tokenId: Int!, $id: ID!, $mechanism: DetectionMechanism!, $config: SegmentConfig, $signalRequests: [SegmentSignalRequest!], $eventRequests: [SegmentEventRequest!]) { segment(tokenId: $tokenId, id: $id, mechanism: $mechanism, config: $config, signalRequests: $signalRequests, eventRequests: $eventRequests) { id mechanism start { timestamp value { latitude longitude } } end { timestamp value { latitude longitude } } duration isOngoing startedBeforeRange signals { name agg value } eventCounts { name count } } }",
 		Annotations: &mcp.ToolAnnotations{
 			ReadOnlyHint:    true,
 			DestructiveHint: boolPtr(false),
@@ -144,12 +256,12 @@ var MCPTools = []mcpserver.ToolDefinition{
 	},
 	{
 		Name:        "telemetry_get_daily_activity",
-		Description: "Get per-day driving activity summaries for a vehicle. Returns segment count, total active duration, and signal aggregates per day. Maximum date range: 31 days.",
+		Description: "Get per-day driving activity summaries for a vehicle. Returns segment count, total active duration, and signal aggregates per day. Maximum date range: 31 days, or 366 days within the last year with the default config where the segment store is enabled; a longer range it doesn't cover yet fails with an error asking to retry in a few minutes.",
 		Args: []mcpserver.ArgDefinition{
 			{Name: "tokenId", Type: "integer", Description: "tokenId (Int!, required)", Required: true, ItemsType: ""},
 			{Name: "from", Type: "string", Description: "from (Time!, required)", Required: true, ItemsType: ""},
 			{Name: "to", Type: "string", Description: "to (Time!, required)", Required: true, ItemsType: ""},
-			{Name: "mechanism", Type: "string", Description: "mechanism (DetectionMechanism!, required)", Required: true, ItemsType: "", EnumValues: []string{"ignitionDetection", "frequencyAnalysis", "changePointDetection", "idling", "refuel", "recharge"}},
+			{Name: "mechanism", Type: "string", Description: "mechanism (DetectionMechanism!, required)", Required: true, ItemsType: "", EnumValues: []string{"ignitionDetection", "frequencyAnalysis", "changePointDetection", "idling", "refuel", "recharge", "threshold", "geofence", "stops", "fuelDrop", "auto"}},
 			{Name: "config", Type: "object", Description: "config (SegmentConfig, optional)", Required: false, ItemsType: ""},
 			{Name: "signalRequests", Type: "array", Description: "signalRequests ([SegmentSignalRequest!], optional)", Required: false, ItemsType: "object"},
 			{Name: "eventRequests", Type: "array", Description: "eventRequests ([SegmentEventRequest!], optional)", Required: false, ItemsType: "object"},
@@ -179,4 +291,4 @@ var MCPTools = []mcpserver.ToolDefinition{
 	},
 }
 
-var CondensedSchema = "scalar Address  # A 20-byte Ethereum address, encoded as a checksummed hex string with 0x prefix.\nscalar Map\nscalar Time  # A point in time, encoded per RFC-3339.\nscalar Uint64  # A 64-bit unsigned integer.\n\n# ═══ SIGNAL FIELDS (117 total) ═══\n# All signals below exist on every signal type. Calling convention per type:\n#   SignalAggregations:\n#     fieldName(agg: LocationAggregation!): Location\n#     fieldName(agg: FloatAggregation!, filter: SignalFloatFilter): Float\n#     fieldName(agg: LocationAggregation!, filter: SignalLocationFilter): Location\n#     fieldName(agg: StringAggregation!): String\n#   SignalCollection:\n#     fieldName(): SignalLocation\n#     fieldName(): SignalFloat\n#     fieldName(): SignalString\n# Float is the default type. Location: currentLocationApproximateCoordinates, currentLocationCoordinates. String: obdDTCList, obdFuelTypeName, powertrainCombustionEngineEngineOilLevel, powertrainFuelSystemSupportedFuelTypes, powertrainTransmissionRetarderTorqueMode, powertrainType.\n# | Signal | Unit | Description |\n# |--------|------|-------------|\n# Shared descriptions (blank rows below use these):\n#   - Is item open or closed? True = Fully or partially open\n#   - Is the belt engaged\n#   - Measured Load on axle row 3\n# ── CURRENT (privilege: VEHICLE_ALL_TIME_LOCATION) ──\n# | currentLocationApproximateCoordinates |  | Approximate location of the vehicle in WGS 84 coordinates (privilege: VEHICLE_APPROXIMATE_LOCATION VEHICLE_ALL_TIME_LOCATION) |\n# | currentLocationAltitude | m | Current altitude relative to WGS 84 reference ellipsoid, as measured at the position of GNSS receiver antenna |\n# | currentLocationCoordinates |  | Current location of the vehicle in WGS 84 coordinates |\n# | currentLocationHeading | degrees | Current heading relative to geographic north |\n# ── OTHER (privilege: VEHICLE_NON_LOCATION_DATA) ──\n# | angularVelocityYaw | degrees/s | Vehicle rotation rate along Z (vertical) |\n# | connectivityCellularIsJammingDetected |  | Indicates whether cellular radio signal jamming or interference is detected that prevents normal communication |\n# | exteriorAirTemperature | celsius | Air temperature outside the vehicle |\n# | isIgnitionOn |  | Vehicle ignition status |\n# | lowVoltageBatteryCurrentVoltage | V |  |\n# | speed | km/h |  |\n# ── BODY (privilege: VEHICLE_NON_LOCATION_DATA) ──\n# | bodyLightsIsAirbagWarningOn |  | Indicates whether the airbag/SRS warning telltale is active |\n# | bodyLockIsLocked |  | Indicates whether the vehicle is locked via the central locking system |\n# | bodyTrunkFrontIsOpen |  |  |\n# | bodyTrunkRearIsOpen |  |  |\n# ── CABIN (privilege: VEHICLE_NON_LOCATION_DATA) ──\n# | cabinDoorRow1DriverSideIsOpen |  |  |\n# | cabinDoorRow1DriverSideWindowIsOpen |  |  |\n# | cabinDoorRow1PassengerSideIsOpen |  |  |\n# | cabinDoorRow1PassengerSideWindowIsOpen |  |  |\n# | cabinDoorRow2DriverSideIsOpen |  |  |\n# | cabinDoorRow2DriverSideWindowIsOpen |  |  |\n# | cabinDoorRow2PassengerSideIsOpen |  |  |\n# | cabinDoorRow2PassengerSideWindowIsOpen |  |  |\n# | cabinSeatRow1DriverSideIsBelted |  |  |\n# | cabinSeatRow1PassengerSideIsBelted |  |  |\n# | cabinSeatRow2DriverSideIsBelted |  |  |\n# | cabinSeatRow2MiddleIsBelted |  |  |\n# | cabinSeatRow2PassengerSideIsBelted |  |  |\n# | cabinSeatRow3DriverSideIsBelted |  |  |\n# | cabinSeatRow3PassengerSideIsBelted |  |  |\n# ── CHASSIS (privilege: VEHICLE_NON_LOCATION_DATA) ──\n# shared: Rotational speed of a vehicle's wheel\n# shared: Pneumatic pressure in the service brake circuit or reservoir\n# | chassisAxleRow1WheelLeftSpeed | km/h |  |\n# | chassisAxleRow1WheelLeftTirePressure | kPa |  |\n# | chassisAxleRow1WheelRightSpeed | km/h |  |\n# | chassisAxleRow1WheelRightTirePressure | kPa |  |\n# | chassisAxleRow2WheelLeftTirePressure | kPa |  |\n# | chassisAxleRow2WheelRightTirePressure | kPa |  |\n# | chassisAxleRow3Weight | kg |  |\n# | chassisAxleRow4Weight | kg |  |\n# | chassisAxleRow5Weight | kg |  |\n# | chassisBrakeABSIsWarningOn |  | Indicates whether the ABS warning telltale is active (any non-off state) |\n# | chassisBrakeCircuit1PressurePrimary | kPa |  |\n# | chassisBrakeCircuit2PressurePrimary | kPa |  |\n# | chassisBrakeIsPedalPressed |  | Indicates whether the brake pedal is pressed |\n# | chassisBrakePedalPosition | percent | Brake pedal position as percent |\n# | chassisParkingBrakeIsEngaged |  |  |\n# | chassisTireSystemIsWarningOn |  | Indicates whether the tire system warning telltale is active |\n# ── OBD (privilege: VEHICLE_NON_LOCATION_DATA) ──\n# shared: PID 2x (byte CD) - Voltage for wide range/band oxygen sensor\n# | obdBarometricPressure | kPa | PID 33 - Barometric pressure |\n# | obdCommandedEGR | percent | PID 2C - Commanded exhaust gas recirculation (EGR) |\n# | obdCommandedEVAP | percent | PID 2E - Commanded evaporative purge (EVAP) valve |\n# | obdDTCList |  | List of currently active DTCs formatted according OBD II (SAE-J2012DA_201812) standard ([P|C|B|U]XXXXX ) |\n# | obdDistanceSinceDTCClear | km | PID 31 - Distance traveled since codes cleared |\n# | obdDistanceWithMIL | km | PID 21 - Distance traveled with MIL on |\n# | obdEngineLoad | percent | PID 04 - Engine load in percent - 0 = no load, 100 = full load |\n# | obdEthanolPercent | percent | PID 52 - Percentage of ethanol in the fuel |\n# | obdFuelPressure | kPa | PID 0A - Fuel pressure |\n# | obdFuelRailPressure | kPa |  |\n# | obdFuelRate | l/h | PID 5E - Engine fuel rate |\n# | obdFuelTypeName |  | Fuel type names decoded from PID 51 |\n# | obdIntakeTemp | celsius | PID 0F - Intake temperature |\n# | obdIsEngineBlocked |  | Engine block status, 0 = engine unblocked, 1 = engine blocked |\n# | obdIsPTOActive |  | PID 1E - Auxiliary input status (power take off) |\n# | obdIsPluggedIn |  | Aftermarket device plugged in status |\n# | obdLongTermFuelTrim1 | percent | PID 07 - Long Term (learned) Fuel Trim - Bank 1 - negative percent leaner, positive percent richer |\n# | obdLongTermFuelTrim2 | percent | PID 09 - Long Term (learned) Fuel Trim - Bank 2 - negative percent leaner, positive percent richer |\n# | obdMAP | kPa | PID 0B - Intake manifold pressure |\n# | obdMaxMAF | g/s | PID 50 - Maximum flow for mass air flow sensor |\n# | obdO2WRSensor1Voltage | V |  |\n# | obdO2WRSensor2Voltage | V |  |\n# | obdOilTemperature | celsius | PID 5C - Engine oil temperature |\n# | obdRunTime | s | PID 1F - Engine run time |\n# | obdShortTermFuelTrim1 | percent | PID 06 - Short Term (immediate) Fuel Trim - Bank 1 - negative percent leaner, positive percent richer |\n# | obdStatusDTCCount |  | Number of Diagnostic Trouble Codes (DTC) |\n# | obdThrottlePosition | percent | PID 11 - Throttle position - 0 = closed throttle, 100 = open throttle |\n# | obdWarmupsSinceDTCClear |  | PID 30 - Number of warm-ups since codes cleared |\n# ── POWERTRAIN (privilege: VEHICLE_NON_LOCATION_DATA) ──\n# | powertrainCombustionEngineDieselExhaustFluidCapacity | l | Capacity in liters of the Diesel Exhaust Fluid Tank |\n# | powertrainCombustionEngineDieselExhaustFluidLevel | percent | Level of the Diesel Exhaust Fluid tank as percent of capacity |\n# | powertrainCombustionEngineECT | celsius | Engine coolant temperature |\n# | powertrainCombustionEngineEOP | kPa | Engine oil pressure |\n# | powertrainCombustionEngineEOT | celsius | Engine oil temperature |\n# | powertrainCombustionEngineEngineOilLevel |  |  |\n# | powertrainCombustionEngineEngineOilRelativeLevel | percent | Engine oil level as a percentage |\n# | powertrainCombustionEngineMAF | g/s | Grams of air drawn into engine per second |\n# | powertrainCombustionEngineSpeed | rpm | Engine speed measured as rotations per minute |\n# | powertrainCombustionEngineTPS | percent | Current throttle position |\n# | powertrainCombustionEngineTorque | Nm |  |\n# | powertrainCombustionEngineTorquePercent | percent | Actual engine output torque as a percentage of reference engine torque (FMS / J1939 parameter SPN 513) |\n# | powertrainFuelSystemAbsoluteLevel | l | Current available fuel in the fuel tank expressed in liters |\n# | powertrainFuelSystemAccumulatedConsumption | l | Accumulated fuel consumption (totalized) reported by the vehicle (FMS SPN 250) |\n# | powertrainFuelSystemRelativeLevel | percent | Level in fuel tank as percent of capacity |\n# | powertrainFuelSystemSupportedFuelTypes |  | High level information of fuel types supported |\n# | powertrainRange | km | Remaining range in kilometers using all energy sources available in the vehicle |\n# | powertrainTractionBatteryChargingAddedEnergy | kWh | Amount of charge added to the high voltage battery during the current charging session, expressed in kilowatt-hours |\n# | powertrainTractionBatteryChargingChargeCurrentAC | A | Current AC charging current (rms) at inlet |\n# | powertrainTractionBatteryChargingChargeLimit | percent | Target charge limit (state of charge) for battery |\n# | powertrainTractionBatteryChargingChargeVoltageUnknownType | V | Current charging voltage at inlet |\n# | powertrainTractionBatteryChargingIsCharging |  | True if charging is ongoing |\n# | powertrainTractionBatteryChargingIsChargingCableConnected |  | Indicates if a charging cable is physically connected to the vehicle or not |\n# | powertrainTractionBatteryChargingPower | kW | Instantaneous charging power recorded during a charging event |\n# | powertrainTractionBatteryCurrentPower | W | Current electrical energy flowing in/out of battery |\n# | powertrainTractionBatteryCurrentVoltage | V |  |\n# | powertrainTractionBatteryGrossCapacity | kWh |  |\n# | powertrainTractionBatteryRange | km | Remaining range in kilometers using only battery |\n# | powertrainTractionBatteryStateOfChargeCurrent | percent | Physical state of charge of the high voltage battery, relative to net capacity |\n# | powertrainTractionBatteryStateOfChargeCurrentEnergy | kWh | Physical state of charge of high voltage battery expressed in kWh |\n# | powertrainTractionBatteryStateOfHealth | percent | Calculated battery state of health at standard conditions |\n# | powertrainTractionBatteryTemperatureAverage | celsius | Current average temperature of the battery cells |\n# | powertrainTransmissionActualGear |  | Actual transmission gear currently engaged |\n# | powertrainTransmissionActualGearRatio |  |  |\n# | powertrainTransmissionCurrentGear |  |  |\n# | powertrainTransmissionIsClutchSwitchOperated |  | Indicates if the Clutch switch is operated, so engine and transmission are partially or fully decoupled |\n# | powertrainTransmissionRetarderActualTorque | percent | Actual retarder torque as a percentage (FMS / J1939 SPN 520) |\n# | powertrainTransmissionRetarderTorqueMode |  | Active engine torque mode |\n# | powertrainTransmissionSelectedGear |  |  |\n# | powertrainTransmissionTemperature | celsius | The current gearbox temperature |\n# | powertrainTransmissionTravelledDistance | km | Odometer reading, total distance travelled during the lifetime of the transmission |\n# | powertrainType |  | Defines the powertrain type of the vehicle |\n# ── SERVICE (privilege: VEHICLE_NON_LOCATION_DATA) ──\n# | serviceDistanceToService | km | Remaining distance to service (of any kind) |\n# | serviceTimeToService | s | Remaining time to service (of any kind) |\n\ntype Query {\n  signals(\n    tokenId: Int!\n    \"\"\"\n    Duration string for data aggregation buckets (e.g., \"5m\", \"1h\", \"2h45m\"). Valid\n    units: ms, s, m, h. Common values: \"5m\" (5 minutes), \"1h\" (1 hour), \"6h\", \"24h\".\n    Days are not a valid unit — use \"24h\" instead of \"1d\".\n    \"\"\"\n    interval: String!\n    from: Time!\n    to: Time!\n    filter: SignalFilter\n  ): [SignalAggregations!]\n  # Example - Hourly average speed over a time range:\n  #   query TimeSeries($tokenId:Int!,$from:Time!,$to:Time!) { signals(tokenId:$tokenId,interval:\"1h\",from:$from,to:$to) { timestamp speed(agg:AVG) } }\n\n  signalsLatest(tokenId: Int!, filter: SignalFilter): SignalCollection\n  # Example - Latest speed and battery charge:\n  #   query Latest($tokenId:Int!) { signalsLatest(tokenId:$tokenId) { lastSeen speed{timestamp value} powertrainTractionBatteryStateOfChargeCurrent{timestamp value} } }\n\n  availableSignals(tokenId: Int!, filter: SignalFilter): [String!]\n  \"Point-in-time snapshot of all accessible signals. Equivalent to availableSignals + signalsLatest in a single request.\"\n  signalsSnapshot(tokenId: Int!, filter: SignalFilter): SignalsSnapshotResponse\n  # Example - Full snapshot of all signals for a vehicle:\n  #   query Snapshot($tokenId:Int!) { signalsSnapshot(tokenId:$tokenId) { lastSeen signals { name timestamp valueNumber valueString valueLocation { latitude longitude hdop } } } }\n\n  dataSummary(tokenId: Int!, filter: SignalFilter): DataSummary\n  attestations(tokenId: Int, subject: String, filter: AttestationFilter): [Attestation]\n  events(tokenId: Int!, from: Time!, to: Time!, filter: EventFilter): [Event!]\n  \"\"\"\n  Returns vehicle usage segments detected using the specified mechanism. Maximum\n  date range: 31 days.\n  Detection mechanisms:\n  - ignitionDetection: Uses 'isIgnitionOn' signal with configurable debouncing\n  - frequencyAnalysis: Analyzes signal update frequency to detect activity periods\n  - changePointDetection: CUSUM-based regime change detection\n  - idling: Idling segments (engine rpm idle)\n  - refuel: Refueling segments (fuel level increased)\n  - recharge: Charging segments (battery SoC increased)\n  Segment IDs are stable and consistent across queries as long as the segment\n  start is captured in the underlying data source.\n  Each segment includes summary: signals, start/end location, and (when requested)\n  eventCounts. A default set of signal requests is always applied (e.g. speed,\n  odometer; for refuel/recharge also the level signal at start and end). When\n  signalRequests is provided, those requests are added on top of the default set;\n  duplicates (same name and agg) are omitted.\n  \"\"\"\n  segments(\n    tokenId: Int!\n    from: Time!\n    to: Time!\n    mechanism: DetectionMechanism!\n    config: SegmentConfig\n    signalRequests: [SegmentSignalRequest!]\n    eventRequests: [SegmentEventRequest!]\n    \"Maximum number of segments to return. Default 100, max 200.\"\n    limit: Int = 100\n    after: Time\n  ): [Segment!]!\n  # Example - Trip segments with start/end locations and signal aggregates:\n  #   query Trips($tokenId:Int!,$from:Time!,$to:Time!) { segments(tokenId:$tokenId,from:$from,to:$to,mechanism:frequencyAnalysis) { start{timestamp value{latitude longitude}} end{timestamp value{latitude longitude}} duration isOngoing signals{name agg value} eventCounts{name count} } }\n\n  \"\"\"\n  Returns one record per calendar day in the date range. Mechanism must be\n  ignitionDetection, frequencyAnalysis, or changePointDetection (idling, refuel,\n  and recharge not allowed). Maximum date range: 31 days.\n  \"\"\"\n  dailyActivity(tokenId: Int!, from: Time!, to: Time!, mechanism: DetectionMechanism!, config: SegmentConfig, signalRequests: [SegmentSignalRequest!], eventRequests: [SegmentEventRequest!], timezone: String): [DailyActivity!]!\n  # Example - Daily activity summaries:\n  #   query Daily($tokenId:Int!,$from:Time!,$to:Time!) { dailyActivity(tokenId:$tokenId,from:$from,to:$to,mechanism:frequencyAnalysis) { segmentCount duration signals{name agg value} eventCounts{name count} } }\n\n  \"Required Privileges: [VEHICLE_VIN_CREDENTIAL]\"\n  vinVCLatest(tokenId: Int!): VINVC\n}\n\ntype Attestation { id: String!, vehicleTokenId: Int!, time: Time!, attestation: String!, type: String!, source: Address!, dataVersion: String!, producer: String, signature: String!, tags: [String!] }\n\ninput AttestationFilter {\n  id: String\n  \"The attesting party.\"\n  source: Address\n  dataVersion: String\n  producer: String\n  \"Before this timestamp.\"\n  before: Time\n  \"After this timestamp.\"\n  after: Time\n  \"Max results. Default 10.\"\n  limit: Int\n  \"Pagination cursor (exclusive).\"\n  cursor: Time\n  tags: StringArrayFilter\n}\n\ntype DailyActivity { start: SignalLocation, end: SignalLocation, segmentCount: Int!, duration: Int!, signals: [SignalAggregationValue!]!, eventCounts: [EventCount!]! }\n\ntype DataSummary { numberOfSignals: Uint64!, availableSignals: [String!]!, firstSeen: Time!, lastSeen: Time!, signalDataSummary: [SignalDataSummary!]!, eventDataSummary: [EventDataSummary!]! }\n\nenum DetectionMechanism {\n  \"Ignition-based detection: Segments are identified by isIgnitionOn state transitions. Most reliable for vehicles with proper ignition signal support.\"\n  ignitionDetection\n  \"Frequency analysis: Segments are detected by analyzing signal update patterns. Uses pre-computed materialized view for optimal performance. Ideal for real-time APIs and bulk queries.\"\n  frequencyAnalysis\n  \"\"\"\n  Change point detection: Uses CUSUM algorithm to detect statistical regime\n  changes. Monitors cumulative deviation in signal frequency via materialized\n  view. Excellent noise resistance with 100% accuracy match to ignition baseline.\n  Best alternative when ignition signal is unavailable - same accuracy, same speed\n  as frequency analysis.\n  \"\"\"\n  changePointDetection\n  \"Idling: Segments are contiguous periods where engine RPM remains in idle range.\"\n  idling\n  \"Refuel: Detects where fuel level rises significantly.\"\n  refuel\n  \"Recharge: Hybrid detection. Uses charging signals and state of charge for detection.\"\n  recharge\n}\n\ntype Event { timestamp: Time!, name: String!, source: String!, durationNs: Int!, metadata: String }\n\ntype EventCount { name: String!, count: Int! }\n\ntype EventDataSummary { name: String!, numberOfEvents: Uint64!, firstSeen: Time!, lastSeen: Time! }\n\ninput EventFilter {\n  name: StringValueFilter\n  \"Source connection that created the event.\"\n  source: StringValueFilter\n  tags: StringArrayFilter\n}\n\ninput FilterLocation {\n  \"Latitude in the range [-90, 90].\"\n  latitude: Float!\n  \"Longitude in the range [-180, 180].\"\n  longitude: Float!\n}\n\nenum FloatAggregation { AVG, MED, MAX, MIN, RAND, FIRST, LAST }\n\ninput InCircleFilter {\n  center: FilterLocation!\n  \"Radius in kilometers.\"\n  radius: Float!\n}\n\ntype LatestSignal { name: String!, timestamp: Time!, valueNumber: Float, valueString: String, valueLocation: Location }\n\ntype Location { latitude: Float!, longitude: Float!, hdop: Float! }\n\nenum LocationAggregation { AVG, RAND, FIRST, LAST }\n\nenum Privilege { VEHICLE_NON_LOCATION_DATA, VEHICLE_COMMANDS, VEHICLE_CURRENT_LOCATION, VEHICLE_ALL_TIME_LOCATION, VEHICLE_VIN_CREDENTIAL, VEHICLE_APPROXIMATE_LOCATION, VEHICLE_RAW_DATA }\n\ntype Segment { start: SignalLocation!, end: SignalLocation, duration: Int!, isOngoing: Boolean!, startedBeforeRange: Boolean!, signals: [SignalAggregationValue!], eventCounts: [EventCount!] }\n\ninput SegmentConfig {\n  \"\"\"\n  Maximum gap (seconds) between data points before a segment is split. For\n  ignitionDetection: filters noise from brief ignition OFF events. For\n  frequencyAnalysis: maximum gap between active windows to merge. Default: 300 (5\n  minutes), Min: 60, Max: 3600\n  \"\"\"\n  maxGapSeconds: Int = 300\n  \"Minimum segment duration (seconds) to include in results. Filters very short segments (testing, engine cycling). Default: 240 (4 minutes), Min: 60, Max: 3600\"\n  minSegmentDurationSeconds: Int = 240\n  \"\"\"\n  [frequencyAnalysis] Minimum signal count per window for activity detection.\n  [idling] Minimum samples per window to consider it idle (same semantics). Higher\n  values = more conservative. Lower values = more sensitive. Default: 10, Min: 1,\n  Max: 3600\n  \"\"\"\n  signalCountThreshold: Int = 10\n  \"[idling only] Upper bound for idle RPM. Windows with max(RPM) <= this are considered idle. Default: 1000, Min: 300, Max: 3000\"\n  maxIdleRpm: Int = 1000\n  \"[refuel and recharge only] Minimum percent increase within a window to consider it a level-increase window.\"\n  minIncreasePercent: Int = 15\n}\n\ninput SegmentEventRequest { name: String! }\n\ninput SegmentSignalRequest { name: String!, agg: FloatAggregation! }\n\ntype SignalAggregationValue { name: String!, agg: String!, value: Float! }\n\ntype SignalAggregations {\n  timestamp: Time!\n  # + 117 signal fields (see SIGNAL FIELDS table above)\n}\n\ntype SignalCollection {\n  lastSeen: Time\n  # + 117 signal fields (see SIGNAL FIELDS table above)\n}\n\ntype SignalDataSummary { name: String!, numberOfSignals: Uint64!, firstSeen: Time!, lastSeen: Time! }\n\ninput SignalFilter {\n  \"\"\"\n  Filter by source ethr DID. Example:\n  \"did:ethr:137:0xcd445F4c6bDAD32b68a2939b912150Fe3C88803E\"\n  \"\"\"\n  source: String\n}\n\ntype SignalFloat { timestamp: Time!, value: Float! }\n\ninput SignalFloatFilter { eq: Float, neq: Float, gt: Float, lt: Float, gte: Float, lte: Float, notIn: [Float!], in: [Float!], or: [SignalFloatFilter!] }\n\ntype SignalLocation { timestamp: Time!, value: Location! }\n\ninput SignalLocationFilter {\n  \"Filter for locations within a polygon. The vertices should be ordered clockwise or counterclockwise, and there must be at least 3. May produce inaccurate results around the poles and the antimeridian.\"\n  inPolygon: [FilterLocation!]\n  \"Filter for locations within a given distance of a given point. Distances are computed using WGS 84, and points that are exactly a distance `radius` from the `center` will be included.\"\n  inCircle: InCircleFilter\n}\n\ntype SignalString { timestamp: Time!, value: String! }\n\ntype SignalsSnapshotResponse { lastSeen: Time, signals: [LatestSignal!]! }\n\nenum StringAggregation {\n  \"Randomly select a value from the group.\"\n  RAND\n  \"Select the most frequently occurring value in the group.\"\n  TOP\n  \"Return a list of unique values in the group.\"\n  UNIQUE\n  \"Return value in group associated with the minimum time value.\"\n  FIRST\n  \"Return value in group associated with the maximum time value.\"\n  LAST\n}\n\ninput StringArrayFilter { containsAny: [String!], containsAll: [String!], notContainsAny: [String!], notContainsAll: [String!], or: [StringArrayFilter!] }\n\ninput StringValueFilter {\n  eq: String\n  neq: String\n  notIn: [String!]\n  in: [String!]\n  \"Matches strings that begin with the given prefix.\"\n  startsWith: String\n  or: [StringValueFilter!]\n}\n\ntype VINVC { vehicleTokenId: Int, vin: String, recordedBy: String, recordedAt: Time, countryCode: String, vehicleContractAddress: String, validFrom: Time, validTo: Time, rawVC: String! }\n"
+var CondensedSchema = "scalar Address  # A 20-byte Ethereum address, encoded as a checksummed hex string with 0x prefix.\nscalar Map\nscalar Time  # A point in time, encoded per RFC-3339.\nscalar Uint64  # A 64-bit unsigned integer.\n\n# ═══ SIGNAL FIELDS (117 total) ═══\n# All signals below exist on every signal type. Calling convention per type:\n#   SignalAggregations:\n#     fieldName(agg: LocationAggregation!): Location\n#     fieldName(agg: FloatAggregation!, filter: SignalFloatFilter): Float\n#     fieldName(agg: LocationAggregation!, filter: SignalLocationFilter): Location\n#     fieldName(agg: StringAggregation!): String\n#   SignalCollection:\n#     fieldName(): SignalLocation\n#     fieldName(): SignalFloat\n#     fieldName(): SignalString\n# Float is the default type. Location: currentLocationApproximateCoordinates, currentLocationCoordinates. String: obdDTCList, obdFuelTypeName, powertrainCombustionEngineEngineOilLevel, powertrainFuelSystemSupportedFuelTypes, powertrainTransmissionRetarderTorqueMode, powertrainType.\n# | Signal | Unit | Description |\n# |--------|------|-------------|\n# Shared descriptions (blank rows below use these):\n#   - Is item open or closed? True = Fully or partially open\n#   - Is the belt engaged\n#   - Measured Load on axle row 3\n# ── CURRENT (privilege: VEHICLE_ALL_TIME_LOCATION) ──\n# | currentLocationApproximateCoordinates |  | Approximate location of the vehicle in WGS 84 coordinates (privilege: VEHICLE_APPROXIMATE_LOCATION VEHICLE_ALL_TIME_LOCATION) |\n# | currentLocationAltitude | m | Current altitude relative to WGS 84 reference ellipsoid, as measured at the position of GNSS receiver antenna |\n# | currentLocationCoordinates |  | Current location of the vehicle in WGS 84 coordinates |\n# | currentLocationHeading | degrees | Current heading relative to geographic north |\n# ── OTHER (privilege: VEHICLE_NON_LOCATION_DATA) ──\n# | angularVelocityYaw | degrees/s | Vehicle rotation rate along Z (vertical) |\n# | connectivityCellularIsJammingDetected |  | Indicates whether cellular radio signal jamming or interference is detected that prevents normal communication |\n# | exteriorAirTemperature | celsius | Air temperature outside the vehicle |\n# | isIgnitionOn |  | Vehicle ignition status |\n# | lowVoltageBatteryCurrentVoltage | V |  |\n# | speed | km/h |  |\n# ── BODY (privilege: VEHICLE_NON_LOCATION_DATA) ──\n# | bodyLightsIsAirbagWarningOn |  | Indicates whether the airbag/SRS warning telltale is active |\n# | bodyLockIsLocked |  | Indicates whether the vehicle is locked via the central locking system |\n# | bodyTrunkFrontIsOpen |  |  |\n# | bodyTrunkRearIsOpen |  |  |\n# ── CABIN (privilege: VEHICLE_NON_LOCATION_DATA) ──\n# | cabinDoorRow1DriverSideIsOpen |  |  |\n# | cabinDoorRow1DriverSideWindowIsOpen |  |  |\n# | cabinDoorRow1PassengerSideIsOpen |  |  |\n# | cabinDoorRow1PassengerSideWindowIsOpen |  |  |\n# | cabinDoorRow2DriverSideIsOpen |  |  |\n# | cabinDoorRow2DriverSideWindowIsOpen |  |  |\n# | cabinDoorRow2PassengerSideIsOpen |  |  |\n# | cabinDoorRow2PassengerSideWindowIsOpen |  |  |\n# | cabinSeatRow1DriverSideIsBelted |  |  |\n# | cabinSeatRow1PassengerSideIsBelted |  |  |\n# | cabinSeatRow2DriverSideIsBelted |  |  |\n# | cabinSeatRow2MiddleIsBelted |  |  |\n# | cabinSeatRow2PassengerSideIsBelted |  |  |\n# | cabinSeatRow3DriverSideIsBelted |  |  |\n# | cabinSeatRow3PassengerSideIsBelted |  |  |\n# ── CHASSIS (privilege: VEHICLE_NON_LOCATION_DATA) ──\n# shared: Rotational speed of a vehicle's wheel\n# shared: Pneumatic pressure in the service brake circuit or reservoir\n# | chassisAxleRow1WheelLeftSpeed | km/h |  |\n# | chassisAxleRow1WheelLeftTirePressure | kPa |  |\n# | chassisAxleRow1WheelRightSpeed | km/h |  |\n# | chassisAxleRow1WheelRightTirePressure | kPa |  |\n# | chassisAxleRow2WheelLeftTirePressure | kPa |  |\n# | chassisAxleRow2WheelRightTirePressure | kPa |  |\n# | chassisAxleRow3Weight | kg |  |\n# | chassisAxleRow4Weight | kg |  |\n# | chassisAxleRow5Weight | kg |  |\n# | chassisBrakeABSIsWarningOn |  | Indicates whether the ABS warning telltale is active (any non-off state) |\n# | chassisBrakeCircuit1PressurePrimary | kPa |  |\n# | chassisBrakeCircuit2PressurePrimary | kPa |  |\n# | chassisBrakeIsPedalPressed |  | Indicates whether the brake pedal is pressed |\n# | chassisBrakePedalPosition | percent | Brake pedal position as percent |\n# | chassisParkingBrakeIsEngaged |  |  |\n# | chassisTireSystemIsWarningOn |  | Indicates whether the tire system warning telltale is active |\n# ── OBD (privilege: VEHICLE_NON_LOCATION_DATA) ──\n# shared: PID 2x (byte CD) - Voltage for wide range/band oxygen sensor\n# | obdBarometricPressure | kPa | PID 33 - Barometric pressure |\n# | obdCommandedEGR | percent | PID 2C - Commanded exhaust gas recirculation (EGR) |\n# | obdCommandedEVAP | percent | PID 2E - Commanded evaporative purge (EVAP) valve |\n# | obdDTCList |  | List of currently active DTCs formatted according OBD II (SAE-J2012DA_201812) standard ([P|C|B|U]XXXXX ) |\n# | obdDistanceSinceDTCClear | km | PID 31 - Distance traveled since codes cleared |\n# | obdDistanceWithMIL | km | PID 21 - Distance traveled with MIL on |\n# | obdEngineLoad | percent | PID 04 - Engine load in percent - 0 = no load, 100 = full load |\n# | obdEthanolPercent | percent | PID 52 - Percentage of ethanol in the fuel |\n# | obdFuelPressure | kPa | PID 0A - Fuel pressure |\n# | obdFuelRailPressure | kPa |  |\n# | obdFuelRate | l/h | PID 5E - Engine fuel rate |\n# | obdFuelTypeName |  | Fuel type names decoded from PID 51 |\n# | obdIntakeTemp | celsius | PID 0F - Intake temperature |\n# | obdIsEngineBlocked |  | Engine block status, 0 = engine unblocked, 1 = engine blocked |\n# | obdIsPTOActive |  | PID 1E - Auxiliary input status (power take off) |\n# | obdIsPluggedIn |  | Aftermarket device plugged in status |\n# | obdLongTermFuelTrim1 | percent | PID 07 - Long Term (learned) Fuel Trim - Bank 1 - negative percent leaner, positive percent richer |\n# | obdLongTermFuelTrim2 | percent | PID 09 - Long Term (learned) Fuel Trim - Bank 2 - negative percent leaner, positive percent richer |\n# | obdMAP | kPa | PID 0B - Intake manifold pressure |\n# | obdMaxMAF | g/s | PID 50 - Maximum flow for mass air flow sensor |\n# | obdO2WRSensor1Voltage | V |  |\n# | obdO2WRSensor2Voltage | V |  |\n# | obdOilTemperature | celsius | PID 5C - Engine oil temperature |\n# | obdRunTime | s | PID 1F - Engine run time |\n# | obdShortTermFuelTrim1 | percent | PID 06 - Short Term (immediate) Fuel Trim - Bank 1 - negative percent leaner, positive percent richer |\n# | obdStatusDTCCount |  | Number of Diagnostic Trouble Codes (DTC) |\n# | obdThrottlePosition | percent | PID 11 - Throttle position - 0 = closed throttle, 100 = open throttle |\n# | obdWarmupsSinceDTCClear |  | PID 30 - Number of warm-ups since codes cleared |\n# ── POWERTRAIN (privilege: VEHICLE_NON_LOCATION_DATA) ──\n# | powertrainCombustionEngineDieselExhaustFluidCapacity | l | Capacity in liters of the Diesel Exhaust Fluid Tank |\n# | powertrainCombustionEngineDieselExhaustFluidLevel | percent | Level of the Diesel Exhaust Fluid tank as percent of capacity |\n# | powertrainCombustionEngineECT | celsius | Engine coolant temperature |\n# | powertrainCombustionEngineEOP | kPa | Engine oil pressure |\n# | powertrainCombustionEngineEOT | celsius | Engine oil temperature |\n# | powertrainCombustionEngineEngineOilLevel |  |  |\n# | powertrainCombustionEngineEngineOilRelativeLevel | percent | Engine oil level as a percentage |\n# | powertrainCombustionEngineMAF | g/s | Grams of air drawn into engine per second |\n# | powertrainCombustionEngineSpeed | rpm | Engine speed measured as rotations per minute |\n# | powertrainCombustionEngineTPS | percent | Current throttle position |\n# | powertrainCombustionEngineTorque | Nm |  |\n# | powertrainCombustionEngineTorquePercent | percent | Actual engine output torque as a percentage of reference engine torque (FMS / J1939 parameter SPN 513) |\n# | powertrainFuelSystemAbsoluteLevel | l | Current available fuel in the fuel tank expressed in liters |\n# | powertrainFuelSystemAccumulatedConsumption | l | Accumulated fuel consumption (totalized) reported by the vehicle (FMS SPN 250) |\n# | powertrainFuelSystemRelativeLevel | percent | Level in fuel tank as percent of capacity |\n# | powertrainFuelSystemSupportedFuelTypes |  | High level information of fuel types supported |\n# | powertrainRange | km | Remaining range in kilometers using all energy sources available in the vehicle |\n# | powertrainTractionBatteryChargingAddedEnergy | kWh | Amount of charge added to the high voltage battery during the current charging session, expressed in kilowatt-hours |\n# | powertrainTractionBatteryChargingChargeCurrentAC | A | Current AC charging current (rms) at inlet |\n# | powertrainTractionBatteryChargingChargeLimit | percent | Target charge limit (state of charge) for battery |\n# | powertrainTractionBatteryChargingChargeVoltageUnknownType | V | Current charging voltage at inlet |\n# | powertrainTractionBatteryChargingIsCharging |  | True if charging is ongoing |\n# | powertrainTractionBatteryChargingIsChargingCableConnected |  | Indicates if a charging cable is physically connected to the vehicle or not |\n# | powertrainTractionBatteryChargingPower | kW | Instantaneous charging power recorded during a charging event |\n# | powertrainTractionBatteryCurrentPower | W | Current electrical energy flowing in/out of battery |\n# | powertrainTractionBatteryCurrentVoltage | V |  |\n# | powertrainTractionBatteryGrossCapacity | kWh |  |\n# | powertrainTractionBatteryRange | km | Remaining range in kilometers using only battery |\n# | powertrainTractionBatteryStateOfChargeCurrent | percent | Physical state of charge of the high voltage battery, relative to net capacity |\n# | powertrainTractionBatteryStateOfChargeCurrentEnergy | kWh | Physical state of charge of high voltage battery expressed in kWh |\n# | powertrainTractionBatteryStateOfHealth | percent | Calculated battery state of health at standard conditions |\n# | powertrainTractionBatteryTemperatureAverage | celsius | Current average temperature of the battery cells |\n# | powertrainTransmissionActualGear |  | Actual transmission gear currently engaged |\n# | powertrainTransmissionActualGearRatio |  |  |\n# | powertrainTransmissionCurrentGear |  |  |\n# | powertrainTransmissionIsClutchSwitchOperated |  | Indicates if the Clutch switch is operated, so engine and transmission are partially or fully decoupled |\n# | powertrainTransmissionRetarderActualTorque | percent | Actual retarder torque as a percentage (FMS / J1939 SPN 520) |\n# | powertrainTransmissionRetarderTorqueMode |  | Active engine torque mode |\n# | powertrainTransmissionSelectedGear |  |  |\n# | powertrainTransmissionTemperature | celsius | The current gearbox temperature |\n# | powertrainTransmissionTravelledDistance | km | Odometer reading, total distance travelled during the lifetime of the transmission |\n# | powertrainType |  | Defines the powertrain type of the vehicle |\n# ── SERVICE (privilege: VEHICLE_NON_LOCATION_DATA) ──\n# | serviceDistanceToService | km | Remaining distance to service (of any kind) |\n# | serviceTimeToService | s | Remaining time to service (of any kind) |\n\ntype Query {\n  signals(\n    tokenId: Int!\n    \"\"\"\n    Duration string for data aggregation buckets (e.g., \"5m\", \"1h\", \"2h45m\"). Valid\n    units: ms, s, m, h. Common values: \"5m\" (5 minutes), \"1h\" (1 hour), \"6h\", \"24h\".\n    Days are not a valid unit — use \"24h\" instead of \"1d\".\n    \"\"\"\n    interval: String!\n    from: Time!\n    to: Time!\n    filter: SignalFilter\n  ): [SignalAggregations!]\n  # Example - Hourly average speed over a time range:\n  #   query TimeSeries($tokenId:Int!,$from:Time!,$to:Time!) { signals(tokenId:$tokenId,interval:\"1h\",from:$from,to:$to) { timestamp speed(agg:AVG) } }\n\n  signalsLatest(tokenId: Int!, filter: SignalFilter): SignalCollection\n  # Example - Latest speed and battery charge:\n  #   query Latest($tokenId:Int!) { signalsLatest(tokenId:$tokenId) { lastSeen speed{timestamp value} powertrainTractionBatteryStateOfChargeCurrent{timestamp value} } }\n\n  availableSignals(\n    tokenId: Int!\n    filter: SignalFilter\n    \"Only include signals stored at or after this time. Set together with to; the range may span at most 366 days. Without from and to, all stored signals are considered.\"\n    from: Time\n    \"Only include signals stored before this time. Set together with from.\"\n    to: Time\n  ): [String!]\n  \"Point-in-time snapshot of all accessible signals. Equivalent to availableSignals + signalsLatest in a single request.\"\n  signalsSnapshot(tokenId: Int!, filter: SignalFilter): SignalsSnapshotResponse\n  # Example - Full snapshot of all signals for a vehicle:\n  #   query Snapshot($tokenId:Int!) { signalsSnapshot(tokenId:$tokenId) { lastSeen signals { name timestamp valueNumber valueString valueLocation { latitude longitude hdop } } } }\n\n  \"\"\"\n  Summary of the signals and events stored for a vehicle. Without from and to the\n  summary covers all time; with them it only covers data stored in [from, to), at\n  most 366 days.\n  \"\"\"\n  dataSummary(\n    tokenId: Int!\n    filter: SignalFilter\n    \"Only include data stored at or after this time. Set together with to.\"\n    from: Time\n    \"Only include data stored before this time. Set together with from.\"\n    to: Time\n  ): DataSummary\n  attestations(tokenId: Int, subject: String, filter: AttestationFilter): [Attestation]\n  \"\"\"\n  Lists every signal that can be queried, with its VSS path, value type, unit,\n  description, required privileges and allowed aggregations.\n  \"\"\"\n  signalDefinitions: [SignalDefinition!]!\n  \"\"\"\n  Returns sample counts per signal in each interval bucket, plus the gaps in which a\n  signal reported nothing for longer than minGapSeconds. Useful for seeing when and\n  which signals stopped flowing for a vehicle.\n  Maximum date range: 31 days.\n  \"\"\"\n  dataCoverage(\n    tokenId: Int!\n    from: Time!\n    to: Time!\n    \"\"\"\n    Bucket size as a duration string of whole seconds (e.g., \"15m\", \"1h\", \"24h\").\n    At most 5000 buckets may be requested.\n    \"\"\"\n    interval: String!\n    \"\"\"\n    Signal names to report. Names without samples in the range are reported with a\n    single gap spanning the range. Defaults to every signal with samples in the range.\n    \"\"\"\n    names: [String!]\n    \"Minimum gap length in seconds to report. Default: 3600 (1 hour), Min: 60\"\n    minGapSeconds: Int = 3600\n    filter: SignalFilter\n  ): [SignalCoverage!]!\n  \"\"\"\n  Returns sampling statistics per signal and source over a time range: how often a\n  signal is reported and how irregular the reporting is. Useful for picking segment\n  thresholds such as signalCountThreshold and aggregation intervals.\n  Maximum date range: 31 days.\n  \"\"\"\n  samplingStats(\n    tokenId: Int!\n    from: Time!\n    to: Time!\n    \"Signal names to report. Defaults to every signal with samples in the range.\"\n    names: [String!]\n    filter: SignalFilter\n  ): [SignalSamplingStats!]!\n  \"\"\"\n  Returns the events of a vehicle in a time range, newest first. With\n  derivedEvents, harsh driving events derived from signals are added for vehicles\n  whose connection doesn't emit them. Derived events are only returned here:\n  eventsPage, eventsAggregated, the events subscription and segment event counts\n  and driver scores include stored events only.\n  \"\"\"\n  events(\n    tokenId: Int!\n    from: Time!\n    to: Time!\n    filter: EventFilter\n    \"\"\"\n    Also derive behavior.harshAcceleration, behavior.harshBraking and\n    behavior.harshCornering events from the speed and angularVelocityYaw signals.\n    There are no acceleration signals, so longitudinal acceleration is the change of\n    speed between samples. Derived events have the source \"telemetry-api:derived\",\n    no location, no tags, and metadata {\"peak\", \"threshold\", \"speedKph\"} with\n    accelerations in m/s². They are filtered by filter.name and filter.source; a\n    filter with metadata, location or tags conditions excludes them.\n    \"\"\"\n    derivedEvents: DerivedEventsConfig\n    \"Maximum number of events to return, newest first. Default 1000, max 1000. Use eventsPage to read more.\"\n    limit: Int = 1000\n  ): [Event!]\n  \"\"\"\n  Returns events for a vehicle one page at a time, ordered by timestamp ascending.\n  Events sharing a timestamp are never split across pages, so a page holds more\n  than limit events only when more than limit events share one timestamp. To get\n  the next page, pass the nextCursor of the previous page as after.\n  \"\"\"\n  eventsPage(\n    tokenId: Int!\n    from: Time!\n    to: Time!\n    filter: EventFilter\n    \"Maximum number of events to return. Default 100, max 1000.\"\n    limit: Int = 100\n    \"\"\"\n    Cursor for pagination: return only events with timestamp > after (exclusive).\n    Pass the nextCursor of the previous page for the next page.\n    \"\"\"\n    after: Time\n  ): EventPage!\n  \"\"\"\n  Returns the number of events and their total duration per event name in each\n  interval bucket. Buckets without events are omitted.\n  \"\"\"\n  eventsAggregated(\n    tokenId: Int!\n    from: Time!\n    to: Time!\n    \"Duration string for the buckets (e.g., \\\"5m\\\", \\\"1h\\\", \\\"24h\\\"). Valid units: ms, s, m, h.\"\n    interval: String!\n    filter: EventFilter\n  ): [EventAggregation!]!\n  \"\"\"\n  Returns vehicle usage segments detected using the specified mechanism. Maximum\n  date range: 31 days, or 366 days where the segment store is enabled and covers\n  the range. The store keeps closed segments per vehicle and mechanism for the\n  default detection config, so only the time after the last stored segment is\n  detected again. It is filled in the background from the queried ranges within\n  the last 366 days. At most 31 days of a range it doesn't cover yet are\n  detected from raw signals; longer ranges fail with an error asking to retry in\n  a few minutes. Configs that change detection settings are not stored and\n  limited to 31 days. The last 7 days of the store are detected again every 6\n  hours, so telemetry that arrives late shows up.\n  Detection mechanisms:\n  - ignitionDetection: Uses 'isIgnitionOn' signal with configurable debouncing\n  - frequencyAnalysis: Analyzes signal update frequency to detect activity periods\n  - changePointDetection: CUSUM-based regime change detection\n  - idling: Idling segments (engine rpm idle)\n  - refuel: Refueling segments (fuel level increased)\n  - recharge: Charging segments (battery SoC increased)\n  - threshold: Periods where a signal satisfies a filter (config.thresholdSignal, config.thresholdFilter)\n  - geofence: Visits to a polygon or circle (config.geofence)\n  - stops: Stationary periods between trips (config.stopRadiusMeters)\n  - fuelDrop: Fuel level drops while parked, e.g. theft or leaks (config.minDropPercent)\n  - auto: ignitionDetection, changePointDetection or frequencyAnalysis, chosen per vehicle\n  Segment IDs (Segment.id) are stable and consistent across queries as long as the\n  segment start is captured in the underlying data source. Use segment to look one up\n  again.\n  Each segment includes summary: signals, start/end location, and (when requested)\n  eventCounts. A default set of signal requests is always applied (e.g. speed,\n  odometer; for refuel/recharge also the level signal at start and end; for\n  recharge also the charging power, AC charging current and battery capacity used\n  for charging; for refuel and fuelDrop also the absolute fuel level at start and\n  end). When signalRequests is provided, those requests are added on top of the\n  default set; duplicates (same name and agg) are omitted.\n  \"\"\"\n  segments(\n    tokenId: Int!\n    from: Time!\n    to: Time!\n    mechanism: DetectionMechanism!\n    config: SegmentConfig\n    signalRequests: [SegmentSignalRequest!]\n    eventRequests: [SegmentEventRequest!]\n    \"Maximum number of segments to return. Default 100, max 200.\"\n    limit: Int = 100\n    after: Time\n  ): [Segment!]!\n  # Example - Trip segments with start/end locations and signal aggregates:\n  #   query Trips($tokenId:Int!,$from:Time!,$to:Time!) { segments(tokenId:$tokenId,from:$from,to:$to,mechanism:frequencyAnalysis) { start{timestamp value{latitude longitude}} end{timestamp value{latitude longitude}} duration isOngoing signals{name agg value} eventCounts{name count} } }\n\n  \"\"\"\n  Returns the segment with the given id (Segment.id from segments), re-detected from\n  its start with its summaries. The id does not record the config, so pass the config\n  used when the segment was listed. With auto, the segment is re-detected with the\n  mechanism that produced it. Returns null if the vehicle has no segment with this\n  id.\n  \"\"\"\n  segment(tokenId: Int!, id: ID!, mechanism: DetectionMechanism!, config: SegmentConfig, signalRequests: [SegmentSignalRequest!], eventRequests: [SegmentEventRequest!]): Segment\n\n  \"\"\"\n  Returns one record per calendar day in the date range. Mechanism must be\n  ignitionDetection, frequencyAnalysis, changePointDetection, auto, or stops (idling,\n  refuel, recharge, threshold, geofence, and fuelDrop not allowed). With stops, segmentCount\n  and duration are the number of stops and the total dwell time. Maximum date\n  range: 31 days, or 366 days where the segment store covers the range, as for\n  segments.\n  \"\"\"\n  dailyActivity(tokenId: Int!, from: Time!, to: Time!, mechanism: DetectionMechanism!, config: SegmentConfig, signalRequests: [SegmentSignalRequest!], eventRequests: [SegmentEventRequest!], timezone: String): [DailyActivity!]!\n  # Example - Daily activity summaries:\n  #   query Daily($tokenId:Int!,$from:Time!,$to:Time!) { dailyActivity(tokenId:$tokenId,from:$from,to:$to,mechanism:frequencyAnalysis) { segmentCount duration signals{name agg value} eventCounts{name count} } }\n\n  \"Required Privileges: [VEHICLE_VIN_CREDENTIAL]\"\n  vinVCLatest(tokenId: Int!): VINVC\n}\n\ntype Attestation { id: String!, vehicleTokenId: Int!, time: Time!, attestation: String!, type: String!, source: Address!, dataVersion: String!, producer: String, signature: String!, tags: [String!] }\n\ninput AttestationFilter {\n  id: String\n  \"The attesting party.\"\n  source: Address\n  dataVersion: String\n  producer: String\n  \"Before this timestamp.\"\n  before: Time\n  \"After this timestamp.\"\n  after: Time\n  \"Max results. Default 10.\"\n  limit: Int\n  \"Pagination cursor (exclusive).\"\n  cursor: Time\n  tags: StringArrayFilter\n}\n\nenum ChargerType { AC, DC }\n\ntype ChargingSession { socStart: Float, socEnd: Float, batteryCapacityKwh: Float, energyAddedKwh: Float, peakPowerKw: Float, averagePowerKw: Float, chargerType: ChargerType, location: Location }\n\ntype CoverageBucket { timestamp: Time!, count: Int! }\n\ntype DailyActivity { start: SignalLocation, end: SignalLocation, segmentCount: Int!, duration: Int!, signals: [SignalAggregationValue!]!, eventCounts: [EventCount!]!, score: DriverScore, distanceKm: Float, distanceSource: DistanceSource }\n\ntype DataGap { start: Time!, end: Time!, duration: Int! }\n\ntype DataSummary { numberOfSignals: Uint64!, availableSignals: [String!]!, firstSeen: Time!, lastSeen: Time!, signalDataSummary: [SignalDataSummary!]!, eventDataSummary: [EventDataSummary!]! }\n\n\"\"\"\nThresholds for events derived from signals. Longitudinal acceleration is the\nchange of speed between speed samples at most maxSampleGapSeconds apart; changes\nabove 15 m/s² are treated as glitches. Lateral acceleration is speed times the\nangularVelocityYaw yaw rate. Consecutive samples over a threshold form one\nevent.\n\"\"\"\ninput DerivedEventsConfig {\n  \"Acceleration (m/s²) above which behavior.harshAcceleration is derived. Default: 3, Min: 0.5, Max: 20\"\n  harshAccelerationThreshold: Float = 3\n  \"Deceleration (m/s², positive) above which behavior.harshBraking is derived. Default: 4, Min: 0.5, Max: 20\"\n  harshBrakingThreshold: Float = 4\n  \"Lateral acceleration (m/s²) above which behavior.harshCornering is derived. Default: 4, Min: 0.5, Max: 20\"\n  harshCorneringThreshold: Float = 4\n  \"Longest gap (seconds) between samples that are differentiated or joined into one event. Connections that report speed less often derive no events unless this is raised, but over longer gaps acceleration is averaged out and short harsh maneuvers are still missed. Default: 5, Min: 1, Max: 60\"\n  maxSampleGapSeconds: Int = 5\n}\n\nenum DetectionMechanism {\n  \"Ignition-based detection: Segments are identified by isIgnitionOn state transitions. Most reliable for vehicles with proper ignition signal support.\"\n  ignitionDetection\n  \"Frequency analysis: Segments are detected by analyzing signal update patterns. Uses pre-computed materialized view for optimal performance. Ideal for real-time APIs and bulk queries.\"\n  frequencyAnalysis\n  \"\"\"\n  Change point detection: Uses CUSUM algorithm to detect statistical regime\n  changes. Monitors cumulative deviation in signal frequency via materialized\n  view. Excellent noise resistance with 100% accuracy match to ignition baseline.\n  Best alternative when ignition signal is unavailable - same accuracy, same speed\n  as frequency analysis.\n  \"\"\"\n  changePointDetection\n  \"Idling: Segments are contiguous periods where engine RPM remains in idle range.\"\n  idling\n  \"Refuel: Detects where fuel level rises significantly.\"\n  refuel\n  \"Recharge: Hybrid detection. Uses charging signals and state of charge for detection.\"\n  recharge\n  \"\"\"\n  Threshold: Segments are periods where the signal thresholdSignal satisfies\n  thresholdFilter, e.g. speed above 120 or state of charge below 10.\n  \"\"\"\n  threshold\n  \"\"\"\n  Geofence: Segments are visits to the polygon or circle config.geofence, from entry\n  to exit, based on currentLocationCoordinates. The segment duration is the dwell time.\n  \"\"\"\n  geofence\n  \"\"\"\n  Stops: Segments are stationary periods between trips, where currentLocationCoordinates\n  stays within config.stopRadiusMeters and speed is approximately 0. Speed samples older\n  than config.maxGapSeconds are ignored. The segment duration is the dwell time, and stop\n  reports the centroid and whether ignition was on.\n  \"\"\"\n  stops\n  \"\"\"\n  Fuel drop: Detects sudden fuel level drops while the vehicle is parked (speed\n  approximately 0 or ignition off), which indicate siphoning or a leak. Segments run\n  from the last high reading to the first stable low reading.\n  \"\"\"\n  fuelDrop\n  \"\"\"\n  Auto: Chooses a trip mechanism per vehicle from the signals it reports\n  (signal_summary). Uses ignitionDetection when isIgnitionOn is reliable (at least\n  20 samples, and last seen within 7 days of the vehicle's latest signal), else\n  changePointDetection when at least two of speed, powertrainCombustionEngineSpeed,\n  powertrainTransmissionTravelledDistance and currentLocationCoordinates are\n  reported that way, else frequencyAnalysis. Segment.mechanism reports the chosen\n  mechanism.\n  \"\"\"\n  auto\n}\n\nenum DistanceSource { ODOMETER, GPS, MIXED }\n\ntype DriverScore { overall: Float!, harshBraking: Float!, harshAcceleration: Float!, harshCornering: Float!, speeding: Float, nightDriving: Float! }\n\ntype Event { timestamp: Time!, name: String!, source: String!, durationNs: Int!, metadata: String, location: Location }\n\ntype EventAggregation { timestamp: Time!, name: String!, count: Int!, durationNs: Int! }\n\ntype EventCount { name: String!, count: Int! }\n\ntype EventDataSummary { name: String!, numberOfEvents: Uint64!, firstSeen: Time!, lastSeen: Time! }\n\ninput EventFilter {\n  name: StringValueFilter\n  \"Source connection that created the event.\"\n  source: StringValueFilter\n  \"\"\"\n  Conditions on fields of the event metadata JSON. An event must match all of them.\n  At most 10 conditions.\n  \"\"\"\n  metadata: [EventMetadataFilter!]\n  \"\"\"\n  Conditions on the location of the vehicle at the time of the event, as returned in\n  Event.location. Events without a location do not match.\n  \"\"\"\n  location: SignalLocationFilter\n  tags: StringArrayFilter\n}\n\n\"\"\"\nA condition on one field of the event metadata JSON. Events whose metadata lacks the\nfield, or holds a value of another type, do not match. At least one operator is required;\nif several are given, all must hold.\n\"\"\"\ninput EventMetadataFilter {\n  \"\"\"\n  Dot-separated object keys leading to the field, e.g. \"code\" or \"acceleration.peak\".\n  Keys may contain letters, digits and underscores. At most 5 keys.\n  \"\"\"\n  path: String!\n  \"String value equals.\"\n  eq: String\n  \"String value is one of.\"\n  in: [String!]\n  \"Numeric value is greater than.\"\n  gt: Float\n  \"Numeric value is less than.\"\n  lt: Float\n}\n\ntype EventPage { events: [Event!]!, hasMore: Boolean!, nextCursor: Time }\n\ninput FilterLocation {\n  \"Latitude in the range [-90, 90].\"\n  latitude: Float!\n  \"Longitude in the range [-180, 180].\"\n  longitude: Float!\n}\n\nenum FloatAggregation { AVG, MED, MAX, MIN, RAND, FIRST, LAST }\n\ntype FuelDropDetails { startLevel: Float!, endLevel: Float!, litersLost: Float }\n\ninput InCircleFilter {\n  center: FilterLocation!\n  \"Radius in kilometers.\"\n  radius: Float!\n}\n\ntype LatestSignal { name: String!, timestamp: Time!, valueNumber: Float, valueString: String, valueLocation: Location }\n\ntype Location { latitude: Float!, longitude: Float!, hdop: Float! }\n\nenum LocationAggregation { AVG, RAND, FIRST, LAST }\n\nenum Privilege { VEHICLE_NON_LOCATION_DATA, VEHICLE_COMMANDS, VEHICLE_CURRENT_LOCATION, VEHICLE_ALL_TIME_LOCATION, VEHICLE_VIN_CREDENTIAL, VEHICLE_APPROXIMATE_LOCATION, VEHICLE_RAW_DATA }\n\ntype RefuelDetails { troughLevel: Float!, peakLevel: Float!, tankCapacityLiters: Float, litersAdded: Float, estimatedCost: Float }\n\nenum RouteFormat { POLYLINE, GEOJSON }\n\ntype Segment { id: ID!, mechanism: DetectionMechanism!, start: SignalLocation!, end: SignalLocation, duration: Int!, isOngoing: Boolean!, startedBeforeRange: Boolean!, signals: [SignalAggregationValue!], eventCounts: [EventCount!], score: DriverScore, stop: SegmentStop, charging: ChargingSession, refuel: RefuelDetails, fuelDrop: FuelDropDetails, route: SegmentRoute, distanceKm: Float, distanceSource: DistanceSource }\n\ninput SegmentConfig {\n  \"\"\"\n  Maximum gap (seconds) between data points before a segment is split. For\n  ignitionDetection: filters noise from brief ignition OFF events. For\n  frequencyAnalysis: maximum gap between active windows to merge. Default: 300 (5\n  minutes), Min: 60, Max: 3600\n  \"\"\"\n  maxGapSeconds: Int = 300\n  \"Minimum segment duration (seconds) to include in results. Filters very short segments (testing, engine cycling). Default: 240 (4 minutes), Min: 60, Max: 3600\"\n  minSegmentDurationSeconds: Int = 240\n  \"\"\"\n  [frequencyAnalysis] Minimum signal count per window for activity detection.\n  [idling] Minimum samples per window to consider it idle (same semantics). Higher\n  values = more conservative. Lower values = more sensitive. Default: 10, Min: 1,\n  Max: 3600\n  \"\"\"\n  signalCountThreshold: Int = 10\n  \"[idling only] Upper bound for idle RPM. Windows with max(RPM) <= this are considered idle. Default: 1000, Min: 300, Max: 3000\"\n  maxIdleRpm: Int = 1000\n  \"[refuel and recharge only] Minimum percent increase within a window to consider it a level-increase window.\"\n  minIncreasePercent: Int = 15\n  \"\"\"\n  [threshold only] Float signal whose samples are tested against thresholdFilter, e.g.\n  \"speed\". Required for threshold.\n  \"\"\"\n  thresholdSignal: String\n  \"\"\"\n  [threshold only] Condition a sample must satisfy to be part of a segment. Consecutive\n  matching samples at most maxGapSeconds apart form one segment. Required for threshold.\n  \"\"\"\n  thresholdFilter: SignalFloatFilter\n  \"\"\"\n  [geofence only] Polygon or circle to detect visits to. A visit ends at the last\n  location sample inside it before samples outside it that go on for more than\n  maxGapSeconds; shorter excursions (GPS jitter at the edge) and gaps in reporting don't\n  end a visit. Required for geofence.\n  \"\"\"\n  geofence: SignalLocationFilter\n  \"\"\"\n  [stops only] Maximum distance (meters) of a location sample from the stop's centroid\n  for the vehicle to count as stationary. Larger values tolerate more GPS drift.\n  Default: 100, Min: 10, Max: 1000\n  \"\"\"\n  stopRadiusMeters: Int = 100\n  \"\"\"\n  [recharge only] Usable battery capacity (kWh) used to estimate\n  charging.energyAddedKwh. Defaults to the powertrainTractionBatteryGrossCapacity\n  signal. Min: 1, Max: 1000\n  \"\"\"\n  batteryCapacityKwh: Float\n  \"\"\"\n  [refuel and fuelDrop only] Fuel tank capacity (liters) used to compute\n  refuel.litersAdded and fuelDrop.litersLost. Without it, they come from the\n  powertrainFuelSystemAbsoluteLevel signal.\n  Min: 1, Max: 2000\n  \"\"\"\n  fuelTankCapacityLiters: Float\n  \"\"\"\n  [refuel only] Fuel price per liter used to compute refuel.estimatedCost. The cost\n  is in the currency of the price. Must be positive.\n  \"\"\"\n  fuelPricePerLiter: Float\n  \"\"\"\n  [fuelDrop only] Minimum drop in fuel level (absolute percent of the tank) from the\n  last high reading to the first stable low reading. Default: 10, Min: 1, Max: 100\n  \"\"\"\n  minDropPercent: Int = 10\n  \"\"\"\n  Douglas-Peucker tolerance (meters) used to simplify Segment.route; 0 keeps every\n  point. Default: 10, Min: 0, Max: 1000\n  \"\"\"\n  routeToleranceMeters: Float = 10\n  \"\"\"\n  Encoding of Segment.route. Default: POLYLINE\n  \"\"\"\n  routeFormat: RouteFormat = POLYLINE\n}\n\ninput SegmentEventRequest { name: String! }\n\ninput SegmentSignalRequest { name: String!, agg: FloatAggregation! }\n\ntype SegmentRoute { format: RouteFormat!, value: String!, pointCount: Int! }\n\ntype SegmentStop { centroid: Location!, ignitionOn: Boolean }\n\ntype SignalAggregationValue { name: String!, agg: String!, value: Float! }\n\ntype SignalAggregations {\n  timestamp: Time!\n  # + 117 signal fields (see SIGNAL FIELDS table above)\n}\n\ntype SignalCollection {\n  lastSeen: Time\n  # + 117 signal fields (see SIGNAL FIELDS table above)\n}\n\ntype SignalCoverage { name: String!, totalCount: Int!, buckets: [CoverageBucket!]!, gaps: [DataGap!]! }\n\ntype SignalDataSummary { name: String!, numberOfSignals: Uint64!, firstSeen: Time!, lastSeen: Time! }\n\ntype SignalDefinition { name: String!, vssPath: String!, valueType: String!, unit: String, min: String, max: String, description: String!, privileges: [Privilege!]!, aggregations: [String!]! }\n\ninput SignalFilter {\n  \"\"\"\n  Filter by source ethr DID. Example:\n  \"did:ethr:137:0xcd445F4c6bDAD32b68a2939b912150Fe3C88803E\"\n  \"\"\"\n  source: String\n}\n\ntype SignalFloat { timestamp: Time!, value: Float! }\n\ninput SignalFloatFilter { eq: Float, neq: Float, gt: Float, lt: Float, gte: Float, lte: Float, notIn: [Float!], in: [Float!], or: [SignalFloatFilter!] }\n\ntype SignalLocation { timestamp: Time!, value: Location! }\n\ninput SignalLocationFilter {\n  \"Filter for locations within a polygon. The vertices should be ordered clockwise or counterclockwise, and there must be at least 3. May produce inaccurate results around the poles and the antimeridian.\"\n  inPolygon: [FilterLocation!]\n  \"Filter for locations within a given distance of a given point. Distances are computed using WGS 84, and points that are exactly a distance `radius` from the `center` will be included.\"\n  inCircle: InCircleFilter\n}\n\ntype SignalSamplingStats { name: String!, source: String!, sampleCount: Int!, activeHours: Int!, samplesPerActiveHour: Float!, medianIntervalSeconds: Float, p95IntervalSeconds: Float, maxIntervalSeconds: Float }\n\ntype SignalString { timestamp: Time!, value: String! }\n\ntype SignalsSnapshotResponse { lastSeen: Time, signals: [LatestSignal!]! }\n\nenum StringAggregation {\n  \"Randomly select a value from the group.\"\n  RAND\n  \"Select the most frequently occurring value in the group.\"\n  TOP\n  \"Return a list of unique values in the group.\"\n  UNIQUE\n  \"Return value in group associated with the minimum time value.\"\n  FIRST\n  \"Return value in group associated with the maximum time value.\"\n  LAST\n}\n\ninput StringArrayFilter { containsAny: [String!], containsAll: [String!], notContainsAny: [String!], notContainsAll: [String!], or: [StringArrayFilter!] }\n\ninput StringValueFilter {\n  eq: String\n  neq: String\n  notIn: [String!]\n  in: [String!]\n  \"Matches strings that begin with the given prefix.\"\n  startsWith: String\n  or: [StringValueFilter!]\n}\n\ntype VINVC { vehicleTokenId: Int, vin: String, recordedBy: String, recordedAt: Time, countryCode: String, vehicleContractAddress: String, validFrom: Time, validTo: Time, rawVC: String! }\n"
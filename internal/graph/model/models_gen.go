@@ -44,6 +44,35 @@ type AttestationFilter struct {
 	Tags   *StringArrayFilter `json:"tags,omitempty"`
 }
 
+type ChargingSession struct {
+	// State of charge (percent) at the start of the session. Null if unavailable.
+	SocStart *float64 `json:"socStart,omitempty"`
+	// State of charge (percent) at the end of the session. Null if unavailable.
+	SocEnd *float64 `json:"socEnd,omitempty"`
+	// Battery capacity (kWh) used to estimate energyAddedKwh: config.batteryCapacityKwh, else
+	// the last powertrainTractionBatteryGrossCapacity reading. Null if unavailable.
+	BatteryCapacityKwh *float64 `json:"batteryCapacityKwh,omitempty"`
+	// Energy added to the battery (kWh), estimated as the state of charge increase times
+	// batteryCapacityKwh. Null if either is unavailable.
+	EnergyAddedKwh *float64 `json:"energyAddedKwh,omitempty"`
+	// Highest powertrainTractionBatteryChargingPower reading (kW). Null if unavailable.
+	PeakPowerKw *float64 `json:"peakPowerKw,omitempty"`
+	// Average powertrainTractionBatteryChargingPower reading (kW). Null if unavailable.
+	AveragePowerKw *float64 `json:"averagePowerKw,omitempty"`
+	// Charger type: AC if an AC charging current was reported, DC if the vehicle reported no
+	// AC current or charged faster than AC chargers can. Null if it can't be told.
+	ChargerType *ChargerType `json:"chargerType,omitempty"`
+	// Location of the vehicle at the start of the session. Null if unavailable.
+	Location *Location `json:"location,omitempty"`
+}
+
+type CoverageBucket struct {
+	// Start of the bucket.
+	Timestamp time.Time `json:"timestamp"`
+	// Number of samples in the bucket.
+	Count int `json:"count"`
+}
+
 type DailyActivity struct {
 	// Day start location. Null if unavailable.
 	Start *SignalLocation `json:"start,omitempty"`
@@ -55,6 +84,24 @@ type DailyActivity struct {
 	Duration    int                       `json:"duration"`
 	Signals     []*SignalAggregationValue `json:"signals"`
 	EventCounts []*EventCount             `json:"eventCounts"`
+	// Driver score for the day: the average of the scores of the segments in the day,
+	// weighted by segment duration. Null if the day has no scored segments.
+	Score *DriverScore `json:"score,omitempty"`
+	// Distance traveled in the day in kilometers: the sum of the distances of the day's segments.
+	// Segments that cross midnight count in proportion to the part of their duration in the day.
+	// Null if no segment in the day has a distance.
+	DistanceKm *float64 `json:"distanceKm,omitempty"`
+	// Source of distanceKm; MIXED if the day's segments used different sources.
+	DistanceSource *DistanceSource `json:"distanceSource,omitempty"`
+}
+
+type DataGap struct {
+	// Time of the last sample before the gap, or the start of the range.
+	Start time.Time `json:"start"`
+	// Time of the first sample after the gap, or the end of the range.
+	End time.Time `json:"end"`
+	// Length of the gap in seconds.
+	Duration int `json:"duration"`
 }
 
 type DataSummary struct {
@@ -66,6 +113,47 @@ type DataSummary struct {
 	EventDataSummary  []*EventDataSummary  `json:"eventDataSummary"`
 }
 
+// Thresholds for events derived from signals. Longitudinal acceleration is the change of speed
+// between speed samples at most maxSampleGapSeconds apart; changes above 15 m/s² are treated as
+// glitches. Lateral acceleration is speed times the angularVelocityYaw yaw rate. Consecutive
+// samples over a threshold form one event.
+type DerivedEventsConfig struct {
+	// Acceleration (m/s²) above which behavior.harshAcceleration is derived.
+	// Default: 3, Min: 0.5, Max: 20
+	HarshAccelerationThreshold *float64 `json:"harshAccelerationThreshold,omitempty"`
+	// Deceleration (m/s², positive) above which behavior.harshBraking is derived.
+	// Default: 4, Min: 0.5, Max: 20
+	HarshBrakingThreshold *float64 `json:"harshBrakingThreshold,omitempty"`
+	// Lateral acceleration (m/s²) above which behavior.harshCornering is derived.
+	// Default: 4, Min: 0.5, Max: 20
+	HarshCorneringThreshold *float64 `json:"harshCorneringThreshold,omitempty"`
+	// Longest gap (seconds) between samples that are differentiated or joined into one event.
+	// Connections that report speed less often derive no events unless this is raised, but over
+	// longer gaps acceleration is averaged out and short harsh maneuvers are still missed.
+	// Default: 5, Min: 1, Max: 60
+	MaxSampleGapSeconds *int `json:"maxSampleGapSeconds,omitempty"`
+}
+
+// Driver behavior score. Every component ranges from 0 (worst) to 100 (best). The
+// component weights in the overall score are set by the service operator.
+type DriverScore struct {
+	// Weighted average of the components.
+	Overall float64 `json:"overall"`
+	// Based on behavior.harshBraking and behavior.extremeBraking events per hour of driving.
+	HarshBraking float64 `json:"harshBraking"`
+	// Based on behavior.harshAcceleration events per hour of driving.
+	HarshAcceleration float64 `json:"harshAcceleration"`
+	// Based on behavior.harshCornering events per hour of driving.
+	HarshCornering float64 `json:"harshCornering"`
+	// Based on the share of speed samples over the speed limit set by the service operator; it
+	// reaches 0 when 20% of the samples are over the limit, so a single glitched sample barely
+	// counts. Null if the segments have no speed data; the overall score then leaves it out.
+	Speeding *float64 `json:"speeding,omitempty"`
+	// Share of the driving time not spent at night (22:00 to 05:00), in solar time at the
+	// start location, or UTC if the start location is unknown.
+	NightDriving float64 `json:"nightDriving"`
+}
+
 type Event struct {
 	Timestamp time.Time `json:"timestamp"`
 	Name      string    `json:"name"`
@@ -74,6 +162,21 @@ type Event struct {
 	// Duration in nanoseconds.
 	DurationNs int     `json:"durationNs"`
 	Metadata   *string `json:"metadata,omitempty"`
+	// Location of the vehicle at the time of the event: the latest currentLocationCoordinates
+	// sample at or before the event, if it is at most one hour older. Always exact: event queries
+	// require VEHICLE_ALL_TIME_LOCATION, and callers with only VEHICLE_APPROXIMATE_LOCATION get no
+	// H3-snapped variant, since the location filter would still reveal exact positions.
+	Location *Location `json:"location,omitempty"`
+}
+
+type EventAggregation struct {
+	// Start of the bucket.
+	Timestamp time.Time `json:"timestamp"`
+	Name      string    `json:"name"`
+	// Number of events in the bucket.
+	Count int `json:"count"`
+	// Total duration of the events in the bucket in nanoseconds.
+	DurationNs int `json:"durationNs"`
 }
 
 // Event name and count. Used by segments, daily activity, and event summaries.
@@ -93,10 +196,41 @@ type EventFilter struct {
 	Name *StringValueFilter `json:"name,omitempty"`
 	// Source connection that created the event.
 	Source *StringValueFilter `json:"source,omitempty"`
+	// Conditions on fields of the event metadata JSON. An event must match all of them.
+	// At most 10 conditions.
+	Metadata []*EventMetadataFilter `json:"metadata,omitempty"`
+	// Conditions on the location of the vehicle at the time of the event, as returned in
+	// Event.location. Events without a location do not match.
+	Location *SignalLocationFilter `json:"location,omitempty"`
 	// tags is the tags of the event.
 	Tags *StringArrayFilter `json:"tags,omitempty"`
 }
 
+// A condition on one field of the event metadata JSON. Events whose metadata lacks the
+// field, or holds a value of another type, do not match. At least one operator is required;
+// if several are given, all must hold.
+type EventMetadataFilter struct {
+	// Dot-separated object keys leading to the field, e.g. "code" or "acceleration.peak".
+	// Keys may contain letters, digits and underscores. At most 5 keys.
+	Path string `json:"path"`
+	// String value equals.
+	Eq *string `json:"eq,omitempty"`
+	// String value is one of.
+	In []string `json:"in,omitempty"`
+	// Numeric value is greater than.
+	Gt *float64 `json:"gt,omitempty"`
+	// Numeric value is less than.
+	Lt *float64 `json:"lt,omitempty"`
+}
+
+type EventPage struct {
+	Events []*Event `json:"events"`
+	// Whether there are more events after this page.
+	HasMore bool `json:"hasMore"`
+	// Timestamp of the last event, to pass as after for the next page. Null if hasMore is false.
+	NextCursor *time.Time `json:"nextCursor,omitempty"`
+}
+
 type FilterLocation struct {
 	// Latitude in the range [-90, 90].
 	Latitude float64 `json:"latitude"`
@@ -104,6 +238,16 @@ type FilterLocation struct {
 	Longitude float64 `json:"longitude"`
 }
 
+type FuelDropDetails struct {
+	// Fuel level (percent) at the last high reading before the drop.
+	StartLevel float64 `json:"startLevel"`
+	// Fuel level (percent) at the first stable reading after the drop.
+	EndLevel float64 `json:"endLevel"`
+	// Fuel lost (liters): the level drop times config.fuelTankCapacityLiters, else the drop of
+	// powertrainFuelSystemAbsoluteLevel. Null if unavailable.
+	LitersLost *float64 `json:"litersLost,omitempty"`
+}
+
 type InCircleFilter struct {
 	Center *FilterLocation `json:"center"`
 	// Radius in kilometers.
@@ -131,8 +275,28 @@ type Location struct {
 type Query struct {
 }
 
+type RefuelDetails struct {
+	// Fuel level (percent) at the trough, the last low reading before the refuel.
+	TroughLevel float64 `json:"troughLevel"`
+	// Fuel level (percent) at the peak, the first stable reading after the refuel.
+	PeakLevel float64 `json:"peakLevel"`
+	// Tank capacity (liters) used to compute litersAdded: config.fuelTankCapacityLiters, else
+	// derived from powertrainFuelSystemAbsoluteLevel at the peak. Null if unavailable.
+	TankCapacityLiters *float64 `json:"tankCapacityLiters,omitempty"`
+	// Fuel added (liters): the level rise times config.fuelTankCapacityLiters, else the rise of
+	// powertrainFuelSystemAbsoluteLevel. Null if unavailable.
+	LitersAdded *float64 `json:"litersAdded,omitempty"`
+	// litersAdded times config.fuelPricePerLiter. Null if either is unavailable.
+	EstimatedCost *float64 `json:"estimatedCost,omitempty"`
+}
+
 type Segment struct {
-	Start *SignalLocation `json:"start"`
+	// Deterministic ID derived from the vehicle, the mechanism and the start timestamp.
+	// Pass it to the segment query to fetch this segment again.
+	ID string `json:"id"`
+	// Mechanism that detected the segment. With auto, the mechanism chosen for the vehicle.
+	Mechanism DetectionMechanism `json:"mechanism"`
+	Start     *SignalLocation    `json:"start"`
 	// Omitted when isOngoing is true.
 	End *SignalLocation `json:"end,omitempty"`
 	// In seconds. If ongoing: computed from start to query 'to'.
@@ -142,6 +306,32 @@ type Segment struct {
 	StartedBeforeRange bool                      `json:"startedBeforeRange"`
 	Signals            []*SignalAggregationValue `json:"signals,omitempty"`
 	EventCounts        []*EventCount             `json:"eventCounts,omitempty"`
+	// Driver score for the segment. Only computed for ignitionDetection,
+	// frequencyAnalysis and changePointDetection; null for other mechanisms.
+	Score *DriverScore `json:"score,omitempty"`
+	// [stops only] Details of the stop. Null for other mechanisms.
+	Stop *SegmentStop `json:"stop,omitempty"`
+	// [recharge only] Details of the charging session. Null for other mechanisms.
+	Charging *ChargingSession `json:"charging,omitempty"`
+	// [refuel only] Fuel levels, volume and cost of the refuel. Null for other mechanisms.
+	Refuel *RefuelDetails `json:"refuel,omitempty"`
+	// [fuelDrop only] Fuel levels and volume of the drop. Null for other mechanisms.
+	FuelDrop *FuelDropDetails `json:"fuelDrop,omitempty"`
+	// Path of the segment built from the first currentLocationCoordinates sample of every 10
+	// seconds, at most 10000 per segment, and simplified with config.routeToleranceMeters, encoded
+	// per config.routeFormat. Routes for all segments are fetched in one extra query, only when this
+	// field is selected. Null if the segment has no location samples. Always exact: segment queries
+	// require VEHICLE_ALL_TIME_LOCATION and return exact start and end locations, so there is no
+	// H3-snapped route for callers with only VEHICLE_APPROXIMATE_LOCATION.
+	Route *SegmentRoute `json:"route,omitempty"`
+	// Distance traveled in kilometers. Uses the odometer delta when the vehicle reports odometer
+	// during the segment; otherwise the length of the currentLocationCoordinates path sampled like
+	// route, ignoring imprecise fixes (HDOP above 5), moves under 25 m and jumps faster than
+	// 300 km/h.
+	// Null if there is neither odometer nor location data.
+	DistanceKm *float64 `json:"distanceKm,omitempty"`
+	// Source of distanceKm. Null when distanceKm is null.
+	DistanceSource *DistanceSource `json:"distanceSource,omitempty"`
 }
 
 type SegmentConfig struct {
@@ -164,17 +354,70 @@ type SegmentConfig struct {
 	MaxIdleRpm *int `json:"maxIdleRpm,omitempty"`
 	// [refuel and recharge only] Minimum percent increase within a window to consider it a level-increase window.
 	MinIncreasePercent *int `json:"minIncreasePercent,omitempty"`
+	// [threshold only] Float signal whose samples are tested against thresholdFilter, e.g. "speed".
+	// Required for threshold.
+	ThresholdSignal *string `json:"thresholdSignal,omitempty"`
+	// [threshold only] Condition a sample must satisfy to be part of a segment. Consecutive
+	// matching samples at most maxGapSeconds apart form one segment. Required for threshold.
+	ThresholdFilter *SignalFloatFilter `json:"thresholdFilter,omitempty"`
+	// [geofence only] Polygon or circle to detect visits to. A visit ends at the last
+	// location sample inside it before samples outside it that go on for more than
+	// maxGapSeconds; shorter excursions (GPS jitter at the edge) and gaps in reporting don't
+	// end a visit. Required for geofence.
+	Geofence *SignalLocationFilter `json:"geofence,omitempty"`
+	// [stops only] Maximum distance (meters) of a location sample from the stop's centroid for
+	// the vehicle to count as stationary. Larger values tolerate more GPS drift.
+	// Default: 100, Min: 10, Max: 1000
+	StopRadiusMeters *int `json:"stopRadiusMeters,omitempty"`
+	// [recharge only] Usable battery capacity (kWh) used to estimate charging.energyAddedKwh.
+	// Defaults to the powertrainTractionBatteryGrossCapacity signal.
+	// Min: 1, Max: 1000
+	BatteryCapacityKwh *float64 `json:"batteryCapacityKwh,omitempty"`
+	// [refuel and fuelDrop only] Fuel tank capacity (liters) used to compute refuel.litersAdded
+	// and fuelDrop.litersLost. Without it, they come from the powertrainFuelSystemAbsoluteLevel signal.
+	// Min: 1, Max: 2000
+	FuelTankCapacityLiters *float64 `json:"fuelTankCapacityLiters,omitempty"`
+	// [refuel only] Fuel price per liter used to compute refuel.estimatedCost. The cost is in
+	// the currency of the price. Must be positive.
+	FuelPricePerLiter *float64 `json:"fuelPricePerLiter,omitempty"`
+	// [fuelDrop only] Minimum drop in fuel level (absolute percent of the tank) from the last
+	// high reading to the first stable low reading.
+	// Default: 10, Min: 1, Max: 100
+	MinDropPercent *int `json:"minDropPercent,omitempty"`
+	// Douglas-Peucker tolerance (meters) used to simplify Segment.route. Points closer than this
+	// to the simplified path are dropped; 0 keeps every point.
+	// Default: 10, Min: 0, Max: 1000
+	RouteToleranceMeters *float64 `json:"routeToleranceMeters,omitempty"`
+	// Encoding of Segment.route.
+	// Default: POLYLINE
+	RouteFormat *RouteFormat `json:"routeFormat,omitempty"`
 }
 
 type SegmentEventRequest struct {
 	Name string `json:"name"`
 }
 
+type SegmentRoute struct {
+	Format RouteFormat `json:"format"`
+	// The route in the given format.
+	Value string `json:"value"`
+	// Number of points in the simplified route.
+	PointCount int `json:"pointCount"`
+}
+
 type SegmentSignalRequest struct {
 	Name string           `json:"name"`
 	Agg  FloatAggregation `json:"agg"`
 }
 
+type SegmentStop struct {
+	// Mean position of the location samples during the stop.
+	Centroid *Location `json:"centroid"`
+	// Whether ignition was on at any time during the stop, e.g. while waiting with the engine
+	// running. Null if the vehicle has no isIgnitionOn data for the stop.
+	IgnitionOn *bool `json:"ignitionOn,omitempty"`
+}
+
 // Result of aggregating a float signal over an interval. Used by segments and daily activity summaries.
 // Same shape as one row of aggregated signal data (name, aggregation type, computed value).
 type SignalAggregationValue struct {
@@ -611,6 +854,17 @@ type SignalCollection struct {
 	Speed *SignalFloat `json:"speed,omitempty"`
 }
 
+type SignalCoverage struct {
+	// Signal name.
+	Name string `json:"name"`
+	// Total number of samples in the range.
+	TotalCount int `json:"totalCount"`
+	// Buckets with at least one sample, in ascending order. Missing buckets have no samples.
+	Buckets []*CoverageBucket `json:"buckets"`
+	// Periods without samples longer than minGapSeconds, in ascending order.
+	Gaps []*DataGap `json:"gaps"`
+}
+
 type SignalDataSummary struct {
 	Name            string    `json:"name"`
 	NumberOfSignals uint64    `json:"numberOfSignals"`
@@ -618,6 +872,28 @@ type SignalDataSummary struct {
 	LastSeen        time.Time `json:"lastSeen"`
 }
 
+type SignalDefinition struct {
+	// Signal name as used in queries, e.g. "speed".
+	Name string `json:"name"`
+	// Full VSS path of the signal, e.g. "Vehicle.Speed".
+	VssPath string `json:"vssPath"`
+	// GraphQL type of the signal values: Float, String or Location.
+	ValueType string `json:"valueType"`
+	// Unit of the values, if any.
+	Unit *string `json:"unit,omitempty"`
+	// Minimum value, if defined.
+	Min *string `json:"min,omitempty"`
+	// Maximum value, if defined.
+	Max *string `json:"max,omitempty"`
+	// Description of the signal.
+	Description string `json:"description"`
+	// Privileges the caller must all hold to read the signal. Any one of them is
+	// enough for currentLocationApproximateCoordinates.
+	Privileges []string `json:"privileges"`
+	// Aggregations accepted by the signal in signals queries.
+	Aggregations []string `json:"aggregations"`
+}
+
 type SignalFilter struct {
 	// Filter by source ethr DID.
 	// Example: "did:ethr:137:0xcd445F4c6bDAD32b68a2939b912150Fe3C88803E"
@@ -658,6 +934,27 @@ type SignalLocationFilter struct {
 	InCircle *InCircleFilter `json:"inCircle,omitempty"`
 }
 
+type SignalSamplingStats struct {
+	// Signal name.
+	Name string `json:"name"`
+	// Source connection that reported the samples.
+	Source string `json:"source"`
+	// Number of samples in the range.
+	SampleCount int `json:"sampleCount"`
+	// Number of distinct clock hours with at least one sample.
+	ActiveHours int `json:"activeHours"`
+	// sampleCount divided by activeHours.
+	SamplesPerActiveHour float64 `json:"samplesPerActiveHour"`
+	// Median time between consecutive samples in seconds. Null if there is only one sample.
+	MedianIntervalSeconds *float64 `json:"medianIntervalSeconds,omitempty"`
+	// 95th percentile of the time between consecutive samples in seconds. Null if there
+	// is only one sample.
+	P95IntervalSeconds *float64 `json:"p95IntervalSeconds,omitempty"`
+	// Longest time between consecutive samples in seconds. Null if there is only one
+	// sample.
+	MaxIntervalSeconds *float64 `json:"maxIntervalSeconds,omitempty"`
+}
+
 type SignalString struct {
 	Timestamp time.Time `json:"timestamp"`
 	Value     string    `json:"value"`
@@ -686,6 +983,11 @@ type StringValueFilter struct {
 	Or         []*StringValueFilter `json:"or,omitempty"`
 }
 
+// The root subscription type for the GraphQL schema. Subscriptions are served over
+// WebSocket; pass the JWT as "Authorization" in the connection init payload.
+type Subscription struct {
+}
+
 type Vinvc struct {
 	VehicleTokenID *int    `json:"vehicleTokenId,omitempty"`
 	Vin            *string `json:"vin,omitempty"`
@@ -702,6 +1004,61 @@ type Vinvc struct {
 	RawVc string `json:"rawVC"`
 }
 
+type ChargerType string
+
+const (
+	ChargerTypeAc ChargerType = "AC"
+	ChargerTypeDc ChargerType = "DC"
+)
+
+var AllChargerType = []ChargerType{
+	ChargerTypeAc,
+	ChargerTypeDc,
+}
+
+func (e ChargerType) IsValid() bool {
+	switch e {
+	case ChargerTypeAc, ChargerTypeDc:
+		return true
+	}
+	return false
+}
+
+func (e ChargerType) String() string {
+	return string(e)
+}
+
+func (e *ChargerType) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ChargerType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ChargerType", str)
+	}
+	return nil
+}
+
+func (e ChargerType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *ChargerType) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e ChargerType) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
 type DetectionMechanism string
 
 const (
@@ -723,6 +1080,28 @@ const (
 	DetectionMechanismRefuel DetectionMechanism = "refuel"
 	// Recharge: Hybrid detection. Uses charging signals and state of charge for detection.
 	DetectionMechanismRecharge DetectionMechanism = "recharge"
+	// Threshold: Segments are periods where the signal thresholdSignal satisfies
+	// thresholdFilter, e.g. speed above 120 or state of charge below 10.
+	DetectionMechanismThreshold DetectionMechanism = "threshold"
+	// Geofence: Segments are visits to the polygon or circle config.geofence, from entry
+	// to exit, based on currentLocationCoordinates. The segment duration is the dwell time.
+	DetectionMechanismGeofence DetectionMechanism = "geofence"
+	// Stops: Segments are stationary periods between trips, where currentLocationCoordinates
+	// stays within config.stopRadiusMeters and speed is approximately 0. Speed samples older
+	// than config.maxGapSeconds are ignored. The segment duration is the dwell time, and stop
+	// reports the centroid and whether ignition was on.
+	DetectionMechanismStops DetectionMechanism = "stops"
+	// Fuel drop: Detects sudden fuel level drops while the vehicle is parked (speed
+	// approximately 0 or ignition off), which indicate siphoning or a leak. Segments run from
+	// the last high reading to the first stable low reading.
+	DetectionMechanismFuelDrop DetectionMechanism = "fuelDrop"
+	// Auto: Chooses a trip mechanism per vehicle from the signals it reports (signal_summary).
+	// Uses ignitionDetection when isIgnitionOn is reliable (at least 20 samples, and last seen
+	// within 7 days of the vehicle's latest signal), else changePointDetection when at least two of
+	// speed, powertrainCombustionEngineSpeed, powertrainTransmissionTravelledDistance and
+	// currentLocationCoordinates are reported that way, else frequencyAnalysis.
+	// Segment.mechanism reports the chosen mechanism.
+	DetectionMechanismAuto DetectionMechanism = "auto"
 )
 
 var AllDetectionMechanism = []DetectionMechanism{
@@ -732,11 +1111,16 @@ var AllDetectionMechanism = []DetectionMechanism{
 	DetectionMechanismIdling,
 	DetectionMechanismRefuel,
 	DetectionMechanismRecharge,
+	DetectionMechanismThreshold,
+	DetectionMechanismGeofence,
+	DetectionMechanismStops,
+	DetectionMechanismFuelDrop,
+	DetectionMechanismAuto,
 }
 
 func (e DetectionMechanism) IsValid() bool {
 	switch e {
-	case DetectionMechanismIgnitionDetection, DetectionMechanismFrequencyAnalysis, DetectionMechanismChangePointDetection, DetectionMechanismIdling, DetectionMechanismRefuel, DetectionMechanismRecharge:
+	case DetectionMechanismIgnitionDetection, DetectionMechanismFrequencyAnalysis, DetectionMechanismChangePointDetection, DetectionMechanismIdling, DetectionMechanismRefuel, DetectionMechanismRecharge, DetectionMechanismThreshold, DetectionMechanismGeofence, DetectionMechanismStops, DetectionMechanismFuelDrop, DetectionMechanismAuto:
 		return true
 	}
 	return false
@@ -777,6 +1161,66 @@ func (e DetectionMechanism) MarshalJSON() ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
+type DistanceSource string
+
+const (
+	// Difference between the last and first odometer readings.
+	DistanceSourceOdometer DistanceSource = "ODOMETER"
+	// Length of the filtered GPS path.
+	DistanceSourceGps DistanceSource = "GPS"
+	// [dailyActivity only] The day's segments used both sources.
+	DistanceSourceMixed DistanceSource = "MIXED"
+)
+
+var AllDistanceSource = []DistanceSource{
+	DistanceSourceOdometer,
+	DistanceSourceGps,
+	DistanceSourceMixed,
+}
+
+func (e DistanceSource) IsValid() bool {
+	switch e {
+	case DistanceSourceOdometer, DistanceSourceGps, DistanceSourceMixed:
+		return true
+	}
+	return false
+}
+
+func (e DistanceSource) String() string {
+	return string(e)
+}
+
+func (e *DistanceSource) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = DistanceSource(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid DistanceSource", str)
+	}
+	return nil
+}
+
+func (e DistanceSource) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *DistanceSource) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e DistanceSource) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
 type FloatAggregation string
 
 const (
@@ -901,6 +1345,63 @@ func (e LocationAggregation) MarshalJSON() ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
+type RouteFormat string
+
+const (
+	// Encoded polyline (precision 5), as used by most map SDKs.
+	RouteFormatPolyline RouteFormat = "POLYLINE"
+	// GeoJSON LineString geometry with [longitude, latitude] positions.
+	RouteFormatGeojson RouteFormat = "GEOJSON"
+)
+
+var AllRouteFormat = []RouteFormat{
+	RouteFormatPolyline,
+	RouteFormatGeojson,
+}
+
+func (e RouteFormat) IsValid() bool {
+	switch e {
+	case RouteFormatPolyline, RouteFormatGeojson:
+		return true
+	}
+	return false
+}
+
+func (e RouteFormat) String() string {
+	return string(e)
+}
+
+func (e *RouteFormat) UnmarshalGQL(v any) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = RouteFormat(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid RouteFormat", str)
+	}
+	return nil
+}
+
+func (e RouteFormat) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+func (e *RouteFormat) UnmarshalJSON(b []byte) error {
+	s, err := strconv.Unquote(string(b))
+	if err != nil {
+		return err
+	}
+	return e.UnmarshalGQL(s)
+}
+
+func (e RouteFormat) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	e.MarshalGQL(&buf)
+	return buf.Bytes(), nil
+}
+
 type StringAggregation string
 
 const (
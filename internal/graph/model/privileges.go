@@ -0,0 +1,11 @@
+package model
+
+import "github.com/DIMO-Network/token-exchange-api/pkg/tokenclaims"
+
+// PrivilegePermissions maps the Privilege enum values of signal privileges to
+// the tokenclaims permission strings the Privilege scalar holds.
+var PrivilegePermissions = map[string]string{
+	"VEHICLE_NON_LOCATION_DATA":    tokenclaims.PermissionGetNonLocationHistory,
+	"VEHICLE_ALL_TIME_LOCATION":    tokenclaims.PermissionGetLocationHistory,
+	"VEHICLE_APPROXIMATE_LOCATION": tokenclaims.PermissionGetApproximateLocation,
+}
@@ -74,6 +74,10 @@ type LocationSignalArgs struct {
 	Filter *SignalLocationFilter
 }
 
+// FloatAggregationShareOver is an aggregation the schema doesn't expose: the
+// share of the samples, from 0 to 1, whose value is over Filter.Gt.
+const FloatAggregationShareOver FloatAggregation = "SHARE_OVER"
+
 // FloatSignalArgs is the arguments for querying a float signals.
 type FloatSignalArgs struct {
 	// Name is the signal name. This is the field name in the API.
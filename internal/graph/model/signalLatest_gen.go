@@ -834,3 +834,124 @@ var SignalPrivileges = map[string][]string{
 	"serviceTimeToService":                                      {"VEHICLE_NON_LOCATION_DATA"},
 	"speed":                                                     {"VEHICLE_NON_LOCATION_DATA"},
 }
+
+// SignalSpecs maps signal JSON names to their value type, unit, range and
+// description from the VSS spec.
+var SignalSpecs = map[string]SignalSpec{
+	"angularVelocityYaw":                                        {ValueType: "Float", Unit: "degrees/s", Min: "", Max: "", Description: "Vehicle rotation rate along Z (vertical)."},
+	"bodyLightsIsAirbagWarningOn":                               {ValueType: "Float", Unit: "", Min: "", Max: "", Description: "Indicates whether the airbag/SRS warning telltale is active."},
+	"bodyLockIsLocked":                                          {ValueType: "Float", Unit: "", Min: "", Max: "", Description: "Indicates whether the vehicle is locked via the central locking system. True = vehicle locked. False = vehicle unlocked."},
+	"bodyTrunkFrontIsOpen":                                      {ValueType: "Float", Unit: "", Min: "", Max: "", Description: "Is item open or closed? True = Fully or partially open. False = Fully closed."},
+	"bodyTrunkRearIsOpen":                                       {ValueType: "Float", Unit: "", Min: "", Max: "", Description: "Is item open or closed? True = Fully or partially open. False = Fully closed."},
+	"cabinDoorRow1DriverSideIsOpen":                             {ValueType: "Float", Unit: "", Min: "", Max: "", Description: "Is item open or closed? True = Fully or partially open. False = Fully closed."},
+	"cabinDoorRow1DriverSideWindowIsOpen":                       {ValueType: "Float", Unit: "", Min: "", Max: "", Description: "Is item open or closed? True = Fully or partially open. False = Fully closed."},
+	"cabinDoorRow1PassengerSideIsOpen":                          {ValueType: "Float", Unit: "", Min: "", Max: "", Description: "Is item open or closed? True = Fully or partially open. False = Fully closed."},
+	"cabinDoorRow1PassengerSideWindowIsOpen":                    {ValueType: "Float", Unit: "", Min: "", Max: "", Description: "Is item open or closed? True = Fully or partially open. False = Fully closed."},
+	"cabinDoorRow2DriverSideIsOpen":                             {ValueType: "Float", Unit: "", Min: "", Max: "", Description: "Is item open or closed? True = Fully or partially open. False = Fully closed."},
+	"cabinDoorRow2DriverSideWindowIsOpen":                       {ValueType: "Float", Unit: "", Min: "", Max: "", Description: "Is item open or closed? True = Fully or partially open. False = Fully closed."},
+	"cabinDoorRow2PassengerSideIsOpen":                          {ValueType: "Float", Unit: "", Min: "", Max: "", Description: "Is item open or closed? True = Fully or partially open. False = Fully closed."},
+	"cabinDoorRow2PassengerSideWindowIsOpen":                    {ValueType: "Float", Unit: "", Min: "", Max: "", Description: "Is item open or closed? True = Fully or partially open. False = Fully closed."},
+	"cabinSeatRow1DriverSideIsBelted":                           {ValueType: "Float", Unit: "", Min: "", Max: "", Description: "Is the belt engaged."},
+	"cabinSeatRow1PassengerSideIsBelted":                        {ValueType: "Float", Unit: "", Min: "", Max: "", Description: "Is the belt engaged."},
+	"cabinSeatRow2DriverSideIsBelted":                           {ValueType: "Float", Unit: "", Min: "", Max: "", Description: "Is the belt engaged."},
+	"cabinSeatRow2MiddleIsBelted":                               {ValueType: "Float", Unit: "", Min: "", Max: "", Description: "Is the belt engaged."},
+	"cabinSeatRow2PassengerSideIsBelted":                        {ValueType: "Float", Unit: "", Min: "", Max: "", Description: "Is the belt engaged."},
+	"cabinSeatRow3DriverSideIsBelted":                           {ValueType: "Float", Unit: "", Min: "", Max: "", Description: "Is the belt engaged."},
+	"cabinSeatRow3PassengerSideIsBelted":                        {ValueType: "Float", Unit: "", Min: "", Max: "", Description: "Is the belt engaged."},
+	"chassisAxleRow1WheelLeftSpeed":                             {ValueType: "Float", Unit: "km/h", Min: "", Max: "", Description: "Rotational speed of a vehicle's wheel."},
+	"chassisAxleRow1WheelLeftTirePressure":                      {ValueType: "Float", Unit: "kPa", Min: "", Max: "", Description: "Tire pressure in kilo-Pascal."},
+	"chassisAxleRow1WheelRightSpeed":                            {ValueType: "Float", Unit: "km/h", Min: "", Max: "", Description: "Rotational speed of a vehicle's wheel."},
+	"chassisAxleRow1WheelRightTirePressure":                     {ValueType: "Float", Unit: "kPa", Min: "", Max: "", Description: "Tire pressure in kilo-Pascal."},
+	"chassisAxleRow2WheelLeftTirePressure":                      {ValueType: "Float", Unit: "kPa", Min: "", Max: "", Description: "Tire pressure in kilo-Pascal."},
+	"chassisAxleRow2WheelRightTirePressure":                     {ValueType: "Float", Unit: "kPa", Min: "", Max: "", Description: "Tire pressure in kilo-Pascal."},
+	"chassisAxleRow3Weight":                                     {ValueType: "Float", Unit: "kg", Min: "", Max: "", Description: "Measured Load on axle row 3."},
+	"chassisAxleRow4Weight":                                     {ValueType: "Float", Unit: "kg", Min: "", Max: "", Description: "Measured Load on axle row 3."},
+	"chassisAxleRow5Weight":                                     {ValueType: "Float", Unit: "kg", Min: "", Max: "", Description: "Measured Load on axle row 3."},
+	"chassisBrakeABSIsWarningOn":                                {ValueType: "Float", Unit: "", Min: "", Max: "", Description: "Indicates whether the ABS warning telltale is active (any non-off state)."},
+	"chassisBrakeCircuit1PressurePrimary":                       {ValueType: "Float", Unit: "kPa", Min: "", Max: "", Description: "Pneumatic pressure in the service brake circuit or reservoir"},
+	"chassisBrakeCircuit2PressurePrimary":                       {ValueType: "Float", Unit: "kPa", Min: "", Max: "", Description: "Pneumatic pressure in the service brake circuit or reservoir"},
+	"chassisBrakeIsPedalPressed":                                {ValueType: "Float", Unit: "", Min: "", Max: "", Description: "Indicates whether the brake pedal is pressed."},
+	"chassisBrakePedalPosition":                                 {ValueType: "Float", Unit: "percent", Min: "0", Max: "100", Description: "Brake pedal position as percent. 0 = Not depressed. 100 = Fully depressed."},
+	"chassisParkingBrakeIsEngaged":                              {ValueType: "Float", Unit: "", Min: "", Max: "", Description: "Parking brake status. True = Parking Brake is Engaged. False = Parking Brake is not Engaged."},
+	"chassisTireSystemIsWarningOn":                              {ValueType: "Float", Unit: "", Min: "", Max: "", Description: "Indicates whether the tire system warning telltale is active"},
+	"connectivityCellularIsJammingDetected":                     {ValueType: "Float", Unit: "", Min: "", Max: "", Description: "Indicates whether cellular radio signal jamming or interference is detected that prevents normal communication."},
+	"currentLocationAltitude":                                   {ValueType: "Float", Unit: "m", Min: "", Max: "", Description: "Current altitude relative to WGS 84 reference ellipsoid, as measured at the position of GNSS receiver antenna."},
+	"currentLocationCoordinates":                                {ValueType: "Location", Unit: "", Min: "", Max: "", Description: "Current location of the vehicle in WGS 84 coordinates."},
+	"currentLocationHeading":                                    {ValueType: "Float", Unit: "degrees", Min: "0", Max: "360", Description: "Current heading relative to geographic north. 0 = North, 90 = East, 180 = South, 270 = West."},
+	"exteriorAirTemperature":                                    {ValueType: "Float", Unit: "celsius", Min: "", Max: "", Description: "Air temperature outside the vehicle."},
+	"isIgnitionOn":                                              {ValueType: "Float", Unit: "", Min: "", Max: "", Description: "Vehicle ignition status. False - off, True - on."},
+	"lowVoltageBatteryCurrentVoltage":                           {ValueType: "Float", Unit: "V", Min: "", Max: "", Description: "Current Voltage of the low voltage battery."},
+	"obdBarometricPressure":                                     {ValueType: "Float", Unit: "kPa", Min: "", Max: "", Description: "PID 33 - Barometric pressure"},
+	"obdCommandedEGR":                                           {ValueType: "Float", Unit: "percent", Min: "", Max: "", Description: "PID 2C - Commanded exhaust gas recirculation (EGR)"},
+	"obdCommandedEVAP":                                          {ValueType: "Float", Unit: "percent", Min: "", Max: "", Description: "PID 2E - Commanded evaporative purge (EVAP) valve"},
+	"obdDTCList":                                                {ValueType: "String", Unit: "", Min: "", Max: "", Description: "List of currently active DTCs formatted according OBD II (SAE-J2012DA_201812) standard ([P|C|B|U]XXXXX )"},
+	"obdDistanceSinceDTCClear":                                  {ValueType: "Float", Unit: "km", Min: "", Max: "", Description: "PID 31 - Distance traveled since codes cleared"},
+	"obdDistanceWithMIL":                                        {ValueType: "Float", Unit: "km", Min: "", Max: "", Description: "PID 21 - Distance traveled with MIL on"},
+	"obdEngineLoad":                                             {ValueType: "Float", Unit: "percent", Min: "", Max: "", Description: "PID 04 - Engine load in percent - 0 = no load, 100 = full load"},
+	"obdEthanolPercent":                                         {ValueType: "Float", Unit: "percent", Min: "", Max: "", Description: "PID 52 - Percentage of ethanol in the fuel"},
+	"obdFuelPressure":                                           {ValueType: "Float", Unit: "kPa", Min: "", Max: "", Description: "PID 0A - Fuel pressure"},
+	"obdFuelRailPressure":                                       {ValueType: "Float", Unit: "kPa", Min: "", Max: "", Description: "Fuel rail pressure from OBD. Uses PID 0x22 (fuel rail pressure relative to manifold vacuum) when available, otherwise falls back to PID 0x23 (fuel rail pressure direct injection)."},
+	"obdFuelRate":                                               {ValueType: "Float", Unit: "l/h", Min: "", Max: "", Description: "PID 5E - Engine fuel rate"},
+	"obdFuelTypeName":                                           {ValueType: "String", Unit: "", Min: "", Max: "", Description: "Fuel type names decoded from PID 51."},
+	"obdIntakeTemp":                                             {ValueType: "Float", Unit: "celsius", Min: "", Max: "", Description: "PID 0F - Intake temperature"},
+	"obdIsEngineBlocked":                                        {ValueType: "Float", Unit: "", Min: "", Max: "", Description: "Engine block status, 0 = engine unblocked, 1 = engine blocked"},
+	"obdIsPTOActive":                                            {ValueType: "Float", Unit: "", Min: "", Max: "", Description: "PID 1E - Auxiliary input status (power take off)"},
+	"obdIsPluggedIn":                                            {ValueType: "Float", Unit: "", Min: "", Max: "", Description: "Aftermarket device plugged in status. 1 = device plugged in, 0 = device unplugged."},
+	"obdLongTermFuelTrim1":                                      {ValueType: "Float", Unit: "percent", Min: "", Max: "", Description: "PID 07 - Long Term (learned) Fuel Trim - Bank 1 - negative percent leaner, positive percent richer"},
+	"obdLongTermFuelTrim2":                                      {ValueType: "Float", Unit: "percent", Min: "", Max: "", Description: "PID 09 - Long Term (learned) Fuel Trim - Bank 2 - negative percent leaner, positive percent richer"},
+	"obdMAP":                                                    {ValueType: "Float", Unit: "kPa", Min: "", Max: "", Description: "PID 0B - Intake manifold pressure"},
+	"obdMaxMAF":                                                 {ValueType: "Float", Unit: "g/s", Min: "", Max: "", Description: "PID 50 - Maximum flow for mass air flow sensor"},
+	"obdO2WRSensor1Voltage":                                     {ValueType: "Float", Unit: "V", Min: "", Max: "", Description: "PID 2x (byte CD) - Voltage for wide range/band oxygen sensor"},
+	"obdO2WRSensor2Voltage":                                     {ValueType: "Float", Unit: "V", Min: "", Max: "", Description: "PID 2x (byte CD) - Voltage for wide range/band oxygen sensor"},
+	"obdOilTemperature":                                         {ValueType: "Float", Unit: "celsius", Min: "", Max: "", Description: "PID 5C - Engine oil temperature"},
+	"obdRunTime":                                                {ValueType: "Float", Unit: "s", Min: "", Max: "", Description: "PID 1F - Engine run time"},
+	"obdShortTermFuelTrim1":                                     {ValueType: "Float", Unit: "percent", Min: "", Max: "", Description: "PID 06 - Short Term (immediate) Fuel Trim - Bank 1 - negative percent leaner, positive percent richer"},
+	"obdStatusDTCCount":                                         {ValueType: "Float", Unit: "", Min: "", Max: "", Description: "Number of Diagnostic Trouble Codes (DTC)"},
+	"obdThrottlePosition":                                       {ValueType: "Float", Unit: "percent", Min: "", Max: "", Description: "PID 11 - Throttle position - 0 = closed throttle, 100 = open throttle"},
+	"obdWarmupsSinceDTCClear":                                   {ValueType: "Float", Unit: "", Min: "", Max: "", Description: "PID 30 - Number of warm-ups since codes cleared"},
+	"powertrainCombustionEngineDieselExhaustFluidCapacity":      {ValueType: "Float", Unit: "l", Min: "", Max: "", Description: "Capacity in liters of the Diesel Exhaust Fluid Tank."},
+	"powertrainCombustionEngineDieselExhaustFluidLevel":         {ValueType: "Float", Unit: "percent", Min: "0", Max: "100", Description: "Level of the Diesel Exhaust Fluid tank as percent of capacity. 0 = empty. 100 = full."},
+	"powertrainCombustionEngineECT":                             {ValueType: "Float", Unit: "celsius", Min: "", Max: "", Description: "Engine coolant temperature."},
+	"powertrainCombustionEngineEOP":                             {ValueType: "Float", Unit: "kPa", Min: "", Max: "", Description: "Engine oil pressure."},
+	"powertrainCombustionEngineEOT":                             {ValueType: "Float", Unit: "celsius", Min: "", Max: "", Description: "Engine oil temperature."},
+	"powertrainCombustionEngineEngineOilLevel":                  {ValueType: "String", Unit: "", Min: "", Max: "", Description: "Engine oil level."},
+	"powertrainCombustionEngineEngineOilRelativeLevel":          {ValueType: "Float", Unit: "percent", Min: "0", Max: "100", Description: "Engine oil level as a percentage."},
+	"powertrainCombustionEngineMAF":                             {ValueType: "Float", Unit: "g/s", Min: "", Max: "", Description: "Grams of air drawn into engine per second."},
+	"powertrainCombustionEngineSpeed":                           {ValueType: "Float", Unit: "rpm", Min: "", Max: "", Description: "Engine speed measured as rotations per minute."},
+	"powertrainCombustionEngineTPS":                             {ValueType: "Float", Unit: "percent", Min: "", Max: "100", Description: "Current throttle position."},
+	"powertrainCombustionEngineTorque":                          {ValueType: "Float", Unit: "Nm", Min: "", Max: "", Description: "Current engine torque. Shall be reported as 0 during engine breaking."},
+	"powertrainCombustionEngineTorquePercent":                   {ValueType: "Float", Unit: "percent", Min: "", Max: "", Description: "Actual engine output torque as a percentage of reference engine torque (FMS / J1939 parameter SPN 513)."},
+	"powertrainFuelSystemAbsoluteLevel":                         {ValueType: "Float", Unit: "l", Min: "", Max: "", Description: "Current available fuel in the fuel tank expressed in liters."},
+	"powertrainFuelSystemAccumulatedConsumption":                {ValueType: "Float", Unit: "l", Min: "", Max: "", Description: "Accumulated fuel consumption (totalized) reported by the vehicle (FMS SPN 250)."},
+	"powertrainFuelSystemRelativeLevel":                         {ValueType: "Float", Unit: "percent", Min: "0", Max: "100", Description: "Level in fuel tank as percent of capacity. 0 = empty. 100 = full."},
+	"powertrainFuelSystemSupportedFuelTypes":                    {ValueType: "String", Unit: "", Min: "", Max: "", Description: "High level information of fuel types supported"},
+	"powertrainRange":                                           {ValueType: "Float", Unit: "km", Min: "", Max: "", Description: "Remaining range in kilometers using all energy sources available in the vehicle."},
+	"powertrainTractionBatteryChargingAddedEnergy":              {ValueType: "Float", Unit: "kWh", Min: "", Max: "", Description: "Amount of charge added to the high voltage battery during the current charging session, expressed in kilowatt-hours."},
+	"powertrainTractionBatteryChargingChargeCurrentAC":          {ValueType: "Float", Unit: "A", Min: "", Max: "", Description: "Current AC charging current (rms) at inlet. Negative if returning energy to grid. Used when per-phase numbers are unavailable."},
+	"powertrainTractionBatteryChargingChargeLimit":              {ValueType: "Float", Unit: "percent", Min: "0", Max: "100", Description: "Target charge limit (state of charge) for battery."},
+	"powertrainTractionBatteryChargingChargeVoltageUnknownType": {ValueType: "Float", Unit: "V", Min: "", Max: "", Description: "Current charging voltage at inlet. Used when the data source does not indicate the current type (AC or DC) in use."},
+	"powertrainTractionBatteryChargingIsCharging":               {ValueType: "Float", Unit: "", Min: "", Max: "", Description: "True if charging is ongoing. Charging is considered to be ongoing if energy is flowing from charger to vehicle."},
+	"powertrainTractionBatteryChargingIsChargingCableConnected": {ValueType: "Float", Unit: "", Min: "", Max: "", Description: "Indicates if a charging cable is physically connected to the vehicle or not."},
+	"powertrainTractionBatteryChargingPower":                    {ValueType: "Float", Unit: "kW", Min: "", Max: "", Description: "Instantaneous charging power recorded during a charging event."},
+	"powertrainTractionBatteryCurrentPower":                     {ValueType: "Float", Unit: "W", Min: "", Max: "", Description: "Current electrical energy flowing in/out of battery. Positive = Energy flowing in to battery, e.g. during charging. Negative = Energy flowing out of battery, e.g. during driving."},
+	"powertrainTractionBatteryCurrentVoltage":                   {ValueType: "Float", Unit: "V", Min: "", Max: "", Description: "Current Voltage of the battery."},
+	"powertrainTractionBatteryGrossCapacity":                    {ValueType: "Float", Unit: "kWh", Min: "", Max: "", Description: "Gross capacity of the battery."},
+	"powertrainTractionBatteryRange":                            {ValueType: "Float", Unit: "km", Min: "", Max: "", Description: "Remaining range in kilometers using only battery."},
+	"powertrainTractionBatteryStateOfChargeCurrent":             {ValueType: "Float", Unit: "percent", Min: "0", Max: "100.0", Description: "Physical state of charge of the high voltage battery, relative to net capacity. This is not necessarily the state of charge being displayed to the customer."},
+	"powertrainTractionBatteryStateOfChargeCurrentEnergy":       {ValueType: "Float", Unit: "kWh", Min: "", Max: "", Description: "Physical state of charge of high voltage battery expressed in kWh."},
+	"powertrainTractionBatteryStateOfHealth":                    {ValueType: "Float", Unit: "percent", Min: "0", Max: "100", Description: "Calculated battery state of health at standard conditions."},
+	"powertrainTractionBatteryTemperatureAverage":               {ValueType: "Float", Unit: "celsius", Min: "", Max: "", Description: "Current average temperature of the battery cells."},
+	"powertrainTransmissionActualGear":                          {ValueType: "Float", Unit: "", Min: "", Max: "", Description: "Actual transmission gear currently engaged. 0 = neutral, 1-15 = gear number."},
+	"powertrainTransmissionActualGearRatio":                     {ValueType: "Float", Unit: "", Min: "", Max: "", Description: "Actual transmission gear ratio."},
+	"powertrainTransmissionCurrentGear":                         {ValueType: "Float", Unit: "", Min: "", Max: "", Description: "The current gear. 0=Neutral, 1/2/..=Forward, -1/-2/..=Reverse."},
+	"powertrainTransmissionIsClutchSwitchOperated":              {ValueType: "Float", Unit: "", Min: "", Max: "", Description: "Indicates if the Clutch switch is operated, so engine and transmission are partially or fully decoupled. False = Clutch switch not operated. True = Clutch switch operated."},
+	"powertrainTransmissionRetarderActualTorque":                {ValueType: "Float", Unit: "percent", Min: "", Max: "", Description: "Actual retarder torque as a percentage (FMS / J1939 SPN 520)."},
+	"powertrainTransmissionRetarderTorqueMode":                  {ValueType: "String", Unit: "", Min: "", Max: "", Description: "Active engine torque mode."},
+	"powertrainTransmissionSelectedGear":                        {ValueType: "Float", Unit: "", Min: "", Max: "", Description: "The selected gear. 0=Neutral, 1/2/..=Forward, -1/-2/..=Reverse, 126=Park."},
+	"powertrainTransmissionTemperature":                         {ValueType: "Float", Unit: "celsius", Min: "", Max: "", Description: "The current gearbox temperature."},
+	"powertrainTransmissionTravelledDistance":                   {ValueType: "Float", Unit: "km", Min: "", Max: "", Description: "Odometer reading, total distance travelled during the lifetime of the transmission."},
+	"powertrainType":                                            {ValueType: "String", Unit: "", Min: "", Max: "", Description: "Defines the powertrain type of the vehicle."},
+	"serviceDistanceToService":                                  {ValueType: "Float", Unit: "km", Min: "", Max: "", Description: "Remaining distance to service (of any kind). Negative values indicate service overdue."},
+	"serviceTimeToService":                                      {ValueType: "Float", Unit: "s", Min: "", Max: "", Description: "Remaining time to service (of any kind). Negative values indicate service overdue."},
+	"speed":                                                     {ValueType: "Float", Unit: "km/h", Min: "", Max: "", Description: "Vehicle speed."},
+}
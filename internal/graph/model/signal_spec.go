@@ -0,0 +1,11 @@
+package model
+
+// SignalSpec is the VSS spec metadata of a signal. ValueType is the GraphQL
+// scalar of its values: "Float", "String" or "Location".
+type SignalSpec struct {
+	ValueType   string
+	Unit        string
+	Min         string
+	Max         string
+	Description string
+}
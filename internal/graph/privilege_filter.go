@@ -7,13 +7,6 @@ import (
 	"github.com/DIMO-Network/token-exchange-api/pkg/tokenclaims"
 )
 
-// privilegeEnumToPermission maps GraphQL Privilege enum values to tokenclaims permission strings.
-var privilegeEnumToPermission = map[string]string{
-	"VEHICLE_NON_LOCATION_DATA":    tokenclaims.PermissionGetNonLocationHistory,
-	"VEHICLE_ALL_TIME_LOCATION":    tokenclaims.PermissionGetLocationHistory,
-	"VEHICLE_APPROXIMATE_LOCATION": tokenclaims.PermissionGetApproximateLocation,
-}
-
 // hasPrivilegesForSignal checks if the caller has all required privileges for a signal.
 func hasPrivilegesForSignal(signalName string, permissions []string) bool {
 	required, ok := model.SignalPrivileges[signalName]
@@ -27,7 +20,7 @@ func hasPrivilegesForSignal(signalName string, permissions []string) bool {
 		return false
 	}
 	for _, priv := range required {
-		perm, mapped := privilegeEnumToPermission[priv]
+		perm, mapped := model.PrivilegePermissions[priv]
 		if !mapped {
 			return false
 		}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strings"
 	"time"
 )
 
@@ -37,12 +38,22 @@ func New(maxRequestDuration string) (*Limiter, error) {
 }
 
 // AddRequestTimeout wraps the given handler in a new one that cancels the
-// request context after the duration specified in the limiter.
+// request context after the duration specified in the limiter. WebSocket
+// upgrades are exempt because the connection outlives any single operation;
+// their queries are bounded individually.
 func (l *Limiter) AddRequestTimeout(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if isWebsocketUpgrade(r) {
+			next.ServeHTTP(w, r.Clone(context.WithValue(r.Context(), contextKey{}, time.Now())))
+			return
+		}
 		ctx, cancel := context.WithTimeout(r.Context(), l.maxRequestDuration)
 		ctx = context.WithValue(ctx, contextKey{}, time.Now())
 		defer cancel()
 		next.ServeHTTP(w, r.Clone(ctx))
 	})
 }
+
+func isWebsocketUpgrade(r *http.Request) bool {
+	return strings.EqualFold(r.Header.Get("Upgrade"), "websocket")
+}
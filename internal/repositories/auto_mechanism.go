@@ -0,0 +1,83 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/DIMO-Network/model-garage/pkg/vss"
+	"github.com/DIMO-Network/telemetry-api/internal/graph/model"
+)
+
+const (
+	// autoMinIgnitionSignals is the fewest isIgnitionOn samples for ignition detection to be chosen.
+	autoMinIgnitionSignals = 20
+	// autoMinActivitySignalCount is the fewest samples of an activity signal for it to count as reported.
+	autoMinActivitySignalCount = 100
+	// autoMinActivitySignals is the fewest reported activity signals for change point detection to be
+	// chosen, which needs at least 2 distinct signals in an active window.
+	autoMinActivitySignals = 2
+	// autoMaxSignalLag is how long before the vehicle's latest signal a signal may have last been seen and
+	// still count as reported. Older signals stopped, e.g. after a device or firmware change.
+	autoMaxSignalLag = 7 * 24 * time.Hour
+)
+
+// autoActivitySignals are the signals that change point detection counts on while a vehicle is driven.
+var autoActivitySignals = []string{
+	vss.FieldSpeed,
+	vss.FieldPowertrainCombustionEngineSpeed,
+	vss.FieldPowertrainTransmissionTravelledDistance,
+	vss.FieldCurrentLocationCoordinates,
+}
+
+// autoMechanisms are the mechanisms the auto mechanism chooses from.
+var autoMechanisms = map[model.DetectionMechanism]struct{}{
+	model.DetectionMechanismIgnitionDetection:    {},
+	model.DetectionMechanismChangePointDetection: {},
+	model.DetectionMechanismFrequencyAnalysis:    {},
+}
+
+// resolveAutoMechanism returns the mechanism to detect the segments of subject with: mechanism itself,
+// or for auto the one chosen from the vehicle's signal summaries.
+func (r *Repository) resolveAutoMechanism(ctx context.Context, subject string, mechanism model.DetectionMechanism) (model.DetectionMechanism, error) {
+	if mechanism != model.DetectionMechanismAuto {
+		return mechanism, nil
+	}
+	summaries, err := r.chService.GetSignalSummaries(ctx, subject, nil, nil, nil)
+	if err != nil {
+		return "", err
+	}
+	return selectAutoMechanism(summaries), nil
+}
+
+// selectAutoMechanism chooses a mechanism from the lifetime signal summaries of a vehicle:
+// ignitionDetection if isIgnitionOn is reliable, changePointDetection if the vehicle reports enough
+// activity signals, and frequencyAnalysis otherwise. A signal is reliable if it has enough samples and
+// was last seen within autoMaxSignalLag of the vehicle's latest signal.
+func selectAutoMechanism(summaries []*model.SignalDataSummary) model.DetectionMechanism {
+	var latest time.Time
+	byName := make(map[string]*model.SignalDataSummary, len(summaries))
+	for _, s := range summaries {
+		byName[s.Name] = s
+		if s.LastSeen.After(latest) {
+			latest = s.LastSeen
+		}
+	}
+	reported := func(name string, minCount int) bool {
+		s, ok := byName[name]
+		return ok && s.NumberOfSignals >= uint64(minCount) && latest.Sub(s.LastSeen) <= autoMaxSignalLag
+	}
+
+	if reported(vss.FieldIsIgnitionOn, autoMinIgnitionSignals) {
+		return model.DetectionMechanismIgnitionDetection
+	}
+	activity := 0
+	for _, name := range autoActivitySignals {
+		if reported(name, autoMinActivitySignalCount) {
+			activity++
+		}
+	}
+	if activity >= autoMinActivitySignals {
+		return model.DetectionMechanismChangePointDetection
+	}
+	return model.DetectionMechanismFrequencyAnalysis
+}
@@ -0,0 +1,67 @@
+package repositories
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DIMO-Network/model-garage/pkg/vss"
+	"github.com/DIMO-Network/telemetry-api/internal/graph/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSelectAutoMechanism(t *testing.T) {
+	now := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	summary := func(name string, count uint64, lastSeen time.Time) *model.SignalDataSummary {
+		return &model.SignalDataSummary{Name: name, NumberOfSignals: count, FirstSeen: now.AddDate(-1, 0, 0), LastSeen: lastSeen}
+	}
+
+	tests := []struct {
+		name      string
+		summaries []*model.SignalDataSummary
+		expected  model.DetectionMechanism
+	}{
+		{
+			name:     "no signals",
+			expected: model.DetectionMechanismFrequencyAnalysis,
+		},
+		{
+			name: "reliable ignition",
+			summaries: []*model.SignalDataSummary{
+				summary(vss.FieldIsIgnitionOn, 500, now.Add(-time.Hour)),
+				summary(vss.FieldSpeed, 10000, now),
+			},
+			expected: model.DetectionMechanismIgnitionDetection,
+		},
+		{
+			name: "too few ignition samples",
+			summaries: []*model.SignalDataSummary{
+				summary(vss.FieldIsIgnitionOn, 5, now),
+				summary(vss.FieldSpeed, 10000, now),
+				summary(vss.FieldCurrentLocationCoordinates, 10000, now),
+			},
+			expected: model.DetectionMechanismChangePointDetection,
+		},
+		{
+			name: "ignition no longer reported",
+			summaries: []*model.SignalDataSummary{
+				summary(vss.FieldIsIgnitionOn, 500, now.AddDate(0, -2, 0)),
+				summary(vss.FieldSpeed, 10000, now),
+				summary(vss.FieldPowertrainTransmissionTravelledDistance, 10000, now),
+			},
+			expected: model.DetectionMechanismChangePointDetection,
+		},
+		{
+			name: "single activity signal",
+			summaries: []*model.SignalDataSummary{
+				summary(vss.FieldSpeed, 10000, now),
+				summary(vss.FieldCurrentLocationCoordinates, 50, now),
+			},
+			expected: model.DetectionMechanismFrequencyAnalysis,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, selectAutoMechanism(tt.summaries))
+		})
+	}
+}
@@ -0,0 +1,107 @@
+package repositories
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/DIMO-Network/model-garage/pkg/schema"
+	"github.com/DIMO-Network/model-garage/pkg/vss"
+	"github.com/DIMO-Network/telemetry-api/internal/graph/model"
+)
+
+// GetSignalDefinitions returns the catalog of queryable signals, sorted by name.
+func (r *Repository) GetSignalDefinitions() []*model.SignalDefinition {
+	return r.signalDefinitions
+}
+
+// newSignalDefinitions builds the signal catalog from the loaded model-garage
+// definitions, keyed by VSS path, and the generated signal specs and
+// privileges.
+func newSignalDefinitions(definitions *schema.Definitions) []*model.SignalDefinition {
+	defs := make([]*model.SignalDefinition, 0, len(definitions.FromName))
+	for vssName := range definitions.FromName {
+		name := schema.VSSToJSONName(vssName)
+		spec, ok := model.SignalSpecs[name]
+		if !ok {
+			continue
+		}
+		defs = append(defs, &model.SignalDefinition{
+			Name:         name,
+			VssPath:      vssName,
+			ValueType:    spec.ValueType,
+			Unit:         optionalString(spec.Unit),
+			Min:          optionalString(spec.Min),
+			Max:          optionalString(spec.Max),
+			Description:  spec.Description,
+			Privileges:   signalPermissions(model.SignalPrivileges[name]),
+			Aggregations: aggregationsForValueType(spec.ValueType),
+		})
+	}
+	if coords, ok := model.SignalSpecs[vss.FieldCurrentLocationCoordinates]; ok {
+		defs = append(defs, approximateLocationDefinition(definitions, coords))
+	}
+	slices.SortFunc(defs, func(a, b *model.SignalDefinition) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+	return defs
+}
+
+// approximateLocationDefinition returns the definition of the approximate
+// location, which is derived from the exact coordinates and readable with any
+// one of its privileges.
+func approximateLocationDefinition(definitions *schema.Definitions, coords model.SignalSpec) *model.SignalDefinition {
+	var vssPath string
+	for vssName := range definitions.FromName {
+		if schema.VSSToJSONName(vssName) == vss.FieldCurrentLocationCoordinates {
+			vssPath = vssName
+			break
+		}
+	}
+	return &model.SignalDefinition{
+		Name:      model.ApproximateCoordinatesField,
+		VssPath:   vssPath,
+		ValueType: coords.ValueType,
+		Description: "Approximate location of the vehicle in WGS 84 coordinates: the center of the " +
+			"H3 cell of resolution 6 containing the current location.",
+		Privileges:   signalPermissions([]string{"VEHICLE_APPROXIMATE_LOCATION", "VEHICLE_ALL_TIME_LOCATION"}),
+		Aggregations: aggregationsForValueType(coords.ValueType),
+	}
+}
+
+// signalPermissions converts the Privilege enum names of a signal to the
+// tokenclaims permissions the Privilege scalar marshals.
+func signalPermissions(privileges []string) []string {
+	perms := make([]string, 0, len(privileges))
+	for _, priv := range privileges {
+		if perm, ok := model.PrivilegePermissions[priv]; ok {
+			perms = append(perms, perm)
+		}
+	}
+	return perms
+}
+
+func aggregationsForValueType(valueType string) []string {
+	var aggs []string
+	switch valueType {
+	case "Float":
+		for _, agg := range model.AllFloatAggregation {
+			aggs = append(aggs, agg.String())
+		}
+	case "Location":
+		for _, agg := range model.AllLocationAggregation {
+			aggs = append(aggs, agg.String())
+		}
+	default:
+		for _, agg := range model.AllStringAggregation {
+			aggs = append(aggs, agg.String())
+		}
+	}
+	return aggs
+}
+
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return &s
+}
@@ -0,0 +1,50 @@
+package repositories_test
+
+import (
+	"testing"
+
+	"github.com/DIMO-Network/model-garage/pkg/vss"
+	"github.com/DIMO-Network/telemetry-api/internal/graph/model"
+	"github.com/DIMO-Network/telemetry-api/internal/repositories"
+	"github.com/DIMO-Network/token-exchange-api/pkg/tokenclaims"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetSignalDefinitions(t *testing.T) {
+	mocks := setupMocks(t)
+	repo, err := repositories.NewRepository(mocks.CHService, baseSettings)
+	require.NoError(t, err)
+
+	defs := repo.GetSignalDefinitions()
+	require.NotEmpty(t, defs)
+	byName := make(map[string]*model.SignalDefinition, len(defs))
+	for i, def := range defs {
+		if i > 0 {
+			require.Less(t, defs[i-1].Name, def.Name, "definitions are sorted by name")
+		}
+		if def.Name != model.ApproximateCoordinatesField {
+			require.Contains(t, model.SignalPrivileges, def.Name)
+		}
+		byName[def.Name] = def
+	}
+
+	speed := byName[vss.FieldSpeed]
+	require.NotNil(t, speed)
+	require.Equal(t, "Float", speed.ValueType)
+	require.Equal(t, ref("km/h"), speed.Unit)
+	require.Equal(t, []string{tokenclaims.PermissionGetNonLocationHistory}, speed.Privileges)
+	require.Contains(t, speed.Aggregations, model.FloatAggregationAvg.String())
+
+	location := byName[vss.FieldCurrentLocationCoordinates]
+	require.NotNil(t, location)
+	require.Equal(t, "Location", location.ValueType)
+	require.Equal(t, []string{tokenclaims.PermissionGetLocationHistory}, location.Privileges)
+	require.Contains(t, location.Aggregations, model.LocationAggregationLast.String())
+
+	approximate := byName[model.ApproximateCoordinatesField]
+	require.NotNil(t, approximate)
+	require.Equal(t, location.VssPath, approximate.VssPath)
+	require.Equal(t, "Location", approximate.ValueType)
+	require.Equal(t, []string{tokenclaims.PermissionGetApproximateLocation, tokenclaims.PermissionGetLocationHistory}, approximate.Privileges)
+	require.Equal(t, location.Aggregations, approximate.Aggregations)
+}
@@ -0,0 +1,69 @@
+package repositories
+
+import (
+	"github.com/DIMO-Network/model-garage/pkg/vss"
+	"github.com/DIMO-Network/telemetry-api/internal/graph/model"
+)
+
+// maxACChargingPowerKw is the most an AC charger delivers (three-phase 32 A at 400 V);
+// sessions that peak higher are DC.
+const maxACChargingPowerKw = 22
+
+// Default signal requests that chargingSession reads on top of SoC first/last.
+var (
+	sigChargingPowerMax = &model.SegmentSignalRequest{Name: vss.FieldPowertrainTractionBatteryChargingPower, Agg: model.FloatAggregationMax}
+	sigChargingPowerAvg = &model.SegmentSignalRequest{Name: vss.FieldPowertrainTractionBatteryChargingPower, Agg: model.FloatAggregationAvg}
+	sigChargeCurrentAC  = &model.SegmentSignalRequest{Name: vss.FieldPowertrainTractionBatteryChargingChargeCurrentAC, Agg: model.FloatAggregationMax}
+	sigGrossCapacity    = &model.SegmentSignalRequest{Name: vss.FieldPowertrainTractionBatteryGrossCapacity, Agg: model.FloatAggregationLast}
+)
+
+// chargingSession builds the charging session details of a recharge segment from its
+// summary signals and start location. capacityKwh overrides the battery capacity signal.
+func chargingSession(seg *model.Segment, capacityKwh *float64) *model.ChargingSession {
+	session := &model.ChargingSession{
+		SocStart:       segmentSignal(seg.Signals, sigSoCFirst),
+		SocEnd:         segmentSignal(seg.Signals, sigSoCLast),
+		PeakPowerKw:    segmentSignal(seg.Signals, sigChargingPowerMax),
+		AveragePowerKw: segmentSignal(seg.Signals, sigChargingPowerAvg),
+	}
+
+	session.BatteryCapacityKwh = capacityKwh
+	if capacityKwh == nil {
+		if capacity := segmentSignal(seg.Signals, sigGrossCapacity); capacity != nil && *capacity > 0 {
+			session.BatteryCapacityKwh = capacity
+		}
+	}
+	if session.SocStart != nil && session.SocEnd != nil && session.BatteryCapacityKwh != nil {
+		energy := max(*session.SocEnd-*session.SocStart, 0) / 100 * *session.BatteryCapacityKwh
+		session.EnergyAddedKwh = &energy
+	}
+
+	ac, dc := model.ChargerTypeAc, model.ChargerTypeDc
+	acCurrent := segmentSignal(seg.Signals, sigChargeCurrentAC)
+	switch {
+	case session.PeakPowerKw != nil && *session.PeakPowerKw > maxACChargingPowerKw:
+		session.ChargerType = &dc
+	case acCurrent != nil && *acCurrent > 0:
+		session.ChargerType = &ac
+	case acCurrent != nil && session.PeakPowerKw != nil && *session.PeakPowerKw > 0:
+		// Charging without any AC current at the inlet.
+		session.ChargerType = &dc
+	}
+
+	if loc := seg.Start.Value; loc != nil && (loc.Latitude != 0 || loc.Longitude != 0) {
+		session.Location = loc
+	}
+	return session
+}
+
+// segmentSignal returns the value of the segment signal matching the request's name and agg,
+// or nil if the segment has none.
+func segmentSignal(signals []*model.SignalAggregationValue, req *model.SegmentSignalRequest) *float64 {
+	for _, s := range signals {
+		if s != nil && s.Name == req.Name && s.Agg == string(req.Agg) {
+			value := s.Value
+			return &value
+		}
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+package repositories
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DIMO-Network/model-garage/pkg/vss"
+	"github.com/DIMO-Network/telemetry-api/internal/graph/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChargingSession(t *testing.T) {
+	start := &model.SignalLocation{
+		Timestamp: time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC),
+		Value:     &model.Location{Latitude: 40.75, Longitude: -73.99},
+	}
+	signal := func(req *model.SegmentSignalRequest, value float64) *model.SignalAggregationValue {
+		return &model.SignalAggregationValue{Name: req.Name, Agg: string(req.Agg), Value: value}
+	}
+	ac, dc := model.ChargerTypeAc, model.ChargerTypeDc
+
+	t.Run("AC session with capacity signal", func(t *testing.T) {
+		seg := &model.Segment{Start: start, Signals: []*model.SignalAggregationValue{
+			signal(sigSoCFirst, 20),
+			signal(sigSoCLast, 80),
+			signal(sigGrossCapacity, 75),
+			signal(sigChargingPowerMax, 11),
+			signal(sigChargingPowerAvg, 10.5),
+			signal(sigChargeCurrentAC, 16),
+		}}
+		session := chargingSession(seg, nil)
+		require.Equal(t, floatRef(20.0), session.SocStart)
+		require.Equal(t, floatRef(80.0), session.SocEnd)
+		require.Equal(t, floatRef(75.0), session.BatteryCapacityKwh)
+		require.InDelta(t, 45, *session.EnergyAddedKwh, 1e-9)
+		require.Equal(t, floatRef(11.0), session.PeakPowerKw)
+		require.Equal(t, floatRef(10.5), session.AveragePowerKw)
+		require.Equal(t, &ac, session.ChargerType)
+		require.Equal(t, start.Value, session.Location)
+	})
+
+	t.Run("capacity argument overrides the signal", func(t *testing.T) {
+		seg := &model.Segment{Start: start, Signals: []*model.SignalAggregationValue{
+			signal(sigSoCFirst, 50),
+			signal(sigSoCLast, 60),
+			signal(sigGrossCapacity, 75),
+		}}
+		session := chargingSession(seg, floatRef(60.0))
+		require.Equal(t, floatRef(60.0), session.BatteryCapacityKwh)
+		require.InDelta(t, 6, *session.EnergyAddedKwh, 1e-9)
+		require.Nil(t, session.ChargerType)
+	})
+
+	t.Run("DC from power or missing AC current", func(t *testing.T) {
+		fast := &model.Segment{Start: start, Signals: []*model.SignalAggregationValue{signal(sigChargingPowerMax, 150)}}
+		require.Equal(t, &dc, chargingSession(fast, nil).ChargerType)
+
+		noAC := &model.Segment{Start: start, Signals: []*model.SignalAggregationValue{
+			signal(sigChargingPowerMax, 20),
+			signal(sigChargeCurrentAC, 0),
+		}}
+		require.Equal(t, &dc, chargingSession(noAC, nil).ChargerType)
+	})
+
+	t.Run("no data", func(t *testing.T) {
+		seg := &model.Segment{Start: &model.SignalLocation{Timestamp: start.Timestamp, Value: noDataLocation()}}
+		require.Equal(t, &model.ChargingSession{}, chargingSession(seg, nil))
+	})
+}
+
+func TestRechargeDefaultSignalSet(t *testing.T) {
+	names := make(map[string]bool)
+	for _, req := range defaultSegmentSignalSet(model.DetectionMechanismRecharge) {
+		names[req.Name] = true
+	}
+	require.True(t, names[vss.FieldPowertrainTractionBatteryChargingPower])
+	require.True(t, names[vss.FieldPowertrainTractionBatteryChargingChargeCurrentAC])
+	require.True(t, names[vss.FieldPowertrainTractionBatteryGrossCapacity])
+}
@@ -0,0 +1,194 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/DIMO-Network/server-garage/pkg/gql/errorhandler"
+	"github.com/DIMO-Network/telemetry-api/internal/graph/model"
+	"github.com/DIMO-Network/telemetry-api/internal/service/ch"
+)
+
+const (
+	maxCoverageBuckets     = 5000
+	minCoverageGapSeconds  = 60
+	defaultCoverageGapSecs = 3600
+)
+
+// GetDataCoverage returns per-bucket sample counts and gaps longer than
+// minGapSeconds for each signal of the given tokenID in [from, to).
+func (r *Repository) GetDataCoverage(ctx context.Context, tokenID int, from, to time.Time, interval string, names []string, minGapSeconds *int, filter *model.SignalFilter) ([]*model.SignalCoverage, error) {
+	windowSize, gapSeconds, err := validateCoverageArgs(tokenID, from, to, interval, names, minGapSeconds, r.queryableSignals)
+	if err != nil {
+		return nil, errorhandler.NewBadRequestError(ctx, err)
+	}
+	if err := validateFilter(filter); err != nil {
+		return nil, errorhandler.NewBadRequestError(ctx, err)
+	}
+
+	subject := r.toSubject(uint32(tokenID))
+	windows, err := r.chService.GetSignalCoverage(ctx, subject, from, to, windowSize, names, filter)
+	if err != nil {
+		return nil, handleDBError(ctx, err)
+	}
+
+	// Gaps extend to the end of the range, but not past the present.
+	end := to
+	if now := time.Now().UTC(); now.Before(end) {
+		end = now
+	}
+	return coverageFromWindows(windows, names, r.queryableSignals, from, end, time.Duration(gapSeconds)*time.Second), nil
+}
+
+// coverageFromWindows groups the windows by signal, in the order returned by
+// ClickHouse, and finds the gaps between consecutive samples. Requested names
+// without any windows are reported with a single gap spanning the range.
+func coverageFromWindows(windows []*ch.CoverageWindow, names []string, queryable map[string]struct{}, from, end time.Time, minGap time.Duration) []*model.SignalCoverage {
+	var coverage []*model.SignalCoverage
+	byName := make(map[string]*model.SignalCoverage)
+	lastSeen := make(map[string]time.Time)
+	for _, w := range windows {
+		// Stored names that left the GraphQL schema are not reported.
+		if _, ok := queryable[w.Name]; !ok {
+			continue
+		}
+		sc, ok := byName[w.Name]
+		if !ok {
+			sc = &model.SignalCoverage{Name: w.Name, Buckets: []*model.CoverageBucket{}, Gaps: []*model.DataGap{}}
+			byName[w.Name] = sc
+			coverage = append(coverage, sc)
+			lastSeen[w.Name] = from
+		}
+		sc.TotalCount += int(w.Count)
+		sc.Buckets = append(sc.Buckets, &model.CoverageBucket{Timestamp: w.WindowStart, Count: int(w.Count)})
+		if gap := newDataGap(lastSeen[w.Name], w.FirstSeen, minGap); gap != nil {
+			sc.Gaps = append(sc.Gaps, gap)
+		}
+		lastSeen[w.Name] = w.LastSeen
+	}
+	for _, sc := range coverage {
+		if gap := newDataGap(lastSeen[sc.Name], end, minGap); gap != nil {
+			sc.Gaps = append(sc.Gaps, gap)
+		}
+	}
+
+	for _, name := range names {
+		if _, ok := byName[name]; ok {
+			continue
+		}
+		sc := &model.SignalCoverage{Name: name, Buckets: []*model.CoverageBucket{}, Gaps: []*model.DataGap{}}
+		if gap := newDataGap(from, end, minGap); gap != nil {
+			sc.Gaps = append(sc.Gaps, gap)
+		}
+		byName[name] = sc
+		coverage = append(coverage, sc)
+	}
+	if coverage == nil {
+		return []*model.SignalCoverage{}
+	}
+	return coverage
+}
+
+// newDataGap returns the gap between start and end, or nil if it is not
+// longer than minGap.
+func newDataGap(start, end time.Time, minGap time.Duration) *model.DataGap {
+	if end.Sub(start) <= minGap {
+		return nil
+	}
+	return &model.DataGap{Start: start, End: end, Duration: int(end.Sub(start) / time.Second)}
+}
+
+// GetSamplingStats returns inter-sample interval statistics per signal and
+// source for the given tokenID in [from, to).
+func (r *Repository) GetSamplingStats(ctx context.Context, tokenID int, from, to time.Time, names []string, filter *model.SignalFilter) ([]*model.SignalSamplingStats, error) {
+	if err := validateCoverageRange(tokenID, from, to, names, r.queryableSignals); err != nil {
+		return nil, errorhandler.NewBadRequestError(ctx, err)
+	}
+	if err := validateFilter(filter); err != nil {
+		return nil, errorhandler.NewBadRequestError(ctx, err)
+	}
+
+	subject := r.toSubject(uint32(tokenID))
+	stats, err := r.chService.GetSamplingStats(ctx, subject, from, to, names, filter)
+	if err != nil {
+		return nil, handleDBError(ctx, err)
+	}
+
+	result := make([]*model.SignalSamplingStats, 0, len(stats))
+	for _, st := range stats {
+		// Stored names that left the GraphQL schema are not reported.
+		if _, ok := r.queryableSignals[st.Name]; !ok {
+			continue
+		}
+		result = append(result, samplingStatsToModel(st))
+	}
+	return result, nil
+}
+
+func samplingStatsToModel(st *ch.SamplingStats) *model.SignalSamplingStats {
+	stats := &model.SignalSamplingStats{
+		Name:        st.Name,
+		Source:      st.Source,
+		SampleCount: int(st.SampleCount),
+		ActiveHours: int(st.ActiveHours),
+	}
+	if st.ActiveHours > 0 {
+		stats.SamplesPerActiveHour = float64(st.SampleCount) / float64(st.ActiveHours)
+	}
+	if st.IntervalCount > 0 {
+		median := st.MedianIntervalMs / 1000
+		p95 := st.P95IntervalMs / 1000
+		maxInterval := float64(st.MaxIntervalMs) / 1000
+		stats.MedianIntervalSeconds = &median
+		stats.P95IntervalSeconds = &p95
+		stats.MaxIntervalSeconds = &maxInterval
+	}
+	return stats
+}
+
+// validateCoverageRange validates the arguments shared by the coverage and
+// sampling statistics queries.
+func validateCoverageRange(tokenID int, from, to time.Time, names []string, queryable map[string]struct{}) error {
+	if tokenID < 1 {
+		return ValidationError("tokenID is not a positive integer")
+	}
+	if !from.Before(to) {
+		return ValidationError("from timestamp must be before to timestamp")
+	}
+	if to.Sub(from) > maxDateRangeDuration {
+		return ValidationError(fmt.Sprintf("date range exceeds maximum of %d days", maxDateRangeDays))
+	}
+	for _, name := range names {
+		if _, ok := queryable[name]; !ok {
+			return ValidationError(fmt.Sprintf("unknown signal name '%s'", name))
+		}
+	}
+	return nil
+}
+
+// validateCoverageArgs validates the dataCoverage arguments and returns the
+// bucket size and minimum gap in seconds.
+func validateCoverageArgs(tokenID int, from, to time.Time, interval string, names []string, minGapSeconds *int, queryable map[string]struct{}) (int, int, error) {
+	if err := validateCoverageRange(tokenID, from, to, names, queryable); err != nil {
+		return 0, 0, err
+	}
+	dur, err := time.ParseDuration(interval)
+	if err != nil {
+		return 0, 0, ValidationError(fmt.Sprintf("invalid interval '%s'", interval))
+	}
+	if dur < time.Second || dur%time.Second != 0 {
+		return 0, 0, ValidationError("interval must be a positive whole number of seconds")
+	}
+	if (to.Sub(from)+dur-1)/dur > maxCoverageBuckets {
+		return 0, 0, ValidationError(fmt.Sprintf("interval yields more than %d buckets", maxCoverageBuckets))
+	}
+	gapSeconds := defaultCoverageGapSecs
+	if minGapSeconds != nil {
+		gapSeconds = *minGapSeconds
+	}
+	if gapSeconds < minCoverageGapSeconds {
+		return 0, 0, ValidationError(fmt.Sprintf("minGapSeconds must be at least %d", minCoverageGapSeconds))
+	}
+	return int(dur / time.Second), gapSeconds, nil
+}
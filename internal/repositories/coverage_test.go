@@ -0,0 +1,138 @@
+package repositories_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/DIMO-Network/cloudevent"
+	"github.com/DIMO-Network/model-garage/pkg/vss"
+	"github.com/DIMO-Network/telemetry-api/internal/graph/model"
+	"github.com/DIMO-Network/telemetry-api/internal/repositories"
+	"github.com/DIMO-Network/telemetry-api/internal/service/ch"
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+)
+
+func TestGetDataCoverage(t *testing.T) {
+	testSubject := cloudevent.ERC721DID{
+		ChainID:         baseSettings.ChainID,
+		ContractAddress: baseSettings.VehicleNFTAddress,
+		TokenID:         big.NewInt(1),
+	}.String()
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(6 * time.Hour)
+	names := []string{vss.FieldSpeed, vss.FieldPowertrainTransmissionTravelledDistance}
+
+	mocks := setupMocks(t)
+	mocks.CHService.EXPECT().
+		GetSignalCoverage(gomock.Any(), testSubject, from, to, 3600, names, nil).
+		Return([]*ch.CoverageWindow{
+			// Speed reports in the first hour, stops for three hours and resumes.
+			{Name: vss.FieldSpeed, WindowStart: from, Count: 10, FirstSeen: from.Add(time.Minute), LastSeen: from.Add(50 * time.Minute)},
+			{Name: vss.FieldSpeed, WindowStart: from.Add(4 * time.Hour), Count: 5, FirstSeen: from.Add(4*time.Hour + 30*time.Minute), LastSeen: from.Add(5*time.Hour + 30*time.Minute)},
+			// Stored names that left the schema are dropped.
+			{Name: "currentLocationIsRedacted", WindowStart: from, Count: 1, FirstSeen: from, LastSeen: from},
+		}, nil)
+
+	repo, err := repositories.NewRepository(mocks.CHService, baseSettings)
+	require.NoError(t, err)
+
+	coverage, err := repo.GetDataCoverage(context.Background(), 1, from, to, "1h", names, nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, []*model.SignalCoverage{
+		{
+			Name:       vss.FieldSpeed,
+			TotalCount: 15,
+			Buckets: []*model.CoverageBucket{
+				{Timestamp: from, Count: 10},
+				{Timestamp: from.Add(4 * time.Hour), Count: 5},
+			},
+			Gaps: []*model.DataGap{
+				{Start: from.Add(50 * time.Minute), End: from.Add(4*time.Hour + 30*time.Minute), Duration: 13200},
+			},
+		},
+		{
+			Name:    vss.FieldPowertrainTransmissionTravelledDistance,
+			Buckets: []*model.CoverageBucket{},
+			Gaps:    []*model.DataGap{{Start: from, End: to, Duration: 21600}},
+		},
+	}, coverage)
+}
+
+func TestGetDataCoverageInvalidArgs(t *testing.T) {
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(24 * time.Hour)
+
+	tests := []struct {
+		name          string
+		from, to      time.Time
+		interval      string
+		names         []string
+		minGapSeconds *int
+	}{
+		{name: "from after to", from: to, to: from, interval: "1h"},
+		{name: "range too long", from: from, to: from.Add(40 * 24 * time.Hour), interval: "24h"},
+		{name: "bad interval", from: from, to: to, interval: "1d"},
+		{name: "fractional seconds", from: from, to: to, interval: "1500ms"},
+		{name: "too many buckets", from: from, to: to, interval: "1s"},
+		{name: "unknown signal", from: from, to: to, interval: "1h", names: []string{"notASignal"}},
+		{name: "gap too short", from: from, to: to, interval: "1h", minGapSeconds: ref(30)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mocks := setupMocks(t)
+			repo, err := repositories.NewRepository(mocks.CHService, baseSettings)
+			require.NoError(t, err)
+
+			_, err = repo.GetDataCoverage(context.Background(), 1, tt.from, tt.to, tt.interval, tt.names, tt.minGapSeconds, nil)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestGetSamplingStats(t *testing.T) {
+	testSubject := cloudevent.ERC721DID{
+		ChainID:         baseSettings.ChainID,
+		ContractAddress: baseSettings.VehicleNFTAddress,
+		TokenID:         big.NewInt(1),
+	}.String()
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(24 * time.Hour)
+
+	mocks := setupMocks(t)
+	mocks.CHService.EXPECT().
+		GetSamplingStats(gomock.Any(), testSubject, from, to, nil, nil).
+		Return([]*ch.SamplingStats{
+			{Name: vss.FieldSpeed, Source: "0xsource", SampleCount: 120, ActiveHours: 2, IntervalCount: 119, MedianIntervalMs: 30000, P95IntervalMs: 61000, MaxIntervalMs: 3600000},
+			{Name: vss.FieldPowertrainTransmissionTravelledDistance, Source: "0xsource", SampleCount: 1, ActiveHours: 1},
+			{Name: "currentLocationIsRedacted", Source: "0xsource", SampleCount: 5, ActiveHours: 1, IntervalCount: 4},
+		}, nil)
+
+	repo, err := repositories.NewRepository(mocks.CHService, baseSettings)
+	require.NoError(t, err)
+
+	stats, err := repo.GetSamplingStats(context.Background(), 1, from, to, nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, []*model.SignalSamplingStats{
+		{
+			Name:                  vss.FieldSpeed,
+			Source:                "0xsource",
+			SampleCount:           120,
+			ActiveHours:           2,
+			SamplesPerActiveHour:  60,
+			MedianIntervalSeconds: ref(30.0),
+			P95IntervalSeconds:    ref(61.0),
+			MaxIntervalSeconds:    ref(3600.0),
+		},
+		{
+			// A single sample has no intervals.
+			Name:                 vss.FieldPowertrainTransmissionTravelledDistance,
+			Source:               "0xsource",
+			SampleCount:          1,
+			ActiveHours:          1,
+			SamplesPerActiveHour: 1,
+		},
+	}, stats)
+}
@@ -0,0 +1,254 @@
+package repositories
+
+import (
+	"context"
+	"encoding/json"
+	"math"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/DIMO-Network/model-garage/pkg/vss"
+	"github.com/DIMO-Network/telemetry-api/internal/graph/model"
+	"github.com/DIMO-Network/telemetry-api/internal/service/ch"
+)
+
+const (
+	// DerivedEventSource is the source of events derived from signals rather than stored.
+	DerivedEventSource = "telemetry-api:derived"
+
+	derivedHarshAcceleration = "behavior.harshAcceleration"
+	derivedHarshBraking      = "behavior.harshBraking"
+	derivedHarshCornering    = "behavior.harshCornering"
+
+	defaultHarshAccelerationThreshold = 3.0
+	defaultHarshBrakingThreshold      = 4.0
+	defaultHarshCorneringThreshold    = 4.0
+	minDerivedEventThreshold          = 0.5
+	maxDerivedEventThreshold          = 20.0
+
+	// defaultMaxSampleGapSeconds is the default longest gap between samples that are differentiated or
+	// joined into one event. Over longer gaps the acceleration is smeared out and harsh maneuvers are missed.
+	defaultMaxSampleGapSeconds = 5
+	minMaxSampleGapSeconds     = 1
+	maxMaxSampleGapSeconds     = 60
+	// maxPlausibleAcceleration (about 1.5 g) bounds longitudinal acceleration; larger speed changes are
+	// glitches, such as a dropped speed reading.
+	maxPlausibleAcceleration = 15.0
+	kphToMps                 = 1 / 3.6
+)
+
+// derivedEventMetadata is the metadata of a derived event.
+type derivedEventMetadata struct {
+	// Peak is the largest acceleration magnitude during the event in m/s².
+	Peak float64 `json:"peak"`
+	// Threshold is the threshold in m/s² the event exceeded.
+	Threshold float64 `json:"threshold"`
+	// SpeedKph is the speed at the start of the event.
+	SpeedKph float64 `json:"speedKph"`
+}
+
+// derivedThresholds are the harsh driving thresholds in m/s² and the longest sample gap, with
+// defaults applied.
+type derivedThresholds struct {
+	acceleration, braking, cornering float64
+	maxSampleGap                     time.Duration
+}
+
+func resolveDerivedThresholds(config *model.DerivedEventsConfig) derivedThresholds {
+	t := derivedThresholds{
+		acceleration: defaultHarshAccelerationThreshold,
+		braking:      defaultHarshBrakingThreshold,
+		cornering:    defaultHarshCorneringThreshold,
+		maxSampleGap: defaultMaxSampleGapSeconds * time.Second,
+	}
+	if config.HarshAccelerationThreshold != nil {
+		t.acceleration = *config.HarshAccelerationThreshold
+	}
+	if config.HarshBrakingThreshold != nil {
+		t.braking = *config.HarshBrakingThreshold
+	}
+	if config.HarshCorneringThreshold != nil {
+		t.cornering = *config.HarshCorneringThreshold
+	}
+	if config.MaxSampleGapSeconds != nil {
+		t.maxSampleGap = time.Duration(*config.MaxSampleGapSeconds) * time.Second
+	}
+	return t
+}
+
+// getDerivedEvents derives harsh driving events in [from, to) from the speed and angularVelocityYaw
+// samples of subject, keeping those matching the name and source conditions of filter. Filters with
+// metadata, location or tags conditions match no derived events, which have none of these.
+func (r *Repository) getDerivedEvents(ctx context.Context, subject string, from, to time.Time, filter *model.EventFilter, config *model.DerivedEventsConfig) ([]*model.Event, error) {
+	if filter != nil && (len(filter.Metadata) > 0 || filter.Location != nil || filter.Tags != nil) {
+		return nil, nil
+	}
+	thresholds := resolveDerivedThresholds(config)
+	// Samples just before from complete the first acceleration in the range.
+	samples, err := r.chService.GetFloatSamples(ctx, subject, from.Add(-thresholds.maxSampleGap), to, []string{vss.FieldSpeed, vss.FieldAngularVelocityYaw})
+	if err != nil {
+		return nil, err
+	}
+	var events []*model.Event
+	for _, event := range deriveHarshEvents(samples, thresholds) {
+		if event.Timestamp.Before(from) {
+			continue
+		}
+		if filter != nil && (!matchesStringFilter(event.Name, filter.Name) || !matchesStringFilter(event.Source, filter.Source)) {
+			continue
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+// deriveHarshEvents returns the harsh acceleration, braking and cornering events in samples, both
+// ordered by timestamp. There are no acceleration signals, so longitudinal acceleration is the change
+// of speed between consecutive speed samples; lateral acceleration is speed times yaw rate.
+// Consecutive samples over a threshold at most thresholds.maxSampleGap apart form one event.
+func deriveHarshEvents(samples []*ch.FloatSample, thresholds derivedThresholds) []*model.Event {
+	var speeds, yaws []*ch.FloatSample
+	for _, s := range samples {
+		switch s.Name {
+		case vss.FieldSpeed:
+			speeds = append(speeds, s)
+		case vss.FieldAngularVelocityYaw:
+			yaws = append(yaws, s)
+		}
+	}
+
+	accel := newHarshEventBuilder(derivedHarshAcceleration, thresholds.acceleration)
+	braking := newHarshEventBuilder(derivedHarshBraking, thresholds.braking)
+	for i := 1; i < len(speeds); i++ {
+		prev, cur := speeds[i-1], speeds[i]
+		dt := cur.Timestamp.Sub(prev.Timestamp)
+		if dt <= 0 || dt > thresholds.maxSampleGap {
+			accel.flush()
+			braking.flush()
+			continue
+		}
+		a := (cur.Value - prev.Value) * kphToMps / dt.Seconds()
+		if math.Abs(a) > maxPlausibleAcceleration {
+			accel.flush()
+			braking.flush()
+			continue
+		}
+		accel.add(prev.Timestamp, cur.Timestamp, a, prev.Value)
+		braking.add(prev.Timestamp, cur.Timestamp, -a, prev.Value)
+	}
+
+	cornering := newHarshEventBuilder(derivedHarshCornering, thresholds.cornering)
+	speedIdx := -1
+	var lastYaw time.Time
+	for _, yaw := range yaws {
+		if !lastYaw.IsZero() && yaw.Timestamp.Sub(lastYaw) > thresholds.maxSampleGap {
+			cornering.flush()
+		}
+		lastYaw = yaw.Timestamp
+		// The latest speed sample at or before the yaw sample, if recent enough.
+		for speedIdx+1 < len(speeds) && !speeds[speedIdx+1].Timestamp.After(yaw.Timestamp) {
+			speedIdx++
+		}
+		if speedIdx < 0 || yaw.Timestamp.Sub(speeds[speedIdx].Timestamp) > thresholds.maxSampleGap {
+			cornering.flush()
+			continue
+		}
+		speed := speeds[speedIdx].Value
+		lateral := speed * kphToMps * math.Abs(yaw.Value) * math.Pi / 180
+		cornering.add(yaw.Timestamp, yaw.Timestamp, lateral, speed)
+	}
+
+	events := slices.Concat(accel.done(), braking.done(), cornering.done())
+	slices.SortStableFunc(events, func(a, b *model.Event) int { return a.Timestamp.Compare(b.Timestamp) })
+	return events
+}
+
+// harshEventBuilder joins consecutive measurements over a threshold into events.
+type harshEventBuilder struct {
+	name      string
+	threshold float64
+	events    []*model.Event
+	// The open event, if start is set.
+	start, end time.Time
+	peak       float64
+	speedKph   float64
+}
+
+func newHarshEventBuilder(name string, threshold float64) *harshEventBuilder {
+	return &harshEventBuilder{name: name, threshold: threshold}
+}
+
+// add records value, measured over [start, end) at speedKph. Values at or below the threshold end
+// the open event.
+func (b *harshEventBuilder) add(start, end time.Time, value, speedKph float64) {
+	if value <= b.threshold {
+		b.flush()
+		return
+	}
+	if b.start.IsZero() {
+		b.start, b.peak, b.speedKph = start, value, speedKph
+	}
+	b.end = end
+	b.peak = max(b.peak, value)
+}
+
+// flush ends the open event.
+func (b *harshEventBuilder) flush() {
+	if b.start.IsZero() {
+		return
+	}
+	metadata, _ := json.Marshal(derivedEventMetadata{
+		Peak:      math.Round(b.peak*100) / 100,
+		Threshold: b.threshold,
+		SpeedKph:  b.speedKph,
+	})
+	meta := string(metadata)
+	b.events = append(b.events, &model.Event{
+		Timestamp:  b.start,
+		Name:       b.name,
+		Source:     DerivedEventSource,
+		DurationNs: int(b.end.Sub(b.start).Nanoseconds()),
+		Metadata:   &meta,
+	})
+	b.start = time.Time{}
+}
+
+// done ends the open event and returns the events.
+func (b *harshEventBuilder) done() []*model.Event {
+	b.flush()
+	return b.events
+}
+
+// matchesStringFilter reports whether v satisfies filter like the ClickHouse condition built from it:
+// all of its own conditions hold, or one of its or clauses matches.
+func matchesStringFilter(v string, filter *model.StringValueFilter) bool {
+	if filter == nil {
+		return true
+	}
+	hasOwn := hasOwnStringConditions(filter)
+	own := (filter.Eq == nil || v == *filter.Eq) &&
+		(filter.Neq == nil || v != *filter.Neq) &&
+		(filter.NotIn == nil || !slices.Contains(filter.NotIn, v)) &&
+		(filter.In == nil || slices.Contains(filter.In, v)) &&
+		(filter.StartsWith == nil || strings.HasPrefix(v, *filter.StartsWith))
+	if hasOwn && own {
+		return true
+	}
+	anyOr := false
+	for _, cond := range filter.Or {
+		// Clauses without conditions add nothing to the ClickHouse condition.
+		if cond == nil || (!hasOwnStringConditions(cond) && len(cond.Or) == 0) {
+			continue
+		}
+		anyOr = true
+		if matchesStringFilter(v, cond) {
+			return true
+		}
+	}
+	return !hasOwn && !anyOr
+}
+
+func hasOwnStringConditions(filter *model.StringValueFilter) bool {
+	return filter.Eq != nil || filter.Neq != nil || filter.NotIn != nil || filter.In != nil || filter.StartsWith != nil
+}
@@ -0,0 +1,115 @@
+package repositories
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DIMO-Network/model-garage/pkg/vss"
+	"github.com/DIMO-Network/telemetry-api/internal/graph/model"
+	"github.com/DIMO-Network/telemetry-api/internal/service/ch"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeriveHarshEvents(t *testing.T) {
+	t0 := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	sample := func(name string, sec int, value float64) *ch.FloatSample {
+		return &ch.FloatSample{Name: name, Timestamp: t0.Add(time.Duration(sec) * time.Second), Value: value}
+	}
+	samples := []*ch.FloatSample{
+		sample(vss.FieldSpeed, 0, 0),
+		sample(vss.FieldSpeed, 1, 15),
+		sample(vss.FieldSpeed, 2, 30),
+		sample(vss.FieldAngularVelocityYaw, 2, 30),
+		sample(vss.FieldSpeed, 3, 45),
+		sample(vss.FieldAngularVelocityYaw, 3, -30),
+		sample(vss.FieldSpeed, 4, 45),
+		sample(vss.FieldAngularVelocityYaw, 4, 0),
+		sample(vss.FieldSpeed, 5, 25),
+		// A jump of 75 km/h in a second is a glitch.
+		sample(vss.FieldSpeed, 6, 100),
+		sample(vss.FieldSpeed, 7, 100),
+		// Speed changes over gaps are not differentiated.
+		sample(vss.FieldSpeed, 20, 0),
+		// The last speed sample is too old for this yaw sample.
+		sample(vss.FieldAngularVelocityYaw, 30, 90),
+	}
+	defaults := resolveDerivedThresholds(&model.DerivedEventsConfig{})
+
+	t.Run("defaults", func(t *testing.T) {
+		events := deriveHarshEvents(samples, defaults)
+		require.Len(t, events, 3)
+
+		require.Equal(t, derivedHarshAcceleration, events[0].Name)
+		require.Equal(t, t0, events[0].Timestamp)
+		require.Equal(t, int(3*time.Second), events[0].DurationNs)
+		require.Equal(t, DerivedEventSource, events[0].Source)
+		require.JSONEq(t, `{"peak": 4.17, "threshold": 3, "speedKph": 0}`, *events[0].Metadata)
+
+		require.Equal(t, derivedHarshCornering, events[1].Name)
+		require.Equal(t, t0.Add(2*time.Second), events[1].Timestamp)
+		require.Equal(t, int(time.Second), events[1].DurationNs)
+		require.JSONEq(t, `{"peak": 6.54, "threshold": 4, "speedKph": 30}`, *events[1].Metadata)
+
+		require.Equal(t, derivedHarshBraking, events[2].Name)
+		require.Equal(t, t0.Add(4*time.Second), events[2].Timestamp)
+		require.JSONEq(t, `{"peak": 5.56, "threshold": 4, "speedKph": 45}`, *events[2].Metadata)
+	})
+
+	t.Run("thresholds", func(t *testing.T) {
+		thresholds := resolveDerivedThresholds(&model.DerivedEventsConfig{
+			HarshAccelerationThreshold: floatRef(5),
+			HarshCorneringThreshold:    floatRef(5),
+		})
+		require.Equal(t, defaults.braking, thresholds.braking)
+		events := deriveHarshEvents(samples, thresholds)
+		require.Len(t, events, 2)
+		require.Equal(t, derivedHarshCornering, events[0].Name)
+		require.Equal(t, t0.Add(3*time.Second), events[0].Timestamp)
+		require.Equal(t, derivedHarshBraking, events[1].Name)
+	})
+
+	t.Run("sample gap", func(t *testing.T) {
+		// Speed reported every 10 seconds.
+		sparse := []*ch.FloatSample{
+			sample(vss.FieldSpeed, 0, 0),
+			sample(vss.FieldSpeed, 10, 120),
+			sample(vss.FieldSpeed, 20, 120),
+		}
+		require.Empty(t, deriveHarshEvents(sparse, defaults))
+
+		events := deriveHarshEvents(sparse, resolveDerivedThresholds(&model.DerivedEventsConfig{MaxSampleGapSeconds: ptr(10)}))
+		require.Len(t, events, 1)
+		require.Equal(t, derivedHarshAcceleration, events[0].Name)
+		require.JSONEq(t, `{"peak": 3.33, "threshold": 3, "speedKph": 0}`, *events[0].Metadata)
+	})
+
+	t.Run("no samples", func(t *testing.T) {
+		require.Empty(t, deriveHarshEvents(nil, defaults))
+	})
+}
+
+func TestMatchesStringFilter(t *testing.T) {
+	name := derivedHarshBraking
+	tests := []struct {
+		name   string
+		filter *model.StringValueFilter
+		want   bool
+	}{
+		{"nil", nil, true},
+		{"empty", &model.StringValueFilter{}, true},
+		{"eq", &model.StringValueFilter{Eq: strRef(name)}, true},
+		{"eq other", &model.StringValueFilter{Eq: strRef("behavior.speeding")}, false},
+		{"neq", &model.StringValueFilter{Neq: strRef(name)}, false},
+		{"in", &model.StringValueFilter{In: []string{"a", name}}, true},
+		{"notIn", &model.StringValueFilter{NotIn: []string{name}}, false},
+		{"startsWith", &model.StringValueFilter{StartsWith: strRef("behavior.harsh")}, true},
+		{"all must hold", &model.StringValueFilter{StartsWith: strRef("behavior."), Neq: strRef(name)}, false},
+		{"or", &model.StringValueFilter{Eq: strRef("a"), Or: []*model.StringValueFilter{{Eq: strRef(name)}}}, true},
+		{"or without match", &model.StringValueFilter{Or: []*model.StringValueFilter{{Eq: strRef("a")}, {}}}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, matchesStringFilter(name, tt.filter))
+		})
+	}
+}
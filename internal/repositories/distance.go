@@ -0,0 +1,101 @@
+package repositories
+
+import (
+	"github.com/DIMO-Network/telemetry-api/internal/graph/model"
+	"github.com/DIMO-Network/telemetry-api/internal/service/ch"
+)
+
+const (
+	// maxDistanceHDOP is the highest HDOP of a location fix used for GPS distance; 0 means unknown and is accepted.
+	maxDistanceHDOP = 5
+	// minGPSStepMeters is the shortest move counted for GPS distance, so jitter while stopped adds nothing.
+	minGPSStepMeters = 25
+	// maxGPSSpeedMps (300 km/h) is the fastest implied speed between fixes; faster jumps are outliers.
+	maxGPSSpeedMps = 300 / 3.6
+)
+
+// segmentDistanceKm returns the distance traveled in a segment and its source: the odometer delta from
+// the segment signals if positive, otherwise the GPS path length of locations. A zero odometer delta is
+// only reported when there are no locations. Returns nil, nil if neither is available.
+func segmentDistanceKm(signals []*model.SignalAggregationValue, locations []*ch.LocationForRange) (*float64, *model.DistanceSource) {
+	first := segmentSignal(signals, sigOdoFirst)
+	last := segmentSignal(signals, sigOdoLast)
+	var odometerKm *float64
+	if first != nil && last != nil && *last >= *first {
+		delta := *last - *first
+		odometerKm = &delta
+	}
+	odometer, gps := model.DistanceSourceOdometer, model.DistanceSourceGps
+	switch {
+	case odometerKm != nil && *odometerKm > 0:
+		return odometerKm, &odometer
+	case len(locations) > 0:
+		distance := gpsDistanceKm(locations)
+		return &distance, &gps
+	case odometerKm != nil:
+		return odometerKm, &odometer
+	default:
+		return nil, nil
+	}
+}
+
+// gpsDistanceKm returns the length in km of the path through locations, which must be sorted by timestamp.
+// Fixes with HDOP above maxDistanceHDOP are skipped. Each fix is measured from the last counted one and is
+// counted only if it is at least minGPSStepMeters away at a plausible speed.
+func gpsDistanceKm(locations []*ch.LocationForRange) float64 {
+	var total float64
+	var anchor *ch.LocationForRange
+	for _, l := range locations {
+		if l.Location.HDOP > maxDistanceHDOP {
+			continue
+		}
+		if anchor == nil {
+			anchor = l
+			continue
+		}
+		d := ch.HaversineMeters(anchor.Location.Latitude, anchor.Location.Longitude, l.Location.Latitude, l.Location.Longitude)
+		if d < minGPSStepMeters {
+			continue
+		}
+		if dt := l.Timestamp.Sub(anchor.Timestamp).Seconds(); dt <= 0 || d/dt > maxGPSSpeedMps {
+			continue
+		}
+		total += d
+		anchor = l
+	}
+	return total / 1000
+}
+
+// dayDistance accumulates the distances of the segments in a day, prorated by the share of each
+// segment's duration that falls in the day.
+type dayDistance struct {
+	km      float64
+	sources map[model.DistanceSource]struct{}
+}
+
+// add counts the overlapSeconds of seg that fall in the day.
+func (d *dayDistance) add(seg *model.Segment, overlapSeconds int) {
+	if seg.DistanceKm == nil || seg.DistanceSource == nil || seg.Duration <= 0 {
+		return
+	}
+	d.km += *seg.DistanceKm * min(float64(overlapSeconds)/float64(seg.Duration), 1)
+	if d.sources == nil {
+		d.sources = make(map[model.DistanceSource]struct{}, 1)
+	}
+	d.sources[*seg.DistanceSource] = struct{}{}
+}
+
+// result returns the day's distance and source, or nil, nil if no segment had a distance.
+func (d *dayDistance) result() (*float64, *model.DistanceSource) {
+	if len(d.sources) == 0 {
+		return nil, nil
+	}
+	km := d.km
+	source := model.DistanceSourceMixed
+	if len(d.sources) == 1 {
+		for s := range d.sources {
+			source = s
+		}
+	}
+	return &km, &source
+}
@@ -0,0 +1,109 @@
+package repositories
+
+import (
+	"testing"
+
+	"github.com/DIMO-Network/model-garage/pkg/vss"
+	"github.com/DIMO-Network/telemetry-api/internal/graph/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGPSDistanceKm(t *testing.T) {
+	// 0.001 degrees of latitude is about 111 m; samples are one minute apart.
+	t.Run("straight path", func(t *testing.T) {
+		locs := rangeLocations(
+			vss.Location{Latitude: 40.000, Longitude: -74},
+			vss.Location{Latitude: 40.001, Longitude: -74},
+			vss.Location{Latitude: 40.002, Longitude: -74},
+		)
+		require.InDelta(t, 0.222, gpsDistanceKm(locs), 0.001)
+	})
+
+	t.Run("jitter while stopped adds nothing", func(t *testing.T) {
+		locs := rangeLocations(
+			vss.Location{Latitude: 40.00000, Longitude: -74},
+			vss.Location{Latitude: 40.00005, Longitude: -74},
+			vss.Location{Latitude: 39.99995, Longitude: -74.00005},
+			vss.Location{Latitude: 40.00005, Longitude: -73.99995},
+		)
+		require.Zero(t, gpsDistanceKm(locs))
+	})
+
+	t.Run("imprecise fixes and jumps are skipped", func(t *testing.T) {
+		locs := rangeLocations(
+			vss.Location{Latitude: 40.000, Longitude: -74},
+			vss.Location{Latitude: 40.050, Longitude: -74, HDOP: 20},
+			// 11 km in a minute.
+			vss.Location{Latitude: 40.100, Longitude: -74},
+			vss.Location{Latitude: 40.001, Longitude: -74},
+		)
+		require.InDelta(t, 0.111, gpsDistanceKm(locs), 0.001)
+	})
+}
+
+func TestSegmentDistanceKm(t *testing.T) {
+	signal := func(req *model.SegmentSignalRequest, value float64) *model.SignalAggregationValue {
+		return &model.SignalAggregationValue{Name: req.Name, Agg: string(req.Agg), Value: value}
+	}
+	locs := rangeLocations(
+		vss.Location{Latitude: 40.000, Longitude: -74},
+		vss.Location{Latitude: 40.001, Longitude: -74},
+	)
+
+	t.Run("odometer delta", func(t *testing.T) {
+		km, source := segmentDistanceKm([]*model.SignalAggregationValue{signal(sigOdoFirst, 1000), signal(sigOdoLast, 1012.5)}, locs)
+		require.Equal(t, floatRef(12.5), km)
+		require.Equal(t, model.DistanceSourceOdometer, *source)
+	})
+
+	t.Run("gps without odometer", func(t *testing.T) {
+		km, source := segmentDistanceKm(nil, locs)
+		require.InDelta(t, 0.111, *km, 0.001)
+		require.Equal(t, model.DistanceSourceGps, *source)
+	})
+
+	t.Run("gps when odometer did not move", func(t *testing.T) {
+		_, source := segmentDistanceKm([]*model.SignalAggregationValue{signal(sigOdoFirst, 1000), signal(sigOdoLast, 1000)}, locs)
+		require.Equal(t, model.DistanceSourceGps, *source)
+	})
+
+	t.Run("gps when odometer went back", func(t *testing.T) {
+		_, source := segmentDistanceKm([]*model.SignalAggregationValue{signal(sigOdoFirst, 1000), signal(sigOdoLast, 10)}, locs)
+		require.Equal(t, model.DistanceSourceGps, *source)
+	})
+
+	t.Run("zero odometer delta without locations", func(t *testing.T) {
+		km, source := segmentDistanceKm([]*model.SignalAggregationValue{signal(sigOdoFirst, 1000), signal(sigOdoLast, 1000)}, nil)
+		require.Equal(t, floatRef(0), km)
+		require.Equal(t, model.DistanceSourceOdometer, *source)
+	})
+
+	t.Run("no data", func(t *testing.T) {
+		km, source := segmentDistanceKm(nil, nil)
+		require.Nil(t, km)
+		require.Nil(t, source)
+	})
+}
+
+func TestDayDistance(t *testing.T) {
+	odometer, gps := model.DistanceSourceOdometer, model.DistanceSourceGps
+
+	var empty dayDistance
+	empty.add(&model.Segment{Duration: 600}, 600)
+	km, source := empty.result()
+	require.Nil(t, km)
+	require.Nil(t, source)
+
+	var day dayDistance
+	day.add(&model.Segment{Duration: 600, DistanceKm: floatRef(10), DistanceSource: &odometer}, 600)
+	// Half of this segment falls in the day.
+	day.add(&model.Segment{Duration: 1200, DistanceKm: floatRef(20), DistanceSource: &odometer}, 600)
+	km, source = day.result()
+	require.InDelta(t, 20, *km, 1e-9)
+	require.Equal(t, odometer, *source)
+
+	day.add(&model.Segment{Duration: 600, DistanceKm: floatRef(1), DistanceSource: &gps}, 600)
+	km, source = day.result()
+	require.InDelta(t, 21, *km, 1e-9)
+	require.Equal(t, model.DistanceSourceMixed, *source)
+}
@@ -0,0 +1,282 @@
+package repositories
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/DIMO-Network/model-garage/pkg/vss"
+	"github.com/DIMO-Network/telemetry-api/internal/config"
+	"github.com/DIMO-Network/telemetry-api/internal/graph/model"
+	"github.com/DIMO-Network/telemetry-api/internal/service/ch"
+)
+
+// Driver score components, as named in DRIVER_SCORE_WEIGHTS.
+const (
+	scoreHarshBraking      = "harshBraking"
+	scoreHarshAcceleration = "harshAcceleration"
+	scoreHarshCornering    = "harshCornering"
+	scoreSpeeding          = "speeding"
+	scoreNightDriving      = "nightDriving"
+)
+
+const (
+	defaultScoreSpeedLimitKph = 120
+	// scoreEventPenalty is the number of points an event component loses per
+	// event per hour of driving.
+	scoreEventPenalty = 10
+	// scoreMinDuration keeps short segments from turning one event into a
+	// huge rate.
+	scoreMinDuration = 15 * time.Minute
+	// scoreSpeedingMaxShare is the share of speed samples over the limit at
+	// which the speeding component reaches 0.
+	scoreSpeedingMaxShare = 0.2
+	// Night runs from scoreNightStart to scoreNightEnd, in hours of the day.
+	scoreNightStart = 22
+	scoreNightEnd   = 5
+)
+
+// defaultScoreWeights are the component weights when DRIVER_SCORE_WEIGHTS
+// doesn't override them.
+var defaultScoreWeights = map[string]float64{
+	scoreHarshBraking:      3,
+	scoreHarshAcceleration: 2,
+	scoreHarshCornering:    2,
+	scoreSpeeding:          3,
+	scoreNightDriving:      1,
+}
+
+// scoreEventComponents maps the event names counted by the driver score to
+// their component.
+var scoreEventComponents = map[string]string{
+	"behavior.harshBraking":      scoreHarshBraking,
+	"behavior.extremeBraking":    scoreHarshBraking,
+	"behavior.harshAcceleration": scoreHarshAcceleration,
+	"behavior.harshCornering":    scoreHarshCornering,
+}
+
+// scoredMechanisms are the detection mechanisms whose segments are driving
+// and therefore get a driver score.
+var scoredMechanisms = map[model.DetectionMechanism]struct{}{
+	model.DetectionMechanismIgnitionDetection:    {},
+	model.DetectionMechanismFrequencyAnalysis:    {},
+	model.DetectionMechanismChangePointDetection: {},
+}
+
+// driverScorer turns segment summaries into driver scores.
+type driverScorer struct {
+	weights       map[string]float64
+	speedLimitKph float64
+}
+
+// newDriverScorer creates a driver scorer from the settings.
+func newDriverScorer(settings config.Settings) (*driverScorer, error) {
+	scorer := &driverScorer{
+		weights:       make(map[string]float64, len(defaultScoreWeights)),
+		speedLimitKph: defaultScoreSpeedLimitKph,
+	}
+	for component, weight := range defaultScoreWeights {
+		scorer.weights[component] = weight
+	}
+	if settings.DriverScoreSpeedLimitKph != 0 {
+		if settings.DriverScoreSpeedLimitKph < 0 {
+			return nil, fmt.Errorf("driver score speed limit %v was negative", settings.DriverScoreSpeedLimitKph)
+		}
+		scorer.speedLimitKph = settings.DriverScoreSpeedLimitKph
+	}
+	for _, pair := range strings.Split(settings.DriverScoreWeights, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		component, value, ok := strings.Cut(pair, "=")
+		if !ok {
+			return nil, fmt.Errorf("driver score weight %q is not of the form component=weight", pair)
+		}
+		component = strings.TrimSpace(component)
+		if _, known := defaultScoreWeights[component]; !known {
+			return nil, fmt.Errorf("unknown driver score component %q", component)
+		}
+		weight, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil || weight < 0 {
+			return nil, fmt.Errorf("driver score weight for %s must be a non-negative number, got %q", component, value)
+		}
+		scorer.weights[component] = weight
+	}
+	var total float64
+	for _, weight := range scorer.weights {
+		total += weight
+	}
+	if total == 0 {
+		return nil, fmt.Errorf("driver score weights are all zero")
+	}
+	return scorer, nil
+}
+
+// scoreEventNames returns the sorted event names counted by the driver score.
+func scoreEventNames() []string {
+	names := make([]string, 0, len(scoreEventComponents))
+	for name := range scoreEventComponents {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// speedingArg returns the aggregation of the share of speed samples over the
+// speed limit. A share rather than the maximum speed keeps a single glitched
+// sample from zeroing the speeding component.
+func (s *driverScorer) speedingArg() model.FloatSignalArgs {
+	limit := s.speedLimitKph
+	return model.FloatSignalArgs{
+		Name:   vss.FieldSpeed,
+		Agg:    model.FloatAggregationShareOver,
+		Alias:  scoreSpeeding,
+		Filter: &model.SignalFloatFilter{Gt: &limit},
+	}
+}
+
+// floatAggValue returns the value of the float aggregation at index in aggs,
+// or nil if there is none.
+func floatAggValue(aggs []*ch.AggSignal, index int) *float64 {
+	for _, agg := range aggs {
+		if agg.SignalType == ch.FloatType && int(agg.SignalIndex) == index {
+			value := agg.ValueNumber
+			return &value
+		}
+	}
+	return nil
+}
+
+// segmentScore scores a segment from its event counts by name and the share
+// of its speed samples over the speed limit, nil if the segment has no speed
+// data. Without speed data the speeding component is left out. end is the end
+// of the segment, or the end of the query range if it is ongoing.
+func (s *driverScorer) segmentScore(seg *model.Segment, end time.Time, eventCounts map[string]int, speedingShare *float64) *model.DriverScore {
+	start := seg.Start.Timestamp
+	hours := max(end.Sub(start), scoreMinDuration).Hours()
+
+	components := make(map[string]float64, len(defaultScoreWeights))
+	componentEvents := make(map[string]int)
+	for name, count := range eventCounts {
+		if component, ok := scoreEventComponents[name]; ok {
+			componentEvents[component] += count
+		}
+	}
+	for _, component := range []string{scoreHarshBraking, scoreHarshAcceleration, scoreHarshCornering} {
+		components[component] = clampScore(100 - scoreEventPenalty*float64(componentEvents[component])/hours)
+	}
+
+	if speedingShare != nil {
+		components[scoreSpeeding] = clampScore(100 * (1 - *speedingShare/scoreSpeedingMaxShare))
+	}
+
+	// Solar time is close enough to local time to tell night from day,
+	// and doesn't need a time zone lookup.
+	var offset time.Duration
+	if loc := seg.Start.Value; loc != nil && (loc.Latitude != 0 || loc.Longitude != 0) {
+		offset = time.Duration(loc.Longitude / 15 * float64(time.Hour))
+	}
+	components[scoreNightDriving] = 100
+	if total := end.Sub(start); total > 0 {
+		night := nightOverlap(start.Add(offset), end.Add(offset))
+		components[scoreNightDriving] = clampScore(100 * (1 - night.Seconds()/total.Seconds()))
+	}
+	return s.newDriverScore(components)
+}
+
+// newDriverScore builds a driver score with the weighted overall score from
+// the component scores. Components missing from components are left out of
+// the overall score; nil is returned if no weighted component is left.
+func (s *driverScorer) newDriverScore(components map[string]float64) *model.DriverScore {
+	var weighted, total float64
+	for component, weight := range s.weights {
+		if score, ok := components[component]; ok {
+			weighted += weight * score
+			total += weight
+		}
+	}
+	if total == 0 {
+		return nil
+	}
+	score := &model.DriverScore{
+		Overall:           weighted / total,
+		HarshBraking:      components[scoreHarshBraking],
+		HarshAcceleration: components[scoreHarshAcceleration],
+		HarshCornering:    components[scoreHarshCornering],
+		NightDriving:      components[scoreNightDriving],
+	}
+	if speeding, ok := components[scoreSpeeding]; ok {
+		score.Speeding = &speeding
+	}
+	return score
+}
+
+// averageScore returns the average of the scores weighted by the durations in
+// seconds, or nil if there is nothing to average. Speeding is averaged over
+// the scores that have it.
+func (s *driverScorer) averageScore(scores []*model.DriverScore, durations []int) *model.DriverScore {
+	var total, speedingTotal float64
+	components := make(map[string]float64, len(defaultScoreWeights))
+	for i, score := range scores {
+		weight := float64(max(durations[i], 1))
+		total += weight
+		components[scoreHarshBraking] += weight * score.HarshBraking
+		components[scoreHarshAcceleration] += weight * score.HarshAcceleration
+		components[scoreHarshCornering] += weight * score.HarshCornering
+		components[scoreNightDriving] += weight * score.NightDriving
+		if score.Speeding != nil {
+			speedingTotal += weight
+			components[scoreSpeeding] += weight * *score.Speeding
+		}
+	}
+	if total == 0 {
+		return nil
+	}
+	for component := range components {
+		if component == scoreSpeeding {
+			components[component] /= speedingTotal
+		} else {
+			components[component] /= total
+		}
+	}
+	return s.newDriverScore(components)
+}
+
+// nightOverlap returns how much of [start, end) falls at night, reading the
+// times' clock as local time.
+func nightOverlap(start, end time.Time) time.Duration {
+	start, end = start.UTC(), end.UTC()
+	var night time.Duration
+	day := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, time.UTC).AddDate(0, 0, -1)
+	for ; day.Before(end); day = day.AddDate(0, 0, 1) {
+		nightStart := day.Add(scoreNightStart * time.Hour)
+		nightEnd := day.AddDate(0, 0, 1).Add(scoreNightEnd * time.Hour)
+		overlapStart, overlapEnd := maxTime(start, nightStart), minTime(end, nightEnd)
+		if overlapEnd.After(overlapStart) {
+			night += overlapEnd.Sub(overlapStart)
+		}
+	}
+	return night
+}
+
+func clampScore(score float64) float64 {
+	return math.Max(0, math.Min(100, score))
+}
+
+func maxTime(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
+
+func minTime(a, b time.Time) time.Time {
+	if a.Before(b) {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,140 @@
+package repositories
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DIMO-Network/telemetry-api/internal/config"
+	"github.com/DIMO-Network/telemetry-api/internal/graph/model"
+	"github.com/DIMO-Network/telemetry-api/internal/service/ch"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewDriverScorer(t *testing.T) {
+	t.Run("defaults", func(t *testing.T) {
+		scorer, err := newDriverScorer(config.Settings{})
+		require.NoError(t, err)
+		require.Equal(t, defaultScoreWeights, scorer.weights)
+		require.Equal(t, float64(defaultScoreSpeedLimitKph), scorer.speedLimitKph)
+	})
+
+	t.Run("overrides", func(t *testing.T) {
+		scorer, err := newDriverScorer(config.Settings{DriverScoreWeights: "speeding=5, nightDriving=0", DriverScoreSpeedLimitKph: 100})
+		require.NoError(t, err)
+		require.Equal(t, 5.0, scorer.weights[scoreSpeeding])
+		require.Equal(t, 0.0, scorer.weights[scoreNightDriving])
+		require.Equal(t, defaultScoreWeights[scoreHarshBraking], scorer.weights[scoreHarshBraking])
+		require.Equal(t, 100.0, scorer.speedLimitKph)
+	})
+
+	for _, weights := range []string{"speeding", "tailgating=1", "speeding=-1", "speeding=x"} {
+		_, err := newDriverScorer(config.Settings{DriverScoreWeights: weights})
+		require.Error(t, err, weights)
+	}
+	_, err := newDriverScorer(config.Settings{DriverScoreWeights: "harshBraking=0,harshAcceleration=0,harshCornering=0,speeding=0,nightDriving=0"})
+	require.Error(t, err)
+}
+
+func TestSegmentScore(t *testing.T) {
+	scorer, err := newDriverScorer(config.Settings{})
+	require.NoError(t, err)
+	start := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	seg := &model.Segment{Start: &model.SignalLocation{Timestamp: start}}
+
+	t.Run("clean drive", func(t *testing.T) {
+		score := scorer.segmentScore(seg, start.Add(time.Hour), nil, floatRef(0))
+		require.Equal(t, &model.DriverScore{Overall: 100, HarshBraking: 100, HarshAcceleration: 100, HarshCornering: 100, Speeding: floatRef(100), NightDriving: 100}, score)
+	})
+
+	t.Run("events and speeding", func(t *testing.T) {
+		counts := map[string]int{
+			"behavior.harshBraking":   2,
+			"behavior.extremeBraking": 1,
+			"behavior.harshCornering": 1,
+			"safety.collision":        1,
+		}
+		score := scorer.segmentScore(seg, start.Add(30*time.Minute), counts, floatRef(0.1))
+		require.InDelta(t, 40, score.HarshBraking, 1e-9)
+		require.InDelta(t, 100, score.HarshAcceleration, 1e-9)
+		require.InDelta(t, 80, score.HarshCornering, 1e-9)
+		require.InDelta(t, 50, *score.Speeding, 1e-9)
+		require.InDelta(t, (3*40+2*100+2*80+3*50+1*100)/11.0, score.Overall, 1e-9)
+	})
+
+	t.Run("short segments use the minimum duration", func(t *testing.T) {
+		score := scorer.segmentScore(seg, start.Add(time.Minute), map[string]int{"behavior.harshAcceleration": 1}, floatRef(0))
+		require.InDelta(t, 60, score.HarshAcceleration, 1e-9)
+	})
+
+	t.Run("one glitched speed sample", func(t *testing.T) {
+		// One sample over the limit out of a thousand.
+		score := scorer.segmentScore(seg, start.Add(time.Hour), nil, floatRef(0.001))
+		require.InDelta(t, 99.5, *score.Speeding, 1e-9)
+	})
+
+	t.Run("no speed data", func(t *testing.T) {
+		score := scorer.segmentScore(seg, start.Add(time.Hour), map[string]int{"behavior.harshBraking": 1}, nil)
+		require.Nil(t, score.Speeding)
+		require.InDelta(t, 90, score.HarshBraking, 1e-9)
+		require.InDelta(t, (3*90+2*100+2*100+1*100)/8.0, score.Overall, 1e-9)
+	})
+
+	t.Run("night in solar time", func(t *testing.T) {
+		// 12:00 UTC is 23:00 at longitude 165.
+		night := &model.Segment{Start: &model.SignalLocation{Timestamp: start, Value: &model.Location{Latitude: -40, Longitude: 165}}}
+		score := scorer.segmentScore(night, start.Add(time.Hour), nil, nil)
+		require.InDelta(t, 0, score.NightDriving, 1e-9)
+	})
+}
+
+func TestNightOverlap(t *testing.T) {
+	day := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	require.Equal(t, time.Duration(0), nightOverlap(day.Add(8*time.Hour), day.Add(20*time.Hour)))
+	require.Equal(t, 2*time.Hour, nightOverlap(day.Add(3*time.Hour), day.Add(8*time.Hour)))
+	require.Equal(t, 7*time.Hour, nightOverlap(day.Add(21*time.Hour), day.Add(30*time.Hour)))
+	require.Equal(t, 14*time.Hour, nightOverlap(day.Add(20*time.Hour), day.Add(54*time.Hour)))
+}
+
+func TestAverageScore(t *testing.T) {
+	scorer, err := newDriverScorer(config.Settings{})
+	require.NoError(t, err)
+	require.Nil(t, scorer.averageScore(nil, nil))
+
+	scores := []*model.DriverScore{
+		{HarshBraking: 100, HarshAcceleration: 100, HarshCornering: 100, Speeding: floatRef(100), NightDriving: 100},
+		{HarshBraking: 40, HarshAcceleration: 100, HarshCornering: 100, Speeding: floatRef(100), NightDriving: 100},
+	}
+	avg := scorer.averageScore(scores, []int{3600, 1200})
+	require.InDelta(t, 85, avg.HarshBraking, 1e-9)
+	require.InDelta(t, 100, *avg.Speeding, 1e-9)
+	require.InDelta(t, (3*85+8*100)/11.0, avg.Overall, 1e-9)
+
+	t.Run("speeding only from scores with speed data", func(t *testing.T) {
+		scores := []*model.DriverScore{
+			{HarshBraking: 100, HarshAcceleration: 100, HarshCornering: 100, Speeding: floatRef(50), NightDriving: 100},
+			{HarshBraking: 100, HarshAcceleration: 100, HarshCornering: 100, NightDriving: 100},
+		}
+		avg := scorer.averageScore(scores, []int{1200, 3600})
+		require.InDelta(t, 50, *avg.Speeding, 1e-9)
+
+		avg = scorer.averageScore(scores[1:], []int{3600})
+		require.Nil(t, avg.Speeding)
+		require.InDelta(t, 100, avg.Overall, 1e-9)
+	})
+}
+
+func TestFloatAggValue(t *testing.T) {
+	aggs := []*ch.AggSignal{
+		{SignalType: ch.FloatType, SignalIndex: 0, ValueNumber: 130},
+		{SignalType: ch.LocType, SignalIndex: 1},
+		{SignalType: ch.FloatType, SignalIndex: 1, ValueNumber: 0.25},
+	}
+	require.Equal(t, floatRef(0.25), floatAggValue(aggs, 1))
+	require.Nil(t, floatAggValue(aggs, 2))
+
+	scorer, err := newDriverScorer(config.Settings{DriverScoreSpeedLimitKph: 100})
+	require.NoError(t, err)
+	arg := scorer.speedingArg()
+	require.Equal(t, model.FloatAggregationShareOver, arg.Agg)
+	require.Equal(t, floatRef(100), arg.Filter.Gt)
+}
@@ -0,0 +1,104 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/DIMO-Network/server-garage/pkg/gql/errorhandler"
+	"github.com/DIMO-Network/telemetry-api/internal/graph/model"
+	"github.com/DIMO-Network/telemetry-api/internal/service/ch"
+	"github.com/rs/zerolog"
+)
+
+// defaultEventLookback is how far back each poll looks so that events stored
+// after a later event, or with a slightly skewed clock, are still delivered.
+const defaultEventLookback = 10 * time.Minute
+
+// eventKey identifies an event that was already delivered to a subscriber.
+type eventKey struct {
+	name       string
+	source     string
+	timestamp  time.Time
+	durationNs int
+}
+
+func newEventKey(event *ch.Event) eventKey {
+	return eventKey{
+		name:       event.Data.Name,
+		source:     event.Source,
+		timestamp:  event.Data.Timestamp,
+		durationNs: int(event.Data.DurationNs),
+	}
+}
+
+// SubscribeEvents streams events for the given tokenID that match the filter
+// and are stored after the subscription starts. Each event is sent once.
+// Events are stored without an ingestion time, so an event stored late is
+// delivered only if its timestamp is within the event lookback of the poll.
+func (r *Repository) SubscribeEvents(ctx context.Context, tokenID int, filter *model.EventFilter, precision LocationPrecision) (<-chan *model.Event, error) {
+	if err := validateEventSubscriptionArgs(tokenID, filter); err != nil {
+		return nil, errorhandler.NewBadRequestError(ctx, err)
+	}
+	subject := r.toSubject(uint32(tokenID))
+	opts := ch.EventQueryOptions{WithLocation: precision != LocationHidden}
+	lookback := r.eventLookback
+
+	// Events already stored when the subscription starts are not delivered.
+	start := time.Now().UTC()
+	stored, err := r.chService.GetEvents(ctx, subject, start.Add(-lookback), start.Add(lookback), filter, opts)
+	if err != nil {
+		return nil, handleDBError(ctx, err)
+	}
+	seen := make(map[eventKey]struct{}, len(stored))
+	for _, event := range stored {
+		seen[newEventKey(event)] = struct{}{}
+	}
+
+	out := make(chan *model.Event, 1)
+	go func() {
+		defer close(out)
+		ticker := time.NewTicker(r.pollInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+
+			now := time.Now().UTC()
+			from := now.Add(-lookback)
+			pollCtx, cancel := context.WithTimeout(ctx, subscriptionPollTimeout)
+			events, err := r.chService.GetEvents(pollCtx, subject, from, now.Add(lookback), filter, opts)
+			cancel()
+			if err != nil {
+				if ctx.Err() == nil {
+					zerolog.Ctx(ctx).Error().Err(err).Str("subject", subject).Msg("failed to poll events")
+				}
+				continue
+			}
+
+			for key := range seen {
+				if key.timestamp.Before(from) {
+					delete(seen, key)
+				}
+			}
+			// GetEvents returns the newest first; deliver in stored order.
+			for i := len(events) - 1; i >= 0; i-- {
+				event := events[i]
+				key := newEventKey(event)
+				if _, ok := seen[key]; ok {
+					continue
+				}
+				seen[key] = struct{}{}
+				select {
+				case <-ctx.Done():
+					return
+				case out <- eventToModel(event, precision):
+				}
+			}
+		}
+	}()
+	return out, nil
+}
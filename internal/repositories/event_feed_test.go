@@ -0,0 +1,91 @@
+package repositories_test
+
+import (
+	"context"
+	"math/big"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/DIMO-Network/cloudevent"
+	"github.com/DIMO-Network/model-garage/pkg/vss"
+	"github.com/DIMO-Network/telemetry-api/internal/graph/model"
+	"github.com/DIMO-Network/telemetry-api/internal/repositories"
+	"github.com/DIMO-Network/telemetry-api/internal/service/ch"
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+)
+
+func TestSubscribeEvents(t *testing.T) {
+	testSubject := cloudevent.ERC721DID{
+		ChainID:         baseSettings.ChainID,
+		ContractAddress: baseSettings.VehicleNFTAddress,
+		TokenID:         big.NewInt(1),
+	}.String()
+	// Timestamps fall inside the polled window.
+	now := time.Now().UTC()
+	event := func(name string, at time.Time) *ch.Event {
+		return &ch.Event{Event: vss.Event{CloudEventHeader: cloudevent.CloudEventHeader{Source: "0xsource"}, Data: vss.EventData{Name: name, Timestamp: at}}}
+	}
+	stored := event("behavior.harshAcceleration", now.Add(-40*time.Second))
+	braking := event("behavior.harshBraking", now.Add(time.Second))
+	// Stored late, with a timestamp before the subscription started.
+	late := event("behavior.harshCornering", now.Add(-30*time.Second))
+	crash := event("safety.collision", now.Add(2*time.Second))
+	filter := &model.EventFilter{Source: &model.StringValueFilter{Eq: ref("0xsource")}}
+
+	// The first call finds the events stored before the subscription starts. Each
+	// poll returns the next result, newest first; the last one repeats.
+	polls := [][]*ch.Event{
+		{stored},
+		{braking, stored},
+		{crash, braking, late, stored},
+	}
+	var calls atomic.Int32
+
+	mocks := setupMocks(t)
+	mocks.CHService.EXPECT().
+		GetEvents(gomock.Any(), testSubject, gomock.Any(), gomock.Any(), filter, ch.EventQueryOptions{}).
+		DoAndReturn(func(context.Context, string, time.Time, time.Time, *model.EventFilter, ch.EventQueryOptions) ([]*ch.Event, error) {
+			i := min(int(calls.Add(1))-1, len(polls)-1)
+			return polls[i], nil
+		}).
+		AnyTimes()
+
+	settings := baseSettings
+	settings.SubscriptionPollInterval = "10ms"
+	repo, err := repositories.NewRepository(mocks.CHService, settings)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	events, err := repo.SubscribeEvents(ctx, 1, filter, repositories.LocationHidden)
+	require.NoError(t, err)
+
+	// Each event stored after the subscription starts is delivered exactly once.
+	for _, want := range []*ch.Event{braking, late, crash} {
+		select {
+		case got := <-events:
+			require.Equal(t, want.Data.Name, got.Name)
+			require.Equal(t, want.Data.Timestamp, got.Timestamp)
+		case <-time.After(time.Second):
+			t.Fatalf("timed out waiting for %s", want.Data.Name)
+		}
+	}
+	select {
+	case got := <-events:
+		t.Fatalf("unexpected event %+v", got)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestSubscribeEventsInvalidArgs(t *testing.T) {
+	mocks := setupMocks(t)
+	repo, err := repositories.NewRepository(mocks.CHService, baseSettings)
+	require.NoError(t, err)
+
+	_, err = repo.SubscribeEvents(context.Background(), 1, &model.EventFilter{
+		Name: &model.StringValueFilter{Eq: ref("harshBraking")},
+	}, repositories.LocationHidden)
+	require.Error(t, err)
+}
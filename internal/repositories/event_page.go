@@ -0,0 +1,89 @@
+package repositories
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/DIMO-Network/server-garage/pkg/gql/errorhandler"
+	"github.com/DIMO-Network/telemetry-api/internal/graph/model"
+	"github.com/DIMO-Network/telemetry-api/internal/service/ch"
+)
+
+const (
+	defaultEventLimit = 100
+	maxEventLimit     = 1000
+)
+
+// eventLimit returns the requested number of events, or defaultLimit if limit
+// is nil.
+func eventLimit(limit *int, defaultLimit int) (int, error) {
+	if limit == nil {
+		return defaultLimit, nil
+	}
+	if *limit < 1 || *limit > maxEventLimit {
+		return 0, ValidationError(fmt.Sprintf("limit must be between 1 and %d", maxEventLimit))
+	}
+	return *limit, nil
+}
+
+// GetEventsPage returns one page of events for the given tokenID, ordered by
+// timestamp ascending. after is an exclusive timestamp cursor, the nextCursor
+// of the previous page. Pages end on a timestamp boundary, so events sharing a
+// timestamp are never split across pages and none are skipped.
+func (r *Repository) GetEventsPage(ctx context.Context, tokenID int, from, to time.Time, filter *model.EventFilter, limit *int, after *time.Time, precision LocationPrecision) (*model.EventPage, error) {
+	if err := validateEventArgs(tokenID, from, to, filter); err != nil {
+		return nil, errorhandler.NewBadRequestError(ctx, err)
+	}
+	pageSize, err := eventLimit(limit, defaultEventLimit)
+	if err != nil {
+		return nil, errorhandler.NewBadRequestError(ctx, err)
+	}
+	// One extra event tells whether there is another page.
+	opts := ch.EventQueryOptions{
+		Ascending:    true,
+		Limit:        pageSize + 1,
+		After:        after,
+		WithLocation: precision != LocationHidden,
+	}
+	if after != nil && after.After(from) {
+		from = *after
+	}
+
+	page := &model.EventPage{Events: []*model.Event{}}
+	if !from.Before(to) {
+		return page, nil
+	}
+	subject := r.toSubject(uint32(tokenID))
+	events, err := r.chService.GetEvents(ctx, subject, from, to, filter, opts)
+	if err != nil {
+		return nil, handleDBError(ctx, err)
+	}
+	if len(events) > pageSize {
+		// The query returns every event sharing the timestamp of the extra one,
+		// so the page ends before that timestamp, unless it holds the whole page.
+		last := events[len(events)-1].Data.Timestamp
+		end := 0
+		for end < len(events) && events[end].Data.Timestamp.Before(last) {
+			end++
+		}
+		if end > 0 {
+			page.HasMore = true
+			events = events[:end]
+		} else {
+			more, err := r.chService.GetEvents(ctx, subject, from, to, filter, ch.EventQueryOptions{Ascending: true, Limit: 1, After: &last})
+			if err != nil {
+				return nil, handleDBError(ctx, err)
+			}
+			page.HasMore = len(more) > 0
+		}
+	}
+	for _, event := range events {
+		page.Events = append(page.Events, eventToModel(event, precision))
+	}
+	if page.HasMore {
+		cursor := page.Events[len(page.Events)-1].Timestamp
+		page.NextCursor = &cursor
+	}
+	return page, nil
+}
@@ -0,0 +1,109 @@
+package repositories_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/DIMO-Network/cloudevent"
+	"github.com/DIMO-Network/model-garage/pkg/vss"
+	"github.com/DIMO-Network/telemetry-api/internal/repositories"
+	"github.com/DIMO-Network/telemetry-api/internal/service/ch"
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+)
+
+func TestGetEventsPage(t *testing.T) {
+	subject := cloudevent.ERC721DID{
+		ChainID:         baseSettings.ChainID,
+		ContractAddress: baseSettings.VehicleNFTAddress,
+		TokenID:         big.NewInt(1),
+	}.String()
+	from := time.Date(2024, 6, 12, 0, 0, 0, 0, time.UTC)
+	to := from.Add(24 * time.Hour)
+	event := func(offset time.Duration) *ch.Event {
+		return &ch.Event{Event: vss.Event{Data: vss.EventData{Name: "behavior.harshBraking", Timestamp: from.Add(offset)}}}
+	}
+
+	t.Run("last page", func(t *testing.T) {
+		mocks := setupMocks(t)
+		mocks.CHService.EXPECT().
+			GetEvents(gomock.Any(), subject, from, to, nil, ch.EventQueryOptions{Ascending: true, Limit: 3}).
+			Return([]*ch.Event{event(time.Minute), event(2 * time.Minute)}, nil)
+		repo, err := repositories.NewRepository(mocks.CHService, baseSettings)
+		require.NoError(t, err)
+
+		page, err := repo.GetEventsPage(context.Background(), 1, from, to, nil, ref(2), nil, repositories.LocationHidden)
+		require.NoError(t, err)
+		require.Len(t, page.Events, 2)
+		require.False(t, page.HasMore)
+		require.Nil(t, page.NextCursor)
+	})
+
+	t.Run("pages end on a timestamp boundary", func(t *testing.T) {
+		named := func(name string, offset time.Duration) *ch.Event {
+			return &ch.Event{Event: vss.Event{Data: vss.EventData{Name: "behavior." + name, Timestamp: from.Add(offset)}}}
+		}
+		first, second := from.Add(time.Minute), from.Add(2*time.Minute)
+		mocks := setupMocks(t)
+		gomock.InOrder(
+			// The extra event shares the timestamp of the last one, so both are left to the next page.
+			mocks.CHService.EXPECT().
+				GetEvents(gomock.Any(), subject, from, to, nil, ch.EventQueryOptions{Ascending: true, Limit: 3}).
+				Return([]*ch.Event{named("a", time.Minute), named("b", 2*time.Minute), named("c", 2*time.Minute)}, nil),
+			mocks.CHService.EXPECT().
+				GetEvents(gomock.Any(), subject, first, to, nil, ch.EventQueryOptions{Ascending: true, Limit: 3, After: &first}).
+				Return([]*ch.Event{named("b", 2*time.Minute), named("c", 2*time.Minute), named("d", 3*time.Minute)}, nil),
+			mocks.CHService.EXPECT().
+				GetEvents(gomock.Any(), subject, second, to, nil, ch.EventQueryOptions{Ascending: true, Limit: 3, After: &second}).
+				Return([]*ch.Event{named("d", 3*time.Minute)}, nil),
+		)
+		repo, err := repositories.NewRepository(mocks.CHService, baseSettings)
+		require.NoError(t, err)
+
+		var names []string
+		var after *time.Time
+		for range 3 {
+			page, err := repo.GetEventsPage(context.Background(), 1, from, to, nil, ref(2), after, repositories.LocationHidden)
+			require.NoError(t, err)
+			for _, event := range page.Events {
+				names = append(names, event.Name)
+			}
+			after = page.NextCursor
+		}
+		require.Nil(t, after)
+		require.Equal(t, []string{"behavior.a", "behavior.b", "behavior.c", "behavior.d"}, names)
+	})
+
+	t.Run("timestamp holding more than a page", func(t *testing.T) {
+		at := from.Add(time.Minute)
+		same := []*ch.Event{event(time.Minute), event(time.Minute), event(time.Minute), event(time.Minute)}
+		mocks := setupMocks(t)
+		gomock.InOrder(
+			mocks.CHService.EXPECT().
+				GetEvents(gomock.Any(), subject, from, to, nil, ch.EventQueryOptions{Ascending: true, Limit: 3}).
+				Return(same, nil),
+			mocks.CHService.EXPECT().
+				GetEvents(gomock.Any(), subject, from, to, nil, ch.EventQueryOptions{Ascending: true, Limit: 1, After: &at}).
+				Return([]*ch.Event{event(2 * time.Minute)}, nil),
+		)
+		repo, err := repositories.NewRepository(mocks.CHService, baseSettings)
+		require.NoError(t, err)
+
+		page, err := repo.GetEventsPage(context.Background(), 1, from, to, nil, ref(2), nil, repositories.LocationHidden)
+		require.NoError(t, err)
+		require.Len(t, page.Events, 4)
+		require.True(t, page.HasMore)
+		require.Equal(t, &at, page.NextCursor)
+	})
+
+	t.Run("limit above maximum", func(t *testing.T) {
+		mocks := setupMocks(t)
+		repo, err := repositories.NewRepository(mocks.CHService, baseSettings)
+		require.NoError(t, err)
+
+		_, err = repo.GetEventsPage(context.Background(), 1, from, to, nil, ref(1001), nil, repositories.LocationHidden)
+		require.Error(t, err)
+	})
+}
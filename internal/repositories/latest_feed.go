@@ -0,0 +1,249 @@
+package repositories
+
+import (
+	"context"
+	"maps"
+	"sync"
+	"time"
+
+	"github.com/DIMO-Network/model-garage/pkg/vss"
+	"github.com/DIMO-Network/server-garage/pkg/gql/errorhandler"
+	"github.com/DIMO-Network/telemetry-api/internal/graph/model"
+	"github.com/rs/zerolog"
+)
+
+const (
+	defaultSubscriptionPollInterval = 5 * time.Second
+	subscriptionPollTimeout         = 10 * time.Second
+)
+
+// latestSnapshot is the full set of latest signals for a vehicle, keyed by
+// signal name. The last seen timestamp is stored under model.LastSeenField.
+type latestSnapshot map[string]*vss.Signal
+
+// latestFeedKey identifies a shared poller. Subscribers with the same vehicle
+// and source filter read from the same feed.
+type latestFeedKey struct {
+	subject string
+	source  string
+}
+
+// latestFeed polls signal_latest for one vehicle and fans the snapshots out
+// to every subscriber of that vehicle.
+type latestFeed struct {
+	key    latestFeedKey
+	filter *model.SignalFilter
+	subs   map[chan latestSnapshot]struct{}
+	last   latestSnapshot
+	cancel context.CancelFunc
+}
+
+// latestHub owns the running feeds. A feed starts with its first subscriber
+// and stops when its last subscriber leaves.
+type latestHub struct {
+	mu        sync.Mutex
+	feeds     map[latestFeedKey]*latestFeed
+	interval  time.Duration
+	chService CHService
+	pollArgs  model.LatestSignalsArgs
+}
+
+func newLatestHub(chService CHService, queryableSignals map[string]struct{}, interval time.Duration) *latestHub {
+	signalNames := make(map[string]struct{}, len(queryableSignals))
+	for name := range queryableSignals {
+		if name != vss.FieldCurrentLocationCoordinates {
+			signalNames[name] = struct{}{}
+		}
+	}
+	return &latestHub{
+		feeds:     make(map[latestFeedKey]*latestFeed),
+		interval:  interval,
+		chService: chService,
+		pollArgs: model.LatestSignalsArgs{
+			SignalNames:         signalNames,
+			LocationSignalNames: map[string]struct{}{vss.FieldCurrentLocationCoordinates: {}},
+			IncludeLastSeen:     true,
+		},
+	}
+}
+
+// subscribe registers a new subscriber for the vehicle, starting the feed if
+// needed. The returned function must be called to unsubscribe.
+func (h *latestHub) subscribe(ctx context.Context, subject string, filter *model.SignalFilter) (<-chan latestSnapshot, func()) {
+	key := latestFeedKey{subject: subject}
+	if filter != nil && filter.Source != nil {
+		key.source = *filter.Source
+	}
+
+	updates := make(chan latestSnapshot, 1)
+
+	h.mu.Lock()
+	feed, ok := h.feeds[key]
+	if !ok {
+		logger := zerolog.Ctx(ctx).With().Str("subject", subject).Logger()
+		feedCtx, cancel := context.WithCancel(logger.WithContext(context.Background()))
+		feed = &latestFeed{
+			key:    key,
+			filter: filter,
+			subs:   make(map[chan latestSnapshot]struct{}),
+			cancel: cancel,
+		}
+		h.feeds[key] = feed
+		go h.run(feedCtx, feed)
+	}
+	feed.subs[updates] = struct{}{}
+	if feed.last != nil {
+		updates <- feed.last
+	}
+	h.mu.Unlock()
+
+	return updates, func() {
+		h.mu.Lock()
+		defer h.mu.Unlock()
+		delete(feed.subs, updates)
+		if len(feed.subs) == 0 {
+			feed.cancel()
+			delete(h.feeds, key)
+		}
+	}
+}
+
+// run polls the feed until it is cancelled.
+func (h *latestHub) run(ctx context.Context, feed *latestFeed) {
+	ticker := time.NewTicker(h.interval)
+	defer ticker.Stop()
+	for {
+		h.poll(ctx, feed)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// poll queries the latest signals once and broadcasts the snapshot if any
+// timestamp changed since the previous poll.
+func (h *latestHub) poll(ctx context.Context, feed *latestFeed) {
+	pollCtx, cancel := context.WithTimeout(ctx, subscriptionPollTimeout)
+	defer cancel()
+
+	args := h.pollArgs
+	args.Filter = feed.filter
+	signals, err := h.chService.GetLatestSignals(pollCtx, feed.key.subject, &args)
+	if err != nil {
+		if ctx.Err() == nil {
+			zerolog.Ctx(ctx).Error().Err(err).Msg("failed to poll latest signals")
+		}
+		return
+	}
+	snapshot := make(latestSnapshot, len(signals))
+	for _, signal := range signals {
+		snapshot[signal.Data.Name] = signal
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if feed.last != nil && maps.EqualFunc(feed.last, snapshot, func(a, b *vss.Signal) bool {
+		return a.Data.Timestamp.Equal(b.Data.Timestamp)
+	}) {
+		return
+	}
+	feed.last = snapshot
+	for sub := range feed.subs {
+		// Subscribers only need the newest snapshot, so replace a pending one
+		// instead of blocking the feed on a slow reader.
+		select {
+		case sub <- snapshot:
+		default:
+			select {
+			case <-sub:
+			default:
+			}
+			sub <- snapshot
+		}
+	}
+}
+
+// SubscribeSignalLatest streams the latest signals for the given tokenID and
+// filter. The first message contains every requested signal; later messages
+// contain only the requested signals whose timestamp advanced.
+func (r *Repository) SubscribeSignalLatest(ctx context.Context, latestArgs *model.LatestSignalsArgs) (<-chan *model.SignalCollection, error) {
+	if err := validateLatestSigArgs(latestArgs); err != nil {
+		return nil, errorhandler.NewBadRequestError(ctx, err)
+	}
+	subject := r.toSubject(latestArgs.TokenID)
+	updates, unsubscribe := r.latestHub.subscribe(ctx, subject, latestArgs.Filter)
+
+	out := make(chan *model.SignalCollection, 1)
+	go func() {
+		defer close(out)
+		defer unsubscribe()
+
+		var sent map[string]time.Time
+		for {
+			var snapshot latestSnapshot
+			select {
+			case <-ctx.Done():
+				return
+			case snapshot = <-updates:
+			}
+
+			coll, changed := latestCollectionFromSnapshot(snapshot, latestArgs, sent)
+			if sent != nil && !changed {
+				continue
+			}
+			if sent == nil {
+				sent = make(map[string]time.Time)
+			}
+			recordSentTimestamps(snapshot, latestArgs, sent)
+
+			select {
+			case <-ctx.Done():
+				return
+			case out <- coll:
+			}
+		}
+	}()
+	return out, nil
+}
+
+// latestCollectionFromSnapshot builds a collection holding the requested
+// signals whose timestamp is newer than the one last sent. A nil sent map
+// selects every requested signal.
+func latestCollectionFromSnapshot(snapshot latestSnapshot, latestArgs *model.LatestSignalsArgs, sent map[string]time.Time) (*model.SignalCollection, bool) {
+	coll := &model.SignalCollection{}
+	changed := false
+	for _, names := range []map[string]struct{}{latestArgs.SignalNames, latestArgs.LocationSignalNames} {
+		for name := range names {
+			signal, ok := snapshot[name]
+			if !ok || !signal.Data.Timestamp.After(sent[name]) {
+				continue
+			}
+			model.SetCollectionField(coll, signal)
+			changed = true
+		}
+	}
+	if latestArgs.IncludeLastSeen {
+		// ClickHouse returns the Unix epoch for max(timestamp) if there are no rows.
+		if signal, ok := snapshot[model.LastSeenField]; ok && !signal.Data.Timestamp.Equal(unixEpoch) && signal.Data.Timestamp.After(sent[model.LastSeenField]) {
+			coll.LastSeen = &signal.Data.Timestamp
+			changed = true
+		}
+	}
+	setApproximateLocationInCollection(coll)
+	return coll, changed
+}
+
+func recordSentTimestamps(snapshot latestSnapshot, latestArgs *model.LatestSignalsArgs, sent map[string]time.Time) {
+	for _, names := range []map[string]struct{}{latestArgs.SignalNames, latestArgs.LocationSignalNames} {
+		for name := range names {
+			if signal, ok := snapshot[name]; ok {
+				sent[name] = signal.Data.Timestamp
+			}
+		}
+	}
+	if signal, ok := snapshot[model.LastSeenField]; ok {
+		sent[model.LastSeenField] = signal.Data.Timestamp
+	}
+}
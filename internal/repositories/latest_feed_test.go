@@ -0,0 +1,118 @@
+package repositories_test
+
+import (
+	"context"
+	"math/big"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/DIMO-Network/cloudevent"
+	"github.com/DIMO-Network/model-garage/pkg/vss"
+	"github.com/DIMO-Network/telemetry-api/internal/graph/model"
+	"github.com/DIMO-Network/telemetry-api/internal/repositories"
+	"github.com/stretchr/testify/require"
+	gomock "go.uber.org/mock/gomock"
+)
+
+func TestSubscribeSignalLatest(t *testing.T) {
+	testSubject := cloudevent.ERC721DID{
+		ChainID:         baseSettings.ChainID,
+		ContractAddress: baseSettings.VehicleNFTAddress,
+		TokenID:         big.NewInt(1),
+	}.String()
+	t0 := time.Date(2024, 6, 11, 0, 0, 0, 0, time.UTC)
+	t1 := t0.Add(time.Minute)
+
+	// Each poll returns the next snapshot; the last one repeats.
+	snapshots := [][]*vss.Signal{
+		{
+			{Data: vss.SignalData{Timestamp: t0, Name: vss.FieldSpeed, ValueNumber: 10}},
+			{Data: vss.SignalData{Timestamp: t0, Name: vss.FieldPowertrainTransmissionTravelledDistance, ValueNumber: 100}},
+			{Data: vss.SignalData{Timestamp: t0, Name: model.LastSeenField}},
+		},
+		{
+			{Data: vss.SignalData{Timestamp: t1, Name: vss.FieldSpeed, ValueNumber: 20}},
+			{Data: vss.SignalData{Timestamp: t0, Name: vss.FieldPowertrainTransmissionTravelledDistance, ValueNumber: 100}},
+			{Data: vss.SignalData{Timestamp: t1, Name: model.LastSeenField}},
+		},
+	}
+	var polls atomic.Int32
+
+	mocks := setupMocks(t)
+	mocks.CHService.EXPECT().
+		GetLatestSignals(gomock.Any(), testSubject, gomock.Any()).
+		DoAndReturn(func(context.Context, string, *model.LatestSignalsArgs) ([]*vss.Signal, error) {
+			i := min(int(polls.Add(1))-1, len(snapshots)-1)
+			return snapshots[i], nil
+		}).
+		AnyTimes()
+
+	settings := baseSettings
+	settings.SubscriptionPollInterval = "10ms"
+	repo, err := repositories.NewRepository(mocks.CHService, settings)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	updates, err := repo.SubscribeSignalLatest(ctx, &model.LatestSignalsArgs{
+		SignalArgs: model.SignalArgs{TokenID: 1},
+		SignalNames: map[string]struct{}{
+			vss.FieldSpeed: {},
+			vss.FieldPowertrainTransmissionTravelledDistance: {},
+		},
+		LocationSignalNames: map[string]struct{}{},
+		IncludeLastSeen:     true,
+	})
+	require.NoError(t, err)
+
+	first := receive(t, updates)
+	require.Equal(t, &model.SignalCollection{
+		LastSeen:                                &t0,
+		Speed:                                   &model.SignalFloat{Timestamp: t0, Value: 10},
+		PowertrainTransmissionTravelledDistance: &model.SignalFloat{Timestamp: t0, Value: 100},
+	}, first)
+
+	// Only the signals whose timestamp advanced are sent.
+	second := receive(t, updates)
+	require.Equal(t, &model.SignalCollection{
+		LastSeen: &t1,
+		Speed:    &model.SignalFloat{Timestamp: t1, Value: 20},
+	}, second)
+
+	// Nothing changes after the second snapshot.
+	select {
+	case coll := <-updates:
+		t.Fatalf("unexpected update %+v", coll)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	cancel()
+	select {
+	case _, ok := <-updates:
+		require.False(t, ok)
+	case <-time.After(time.Second):
+		t.Fatal("subscription was not closed after cancellation")
+	}
+}
+
+func TestSubscribeSignalLatestInvalidArgs(t *testing.T) {
+	mocks := setupMocks(t)
+	repo, err := repositories.NewRepository(mocks.CHService, baseSettings)
+	require.NoError(t, err)
+
+	_, err = repo.SubscribeSignalLatest(context.Background(), nil)
+	require.Error(t, err)
+}
+
+func receive(t *testing.T, updates <-chan *model.SignalCollection) *model.SignalCollection {
+	t.Helper()
+	select {
+	case coll, ok := <-updates:
+		require.True(t, ok)
+		return coll
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for update")
+		return nil
+	}
+}
@@ -0,0 +1,63 @@
+package repositories
+
+import (
+	"github.com/DIMO-Network/model-garage/pkg/vss"
+	"github.com/DIMO-Network/telemetry-api/internal/graph/model"
+)
+
+// Default signal requests that addRefuelVolume and addFuelDropVolume read when no tank capacity is given.
+var (
+	sigFuelAbsFirst = &model.SegmentSignalRequest{Name: vss.FieldPowertrainFuelSystemAbsoluteLevel, Agg: model.FloatAggregationFirst}
+	sigFuelAbsLast  = &model.SegmentSignalRequest{Name: vss.FieldPowertrainFuelSystemAbsoluteLevel, Agg: model.FloatAggregationLast}
+)
+
+// addRefuelVolume fills in the tank capacity, liters added and estimated cost of a refuel segment
+// whose trough and peak levels were set by the detector. capacityLiters overrides the absolute
+// fuel level signal; without pricePerLiter there is no cost.
+func addRefuelVolume(seg *model.Segment, capacityLiters, pricePerLiter *float64) {
+	details := seg.Refuel
+	if details == nil {
+		return
+	}
+	rise := max(details.PeakLevel-details.TroughLevel, 0)
+	if capacityLiters != nil {
+		liters := rise / 100 * *capacityLiters
+		details.TankCapacityLiters = capacityLiters
+		details.LitersAdded = &liters
+	} else {
+		absFirst := segmentSignal(seg.Signals, sigFuelAbsFirst)
+		absLast := segmentSignal(seg.Signals, sigFuelAbsLast)
+		if absFirst != nil && absLast != nil {
+			liters := max(*absLast-*absFirst, 0)
+			details.LitersAdded = &liters
+		}
+		if absLast != nil && details.PeakLevel > 0 {
+			capacity := *absLast / details.PeakLevel * 100
+			details.TankCapacityLiters = &capacity
+		}
+	}
+	if details.LitersAdded != nil && pricePerLiter != nil {
+		cost := *details.LitersAdded * *pricePerLiter
+		details.EstimatedCost = &cost
+	}
+}
+
+// addFuelDropVolume fills in the liters lost of a fuel drop segment whose start and end levels were
+// set by the detector. capacityLiters overrides the absolute fuel level signal.
+func addFuelDropVolume(seg *model.Segment, capacityLiters *float64) {
+	details := seg.FuelDrop
+	if details == nil {
+		return
+	}
+	if capacityLiters != nil {
+		liters := max(details.StartLevel-details.EndLevel, 0) / 100 * *capacityLiters
+		details.LitersLost = &liters
+		return
+	}
+	absFirst := segmentSignal(seg.Signals, sigFuelAbsFirst)
+	absLast := segmentSignal(seg.Signals, sigFuelAbsLast)
+	if absFirst != nil && absLast != nil {
+		liters := max(*absFirst-*absLast, 0)
+		details.LitersLost = &liters
+	}
+}
@@ -0,0 +1,72 @@
+package repositories
+
+import (
+	"testing"
+
+	"github.com/DIMO-Network/telemetry-api/internal/graph/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddRefuelVolume(t *testing.T) {
+	signal := func(req *model.SegmentSignalRequest, value float64) *model.SignalAggregationValue {
+		return &model.SignalAggregationValue{Name: req.Name, Agg: string(req.Agg), Value: value}
+	}
+
+	t.Run("capacity and price arguments", func(t *testing.T) {
+		seg := &model.Segment{
+			Refuel:  &model.RefuelDetails{TroughLevel: 10, PeakLevel: 90},
+			Signals: []*model.SignalAggregationValue{signal(sigFuelAbsFirst, 5), signal(sigFuelAbsLast, 45)},
+		}
+		addRefuelVolume(seg, floatRef(60), floatRef(1.5))
+		require.Equal(t, floatRef(60), seg.Refuel.TankCapacityLiters)
+		require.InDelta(t, 48, *seg.Refuel.LitersAdded, 1e-9)
+		require.InDelta(t, 72, *seg.Refuel.EstimatedCost, 1e-9)
+	})
+
+	t.Run("absolute level signal", func(t *testing.T) {
+		seg := &model.Segment{
+			Refuel:  &model.RefuelDetails{TroughLevel: 10, PeakLevel: 90},
+			Signals: []*model.SignalAggregationValue{signal(sigFuelAbsFirst, 5), signal(sigFuelAbsLast, 45)},
+		}
+		addRefuelVolume(seg, nil, nil)
+		require.InDelta(t, 50, *seg.Refuel.TankCapacityLiters, 1e-9)
+		require.InDelta(t, 40, *seg.Refuel.LitersAdded, 1e-9)
+		require.Nil(t, seg.Refuel.EstimatedCost)
+	})
+
+	t.Run("no capacity", func(t *testing.T) {
+		seg := &model.Segment{Refuel: &model.RefuelDetails{TroughLevel: 10, PeakLevel: 90}}
+		addRefuelVolume(seg, nil, floatRef(1.5))
+		require.Equal(t, &model.RefuelDetails{TroughLevel: 10, PeakLevel: 90}, seg.Refuel)
+	})
+
+	t.Run("no refuel details", func(t *testing.T) {
+		seg := &model.Segment{}
+		addRefuelVolume(seg, floatRef(60), nil)
+		require.Nil(t, seg.Refuel)
+	})
+}
+
+func TestAddFuelDropVolume(t *testing.T) {
+	signal := func(req *model.SegmentSignalRequest, value float64) *model.SignalAggregationValue {
+		return &model.SignalAggregationValue{Name: req.Name, Agg: string(req.Agg), Value: value}
+	}
+	newSeg := func() *model.Segment {
+		return &model.Segment{
+			FuelDrop: &model.FuelDropDetails{StartLevel: 80, EndLevel: 50},
+			Signals:  []*model.SignalAggregationValue{signal(sigFuelAbsFirst, 48), signal(sigFuelAbsLast, 30)},
+		}
+	}
+
+	seg := newSeg()
+	addFuelDropVolume(seg, floatRef(60))
+	require.InDelta(t, 18, *seg.FuelDrop.LitersLost, 1e-9)
+
+	seg = newSeg()
+	addFuelDropVolume(seg, nil)
+	require.InDelta(t, 18, *seg.FuelDrop.LitersLost, 1e-9)
+
+	seg = &model.Segment{FuelDrop: &model.FuelDropDetails{StartLevel: 80, EndLevel: 50}}
+	addFuelDropVolume(seg, nil)
+	require.Nil(t, seg.FuelDrop.LitersLost)
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"slices"
 	"strings"
 	"time"
 
@@ -27,16 +28,20 @@ var unixEpoch = time.Unix(0, 0).UTC()
 // CHService is the interface for the ClickHouse service.
 type CHService interface {
 	GetAggregatedSignals(ctx context.Context, subject string, aggArgs *model.AggregatedSignalArgs) ([]*ch.AggSignal, error)
-	GetAggregatedSignalsForRanges(ctx context.Context, subject string, ranges []ch.TimeRange, globalFrom, globalTo time.Time, floatArgs []model.FloatSignalArgs, locationArgs []model.LocationSignalArgs) ([]*ch.AggSignalForRange, error)
 	GetLatestSignals(ctx context.Context, subject string, latestArgs *model.LatestSignalsArgs) ([]*vss.Signal, error)
 	GetAllLatestSignals(ctx context.Context, subject string, filter *model.SignalFilter) ([]*vss.Signal, error)
-	GetAvailableSignals(ctx context.Context, subject string, filter *model.SignalFilter) ([]string, error)
-	GetSignalSummaries(ctx context.Context, subject string, filter *model.SignalFilter) ([]*model.SignalDataSummary, error)
-	GetEvents(ctx context.Context, subject string, from, to time.Time, filter *model.EventFilter) ([]*vss.Event, error)
+	GetAvailableSignals(ctx context.Context, subject string, from, to *time.Time, filter *model.SignalFilter) ([]string, error)
+	GetSignalSummaries(ctx context.Context, subject string, from, to *time.Time, filter *model.SignalFilter) ([]*model.SignalDataSummary, error)
+	GetEvents(ctx context.Context, subject string, from, to time.Time, filter *model.EventFilter, opts ch.EventQueryOptions) ([]*ch.Event, error)
+	GetEventAggregations(ctx context.Context, subject string, from, to time.Time, intervalMicro int64, filter *model.EventFilter) ([]*model.EventAggregation, error)
 	GetEventCounts(ctx context.Context, subject string, from, to time.Time, eventNames []string) ([]*ch.EventCount, error)
-	GetEventCountsForRanges(ctx context.Context, subject string, ranges []ch.TimeRange, eventNames []string) ([]*ch.EventCountForRange, error)
-	GetEventSummaries(ctx context.Context, subject string) ([]*ch.EventSummary, error)
+	GetEventSummaries(ctx context.Context, subject string, from, to *time.Time) ([]*ch.EventSummary, error)
+	GetLocationsForRanges(ctx context.Context, subject string, ranges []ch.TimeRange, globalFrom, globalTo time.Time) ([]*ch.LocationForRange, error)
+	GetFloatSamples(ctx context.Context, subject string, from, to time.Time, names []string) ([]*ch.FloatSample, error)
 	GetSegments(ctx context.Context, subject string, from, to time.Time, mechanism model.DetectionMechanism, config *model.SegmentConfig) ([]*model.Segment, error)
+	GetSummariesForRanges(ctx context.Context, subject string, ranges []ch.SummaryRange, floatArgs []model.FloatSignalArgs, locationArgs []model.LocationSignalArgs, eventNames []string) ([]*ch.RangeSummary, error)
+	GetSignalCoverage(ctx context.Context, subject string, from, to time.Time, windowSizeSeconds int, names []string, filter *model.SignalFilter) ([]*ch.CoverageWindow, error)
+	GetSamplingStats(ctx context.Context, subject string, from, to time.Time, names []string, filter *model.SignalFilter) ([]*ch.SamplingStats, error)
 }
 
 // Repository is the base repository for all repositories.
@@ -45,6 +50,14 @@ type Repository struct {
 	chService        CHService
 	chainID          uint64
 	vehicleAddress   common.Address
+	latestHub        *latestHub
+	pollInterval     time.Duration
+	eventLookback    time.Duration
+	// signalDefinitions is the catalog of queryable signals.
+	signalDefinitions []*model.SignalDefinition
+	driverScorer      *driverScorer
+	// segmentStoreEnabled allows longer segment queries where the segment store covers them.
+	segmentStoreEnabled bool
 }
 
 // NewRepository creates a new base repository.
@@ -59,11 +72,44 @@ func NewRepository(chService CHService, settings config.Settings) (*Repository,
 		queryableSignals[schema.VSSToJSONName(vssName)] = struct{}{}
 	}
 
+	pollInterval := defaultSubscriptionPollInterval
+	if settings.SubscriptionPollInterval != "" {
+		pollInterval, err = time.ParseDuration(settings.SubscriptionPollInterval)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't parse subscription poll interval %q: %w", settings.SubscriptionPollInterval, err)
+		}
+		if pollInterval <= 0 {
+			return nil, fmt.Errorf("subscription poll interval %s was not positive", settings.SubscriptionPollInterval)
+		}
+	}
+
+	eventLookback := defaultEventLookback
+	if settings.EventSubscriptionLookback != "" {
+		eventLookback, err = time.ParseDuration(settings.EventSubscriptionLookback)
+		if err != nil {
+			return nil, fmt.Errorf("couldn't parse event subscription lookback %q: %w", settings.EventSubscriptionLookback, err)
+		}
+		if eventLookback <= 0 {
+			return nil, fmt.Errorf("event subscription lookback %s was not positive", settings.EventSubscriptionLookback)
+		}
+	}
+
+	scorer, err := newDriverScorer(settings)
+	if err != nil {
+		return nil, fmt.Errorf("couldn't create driver scorer: %w", err)
+	}
+
 	return &Repository{
-		chService:        chService,
-		queryableSignals: queryableSignals,
-		chainID:          settings.ChainID,
-		vehicleAddress:   settings.VehicleNFTAddress,
+		chService:           chService,
+		queryableSignals:    queryableSignals,
+		chainID:             settings.ChainID,
+		vehicleAddress:      settings.VehicleNFTAddress,
+		latestHub:           newLatestHub(chService, queryableSignals, pollInterval),
+		pollInterval:        pollInterval,
+		eventLookback:       eventLookback,
+		signalDefinitions:   newSignalDefinitions(definitions),
+		driverScorer:        scorer,
+		segmentStoreEnabled: settings.SegmentStoreEnabled,
 	}, nil
 
 }
@@ -155,10 +201,14 @@ func (r *Repository) GetSignalLatest(ctx context.Context, latestArgs *model.Late
 }
 
 // GetAvailableSignals returns the available signals for the given tokenID and filter.
+// If from or to is set, only signals stored in [from, to) are returned.
 // If no signals are found, a nil slice is returned.
-func (r *Repository) GetAvailableSignals(ctx context.Context, tokenID uint32, filter *model.SignalFilter) ([]string, error) {
+func (r *Repository) GetAvailableSignals(ctx context.Context, tokenID uint32, from, to *time.Time, filter *model.SignalFilter) ([]string, error) {
+	if err := validateOptionalTimeRange(from, to); err != nil {
+		return nil, errorhandler.NewBadRequestError(ctx, err)
+	}
 	subject := r.toSubject(tokenID)
-	allSignals, err := r.chService.GetAvailableSignals(ctx, subject, filter)
+	allSignals, err := r.chService.GetAvailableSignals(ctx, subject, from, to, filter)
 	if err != nil {
 		return nil, handleDBError(ctx, err)
 	}
@@ -227,9 +277,13 @@ func (r *Repository) GetSignalSnapshot(ctx context.Context, tokenID uint32, filt
 }
 
 // GetDataSummary returns the signal and event metadata for the given tokenID and filter.
-func (r *Repository) GetDataSummary(ctx context.Context, tokenID uint32, filter *model.SignalFilter) (*model.DataSummary, error) {
+// If from or to is set, the summary only covers data stored in [from, to).
+func (r *Repository) GetDataSummary(ctx context.Context, tokenID uint32, from, to *time.Time, filter *model.SignalFilter) (*model.DataSummary, error) {
+	if err := validateOptionalTimeRange(from, to); err != nil {
+		return nil, errorhandler.NewBadRequestError(ctx, err)
+	}
 	subject := r.toSubject(tokenID)
-	allSignalSummaries, err := r.chService.GetSignalSummaries(ctx, subject, filter)
+	allSignalSummaries, err := r.chService.GetSignalSummaries(ctx, subject, from, to, filter)
 	if err != nil {
 		return nil, handleDBError(ctx, err)
 	}
@@ -243,7 +297,7 @@ func (r *Repository) GetDataSummary(ctx context.Context, tokenID uint32, filter
 			signalDataSummary = append(signalDataSummary, metadata)
 		}
 	}
-	eventSummaries, err := r.chService.GetEventSummaries(ctx, subject)
+	eventSummaries, err := r.chService.GetEventSummaries(ctx, subject, from, to)
 	if err != nil {
 		return nil, handleDBError(ctx, err)
 	}
@@ -288,41 +342,120 @@ func (r *Repository) GetDataSummary(ctx context.Context, tokenID uint32, filter
 	}, nil
 }
 
-// GetEvents returns the events for the given tokenID, from, to and filter.
-func (r *Repository) GetEvents(ctx context.Context, tokenID int, from, to time.Time, filter *model.EventFilter) ([]*model.Event, error) {
+// GetEvents returns the newest events for the given tokenID, from, to and
+// filter, at most limit of them. The location of each event is attached at the
+// given precision. If derived is set, harsh driving events derived from signals
+// are merged in.
+func (r *Repository) GetEvents(ctx context.Context, tokenID int, from, to time.Time, filter *model.EventFilter, derived *model.DerivedEventsConfig, limit *int, precision LocationPrecision) ([]*model.Event, error) {
 	if err := validateEventArgs(tokenID, from, to, filter); err != nil {
 		return nil, errorhandler.NewBadRequestError(ctx, err)
 	}
+	if err := validateDerivedEventsConfig(derived, from, to); err != nil {
+		return nil, errorhandler.NewBadRequestError(ctx, err)
+	}
+	maxEvents, err := eventLimit(limit, maxEventLimit)
+	if err != nil {
+		return nil, errorhandler.NewBadRequestError(ctx, err)
+	}
 	subject := cloudevent.ERC721DID{
 		ChainID:         r.chainID,
 		ContractAddress: r.vehicleAddress,
 		TokenID:         big.NewInt(int64(tokenID)),
 	}.String()
-	allEvents, err := r.chService.GetEvents(ctx, subject, from, to, filter)
+	opts := ch.EventQueryOptions{Limit: maxEvents, WithLocation: precision != LocationHidden}
+	allEvents, err := r.chService.GetEvents(ctx, subject, from, to, filter, opts)
 	if err != nil {
 		return nil, handleDBError(ctx, err)
 	}
 	retEvents := make([]*model.Event, len(allEvents))
 	for i, event := range allEvents {
-		retEvents[i] = &model.Event{
-			Timestamp:  event.Data.Timestamp,
-			Name:       event.Data.Name,
-			Source:     event.Source,
-			DurationNs: int(event.Data.DurationNs),
-		}
-		if event.Data.Metadata != "" {
-			retEvents[i].Metadata = &event.Data.Metadata
+		retEvents[i] = eventToModel(event, precision)
+	}
+	if derived != nil {
+		derivedEvents, err := r.getDerivedEvents(ctx, subject, from, to, filter, derived)
+		if err != nil {
+			return nil, handleDBError(ctx, err)
 		}
+		// Stored events are newest first.
+		retEvents = append(retEvents, derivedEvents...)
+		slices.SortStableFunc(retEvents, func(a, b *model.Event) int { return b.Timestamp.Compare(a.Timestamp) })
+	}
+	// The query keeps every event sharing the oldest timestamp returned.
+	if len(retEvents) > maxEvents {
+		retEvents = retEvents[:maxEvents]
 	}
 	return retEvents, nil
 }
 
+// GetEventsAggregated returns event counts and total durations by name per
+// interval bucket for the given tokenID, from, to and filter.
+func (r *Repository) GetEventsAggregated(ctx context.Context, tokenID int, from, to time.Time, intervalMicro int64, filter *model.EventFilter) ([]*model.EventAggregation, error) {
+	if err := validateEventArgs(tokenID, from, to, filter); err != nil {
+		return nil, errorhandler.NewBadRequestError(ctx, err)
+	}
+	if intervalMicro < 1 {
+		return nil, errorhandler.NewBadRequestError(ctx, ValidationError("interval is not a positive duration"))
+	}
+	aggs, err := r.chService.GetEventAggregations(ctx, r.toSubject(uint32(tokenID)), from, to, intervalMicro, filter)
+	if err != nil {
+		return nil, handleDBError(ctx, err)
+	}
+	return aggs, nil
+}
+
+// LocationPrecision is the precision at which a caller may see locations.
+type LocationPrecision int
+
+const (
+	// LocationHidden means the caller may not see locations.
+	LocationHidden LocationPrecision = iota
+	// LocationExact means the caller sees locations as stored.
+	LocationExact
+)
+
+// eventToModel converts a stored event to its GraphQL model.
+func eventToModel(event *ch.Event, precision LocationPrecision) *model.Event {
+	retEvent := &model.Event{
+		Timestamp:  event.Data.Timestamp,
+		Name:       event.Data.Name,
+		Source:     event.Source,
+		DurationNs: int(event.Data.DurationNs),
+		Location:   locationToModel(event.Location, precision),
+	}
+	if event.Data.Metadata != "" {
+		retEvent.Metadata = &event.Data.Metadata
+	}
+	return retEvent
+}
+
+// locationToModel converts a stored location to its GraphQL model at the
+// given precision. The result is nil if there is no location or the caller
+// may not see it.
+func locationToModel(loc *vss.Location, precision LocationPrecision) *model.Location {
+	if loc == nil {
+		return nil
+	}
+	switch precision {
+	case LocationExact:
+		return &model.Location{
+			Latitude:  loc.Latitude,
+			Longitude: loc.Longitude,
+			Hdop:      loc.HDOP,
+		}
+	default:
+		return nil
+	}
+}
+
 // handleDBError logs the error and returns a generic error message.
 func handleDBError(ctx context.Context, err error) error {
 	exceptionErr := &proto.Exception{}
 	if errors.Is(err, context.DeadlineExceeded) || (errors.As(err, &exceptionErr) && exceptionErr.Code == ch.TimeoutErrCode) {
 		return errorhandler.NewBadRequestErrorWithMsg(ctx, err, "request exceeded or is estimated to exceed the maximum execution time")
 	}
+	if errors.Is(err, ch.ErrSegmentsNotStored) {
+		return errorhandler.NewBadRequestErrorWithMsg(ctx, err, "segments of this range are not stored yet; retry in a few minutes, or query at most 31 days")
+	}
 	return errorhandler.NewInternalErrorWithMsg(ctx, err, "failed to query db")
 }
 
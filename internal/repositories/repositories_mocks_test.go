@@ -59,21 +59,6 @@ func (mr *MockCHServiceMockRecorder) GetAggregatedSignals(ctx, subject, aggArgs
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAggregatedSignals", reflect.TypeOf((*MockCHService)(nil).GetAggregatedSignals), ctx, subject, aggArgs)
 }
 
-// GetAggregatedSignalsForRanges mocks base method.
-func (m *MockCHService) GetAggregatedSignalsForRanges(ctx context.Context, subject string, ranges []ch.TimeRange, globalFrom, globalTo time.Time, floatArgs []model.FloatSignalArgs, locationArgs []model.LocationSignalArgs) ([]*ch.AggSignalForRange, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAggregatedSignalsForRanges", ctx, subject, ranges, globalFrom, globalTo, floatArgs, locationArgs)
-	ret0, _ := ret[0].([]*ch.AggSignalForRange)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// GetAggregatedSignalsForRanges indicates an expected call of GetAggregatedSignalsForRanges.
-func (mr *MockCHServiceMockRecorder) GetAggregatedSignalsForRanges(ctx, subject, ranges, globalFrom, globalTo, floatArgs, locationArgs any) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAggregatedSignalsForRanges", reflect.TypeOf((*MockCHService)(nil).GetAggregatedSignalsForRanges), ctx, subject, ranges, globalFrom, globalTo, floatArgs, locationArgs)
-}
-
 // GetAllLatestSignals mocks base method.
 func (m *MockCHService) GetAllLatestSignals(ctx context.Context, subject string, filter *model.SignalFilter) ([]*vss.Signal, error) {
 	m.ctrl.T.Helper()
@@ -90,18 +75,33 @@ func (mr *MockCHServiceMockRecorder) GetAllLatestSignals(ctx, subject, filter an
 }
 
 // GetAvailableSignals mocks base method.
-func (m *MockCHService) GetAvailableSignals(ctx context.Context, subject string, filter *model.SignalFilter) ([]string, error) {
+func (m *MockCHService) GetAvailableSignals(ctx context.Context, subject string, from, to *time.Time, filter *model.SignalFilter) ([]string, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetAvailableSignals", ctx, subject, filter)
+	ret := m.ctrl.Call(m, "GetAvailableSignals", ctx, subject, from, to, filter)
 	ret0, _ := ret[0].([]string)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetAvailableSignals indicates an expected call of GetAvailableSignals.
-func (mr *MockCHServiceMockRecorder) GetAvailableSignals(ctx, subject, filter any) *gomock.Call {
+func (mr *MockCHServiceMockRecorder) GetAvailableSignals(ctx, subject, from, to, filter any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAvailableSignals", reflect.TypeOf((*MockCHService)(nil).GetAvailableSignals), ctx, subject, filter)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAvailableSignals", reflect.TypeOf((*MockCHService)(nil).GetAvailableSignals), ctx, subject, from, to, filter)
+}
+
+// GetEventAggregations mocks base method.
+func (m *MockCHService) GetEventAggregations(ctx context.Context, subject string, from, to time.Time, intervalMicro int64, filter *model.EventFilter) ([]*model.EventAggregation, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetEventAggregations", ctx, subject, from, to, intervalMicro, filter)
+	ret0, _ := ret[0].([]*model.EventAggregation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetEventAggregations indicates an expected call of GetEventAggregations.
+func (mr *MockCHServiceMockRecorder) GetEventAggregations(ctx, subject, from, to, intervalMicro, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventAggregations", reflect.TypeOf((*MockCHService)(nil).GetEventAggregations), ctx, subject, from, to, intervalMicro, filter)
 }
 
 // GetEventCounts mocks base method.
@@ -119,49 +119,49 @@ func (mr *MockCHServiceMockRecorder) GetEventCounts(ctx, subject, from, to, even
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventCounts", reflect.TypeOf((*MockCHService)(nil).GetEventCounts), ctx, subject, from, to, eventNames)
 }
 
-// GetEventCountsForRanges mocks base method.
-func (m *MockCHService) GetEventCountsForRanges(ctx context.Context, subject string, ranges []ch.TimeRange, eventNames []string) ([]*ch.EventCountForRange, error) {
+// GetEventSummaries mocks base method.
+func (m *MockCHService) GetEventSummaries(ctx context.Context, subject string, from, to *time.Time) ([]*ch.EventSummary, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetEventCountsForRanges", ctx, subject, ranges, eventNames)
-	ret0, _ := ret[0].([]*ch.EventCountForRange)
+	ret := m.ctrl.Call(m, "GetEventSummaries", ctx, subject, from, to)
+	ret0, _ := ret[0].([]*ch.EventSummary)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetEventCountsForRanges indicates an expected call of GetEventCountsForRanges.
-func (mr *MockCHServiceMockRecorder) GetEventCountsForRanges(ctx, subject, ranges, eventNames any) *gomock.Call {
+// GetEventSummaries indicates an expected call of GetEventSummaries.
+func (mr *MockCHServiceMockRecorder) GetEventSummaries(ctx, subject, from, to any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventCountsForRanges", reflect.TypeOf((*MockCHService)(nil).GetEventCountsForRanges), ctx, subject, ranges, eventNames)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventSummaries", reflect.TypeOf((*MockCHService)(nil).GetEventSummaries), ctx, subject, from, to)
 }
 
-// GetEventSummaries mocks base method.
-func (m *MockCHService) GetEventSummaries(ctx context.Context, subject string) ([]*ch.EventSummary, error) {
+// GetEvents mocks base method.
+func (m *MockCHService) GetEvents(ctx context.Context, subject string, from, to time.Time, filter *model.EventFilter, opts ch.EventQueryOptions) ([]*ch.Event, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetEventSummaries", ctx, subject)
-	ret0, _ := ret[0].([]*ch.EventSummary)
+	ret := m.ctrl.Call(m, "GetEvents", ctx, subject, from, to, filter, opts)
+	ret0, _ := ret[0].([]*ch.Event)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetEventSummaries indicates an expected call of GetEventSummaries.
-func (mr *MockCHServiceMockRecorder) GetEventSummaries(ctx, subject any) *gomock.Call {
+// GetEvents indicates an expected call of GetEvents.
+func (mr *MockCHServiceMockRecorder) GetEvents(ctx, subject, from, to, filter, opts any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventSummaries", reflect.TypeOf((*MockCHService)(nil).GetEventSummaries), ctx, subject)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEvents", reflect.TypeOf((*MockCHService)(nil).GetEvents), ctx, subject, from, to, filter, opts)
 }
 
-// GetEvents mocks base method.
-func (m *MockCHService) GetEvents(ctx context.Context, subject string, from, to time.Time, filter *model.EventFilter) ([]*vss.Event, error) {
+// GetFloatSamples mocks base method.
+func (m *MockCHService) GetFloatSamples(ctx context.Context, subject string, from, to time.Time, names []string) ([]*ch.FloatSample, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetEvents", ctx, subject, from, to, filter)
-	ret0, _ := ret[0].([]*vss.Event)
+	ret := m.ctrl.Call(m, "GetFloatSamples", ctx, subject, from, to, names)
+	ret0, _ := ret[0].([]*ch.FloatSample)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetEvents indicates an expected call of GetEvents.
-func (mr *MockCHServiceMockRecorder) GetEvents(ctx, subject, from, to, filter any) *gomock.Call {
+// GetFloatSamples indicates an expected call of GetFloatSamples.
+func (mr *MockCHServiceMockRecorder) GetFloatSamples(ctx, subject, from, to, names any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEvents", reflect.TypeOf((*MockCHService)(nil).GetEvents), ctx, subject, from, to, filter)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFloatSamples", reflect.TypeOf((*MockCHService)(nil).GetFloatSamples), ctx, subject, from, to, names)
 }
 
 // GetLatestSignals mocks base method.
@@ -179,6 +179,36 @@ func (mr *MockCHServiceMockRecorder) GetLatestSignals(ctx, subject, latestArgs a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLatestSignals", reflect.TypeOf((*MockCHService)(nil).GetLatestSignals), ctx, subject, latestArgs)
 }
 
+// GetLocationsForRanges mocks base method.
+func (m *MockCHService) GetLocationsForRanges(ctx context.Context, subject string, ranges []ch.TimeRange, globalFrom, globalTo time.Time) ([]*ch.LocationForRange, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetLocationsForRanges", ctx, subject, ranges, globalFrom, globalTo)
+	ret0, _ := ret[0].([]*ch.LocationForRange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetLocationsForRanges indicates an expected call of GetLocationsForRanges.
+func (mr *MockCHServiceMockRecorder) GetLocationsForRanges(ctx, subject, ranges, globalFrom, globalTo any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLocationsForRanges", reflect.TypeOf((*MockCHService)(nil).GetLocationsForRanges), ctx, subject, ranges, globalFrom, globalTo)
+}
+
+// GetSamplingStats mocks base method.
+func (m *MockCHService) GetSamplingStats(ctx context.Context, subject string, from, to time.Time, names []string, filter *model.SignalFilter) ([]*ch.SamplingStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSamplingStats", ctx, subject, from, to, names, filter)
+	ret0, _ := ret[0].([]*ch.SamplingStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSamplingStats indicates an expected call of GetSamplingStats.
+func (mr *MockCHServiceMockRecorder) GetSamplingStats(ctx, subject, from, to, names, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSamplingStats", reflect.TypeOf((*MockCHService)(nil).GetSamplingStats), ctx, subject, from, to, names, filter)
+}
+
 // GetSegments mocks base method.
 func (m *MockCHService) GetSegments(ctx context.Context, subject string, from, to time.Time, mechanism model.DetectionMechanism, config *model.SegmentConfig) ([]*model.Segment, error) {
 	m.ctrl.T.Helper()
@@ -194,17 +224,47 @@ func (mr *MockCHServiceMockRecorder) GetSegments(ctx, subject, from, to, mechani
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSegments", reflect.TypeOf((*MockCHService)(nil).GetSegments), ctx, subject, from, to, mechanism, config)
 }
 
+// GetSignalCoverage mocks base method.
+func (m *MockCHService) GetSignalCoverage(ctx context.Context, subject string, from, to time.Time, windowSizeSeconds int, names []string, filter *model.SignalFilter) ([]*ch.CoverageWindow, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSignalCoverage", ctx, subject, from, to, windowSizeSeconds, names, filter)
+	ret0, _ := ret[0].([]*ch.CoverageWindow)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSignalCoverage indicates an expected call of GetSignalCoverage.
+func (mr *MockCHServiceMockRecorder) GetSignalCoverage(ctx, subject, from, to, windowSizeSeconds, names, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSignalCoverage", reflect.TypeOf((*MockCHService)(nil).GetSignalCoverage), ctx, subject, from, to, windowSizeSeconds, names, filter)
+}
+
 // GetSignalSummaries mocks base method.
-func (m *MockCHService) GetSignalSummaries(ctx context.Context, subject string, filter *model.SignalFilter) ([]*model.SignalDataSummary, error) {
+func (m *MockCHService) GetSignalSummaries(ctx context.Context, subject string, from, to *time.Time, filter *model.SignalFilter) ([]*model.SignalDataSummary, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetSignalSummaries", ctx, subject, filter)
+	ret := m.ctrl.Call(m, "GetSignalSummaries", ctx, subject, from, to, filter)
 	ret0, _ := ret[0].([]*model.SignalDataSummary)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // GetSignalSummaries indicates an expected call of GetSignalSummaries.
-func (mr *MockCHServiceMockRecorder) GetSignalSummaries(ctx, subject, filter any) *gomock.Call {
+func (mr *MockCHServiceMockRecorder) GetSignalSummaries(ctx, subject, from, to, filter any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSignalSummaries", reflect.TypeOf((*MockCHService)(nil).GetSignalSummaries), ctx, subject, from, to, filter)
+}
+
+// GetSummariesForRanges mocks base method.
+func (m *MockCHService) GetSummariesForRanges(ctx context.Context, subject string, ranges []ch.SummaryRange, floatArgs []model.FloatSignalArgs, locationArgs []model.LocationSignalArgs, eventNames []string) ([]*ch.RangeSummary, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSummariesForRanges", ctx, subject, ranges, floatArgs, locationArgs, eventNames)
+	ret0, _ := ret[0].([]*ch.RangeSummary)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSummariesForRanges indicates an expected call of GetSummariesForRanges.
+func (mr *MockCHServiceMockRecorder) GetSummariesForRanges(ctx, subject, ranges, floatArgs, locationArgs, eventNames any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSignalSummaries", reflect.TypeOf((*MockCHService)(nil).GetSignalSummaries), ctx, subject, filter)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSummariesForRanges", reflect.TypeOf((*MockCHService)(nil).GetSummariesForRanges), ctx, subject, ranges, floatArgs, locationArgs, eventNames)
 }
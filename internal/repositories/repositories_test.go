@@ -347,7 +347,7 @@ func TestGetAvailableSignals(t *testing.T) {
 			name: "No signals",
 			mockSetup: func(m *Mocks) {
 				m.CHService.EXPECT().
-					GetAvailableSignals(gomock.Any(), testSubject, nil).
+					GetAvailableSignals(gomock.Any(), testSubject, nil, nil, nil).
 					Return(nil, nil)
 			},
 			expectedResult: nil,
@@ -357,7 +357,7 @@ func TestGetAvailableSignals(t *testing.T) {
 			name: "One signal",
 			mockSetup: func(m *Mocks) {
 				m.CHService.EXPECT().
-					GetAvailableSignals(gomock.Any(), testSubject, nil).
+					GetAvailableSignals(gomock.Any(), testSubject, nil, nil, nil).
 					Return([]string{"speed"}, nil)
 			},
 			expectedResult: []string{"speed"},
@@ -367,7 +367,7 @@ func TestGetAvailableSignals(t *testing.T) {
 			name: "Multiple signals",
 			mockSetup: func(m *Mocks) {
 				m.CHService.EXPECT().
-					GetAvailableSignals(gomock.Any(), testSubject, nil).
+					GetAvailableSignals(gomock.Any(), testSubject, nil, nil, nil).
 					Return([]string{"speed", "powertrainTractionBatteryStateOfChargeCurrent"}, nil)
 			},
 			expectedResult: []string{"speed", "powertrainTractionBatteryStateOfChargeCurrent"},
@@ -377,7 +377,7 @@ func TestGetAvailableSignals(t *testing.T) {
 			name: "Mix Unknown signals",
 			mockSetup: func(m *Mocks) {
 				m.CHService.EXPECT().
-					GetAvailableSignals(gomock.Any(), testSubject, nil).
+					GetAvailableSignals(gomock.Any(), testSubject, nil, nil, nil).
 					Return([]string{"speed", "newSignalName"}, nil)
 			},
 			expectedResult: []string{"speed"},
@@ -387,7 +387,7 @@ func TestGetAvailableSignals(t *testing.T) {
 			name: "one unknown signals",
 			mockSetup: func(m *Mocks) {
 				m.CHService.EXPECT().
-					GetAvailableSignals(gomock.Any(), testSubject, nil).
+					GetAvailableSignals(gomock.Any(), testSubject, nil, nil, nil).
 					Return([]string{"newSignalName"}, nil)
 			},
 			expectedResult: nil,
@@ -397,7 +397,7 @@ func TestGetAvailableSignals(t *testing.T) {
 			name: "multiple unknown signals",
 			mockSetup: func(m *Mocks) {
 				m.CHService.EXPECT().
-					GetAvailableSignals(gomock.Any(), testSubject, nil).
+					GetAvailableSignals(gomock.Any(), testSubject, nil, nil, nil).
 					Return([]string{"newSignalName", "newSignalName2"}, nil)
 			},
 			expectedResult: nil,
@@ -407,7 +407,7 @@ func TestGetAvailableSignals(t *testing.T) {
 			name: "CHService error",
 			mockSetup: func(m *Mocks) {
 				m.CHService.EXPECT().
-					GetAvailableSignals(gomock.Any(), testSubject, nil).
+					GetAvailableSignals(gomock.Any(), testSubject, nil, nil, nil).
 					Return(nil, errors.New("service error"))
 			},
 			expectedResult: nil,
@@ -425,7 +425,7 @@ func TestGetAvailableSignals(t *testing.T) {
 
 			repo, err := repositories.NewRepository(mocks.CHService, baseSettings)
 			require.NoError(t, err)
-			result, err := repo.GetAvailableSignals(context.Background(), 1, nil)
+			result, err := repo.GetAvailableSignals(context.Background(), 1, nil, nil, nil)
 			if tt.expectError {
 				require.Error(t, err)
 				require.Nil(t, result)
@@ -452,27 +452,32 @@ func TestGetEvents(t *testing.T) {
 	}.String()
 
 	eventMeta := "{\"foo\":\"bar\"}"
-	vssEvents := []*vss.Event{
+	vssEvents := []*ch.Event{
 		{
-			CloudEventHeader: cloudevent.CloudEventHeader{
-				Source: "source1",
-			},
-			Data: vss.EventData{
-				Timestamp:  from.Add(10 * time.Minute),
-				Name:       "event1",
-				DurationNs: 123,
-				Metadata:   eventMeta,
+			Event: vss.Event{
+				CloudEventHeader: cloudevent.CloudEventHeader{
+					Source: "source1",
+				},
+				Data: vss.EventData{
+					Timestamp:  from.Add(10 * time.Minute),
+					Name:       "event1",
+					DurationNs: 123,
+					Metadata:   eventMeta,
+				},
 			},
+			Location: &vss.Location{Latitude: 40.75, Longitude: -73.99, HDOP: 1.5},
 		},
 		{
-			CloudEventHeader: cloudevent.CloudEventHeader{
-				Source: "source2",
-			},
-			Data: vss.EventData{
-				Timestamp:  from.Add(20 * time.Minute),
-				Name:       "event2",
-				DurationNs: 456,
-				Metadata:   "",
+			Event: vss.Event{
+				CloudEventHeader: cloudevent.CloudEventHeader{
+					Source: "source2",
+				},
+				Data: vss.EventData{
+					Timestamp:  from.Add(20 * time.Minute),
+					Name:       "event2",
+					DurationNs: 456,
+					Metadata:   "",
+				},
 			},
 		},
 	}
@@ -483,9 +488,9 @@ func TestGetEvents(t *testing.T) {
 
 	t.Run("success", func(t *testing.T) {
 		mocks.CHService.EXPECT().
-			GetEvents(gomock.Any(), subject, from, to, filter).
+			GetEvents(gomock.Any(), subject, from, to, filter, ch.EventQueryOptions{Limit: 1000}).
 			Return(vssEvents, nil)
-		result, err := repo.GetEvents(context.Background(), tokenID, from, to, filter)
+		result, err := repo.GetEvents(context.Background(), tokenID, from, to, filter, nil, nil, repositories.LocationHidden)
 		require.NoError(t, err)
 		require.Len(t, result, 2)
 		require.Equal(t, vssEvents[0].Data.Name, result[0].Name)
@@ -498,17 +503,84 @@ func TestGetEvents(t *testing.T) {
 		} else {
 			require.Nil(t, result[0].Metadata)
 		}
+		require.Nil(t, result[0].Location)
+	})
+
+	t.Run("exact location", func(t *testing.T) {
+		mocks.CHService.EXPECT().
+			GetEvents(gomock.Any(), subject, from, to, filter, ch.EventQueryOptions{Limit: 1000, WithLocation: true}).
+			Return(vssEvents, nil)
+		result, err := repo.GetEvents(context.Background(), tokenID, from, to, filter, nil, nil, repositories.LocationExact)
+		require.NoError(t, err)
+		require.Equal(t, &model.Location{Latitude: 40.75, Longitude: -73.99, Hdop: 1.5}, result[0].Location)
+		require.Nil(t, result[1].Location)
 	})
 
 	t.Run("error from service", func(t *testing.T) {
 		mocks.CHService.EXPECT().
-			GetEvents(gomock.Any(), subject, from, to, filter).
+			GetEvents(gomock.Any(), subject, from, to, filter, ch.EventQueryOptions{Limit: 1000}).
 			Return(nil, errors.New("service error"))
-		result, err := repo.GetEvents(context.Background(), tokenID, from, to, filter)
+		result, err := repo.GetEvents(context.Background(), tokenID, from, to, filter, nil, nil, repositories.LocationHidden)
 		require.Error(t, err)
 		require.Nil(t, result)
 	})
 
+	t.Run("derived events", func(t *testing.T) {
+		speed := func(at time.Time, value float64) *ch.FloatSample {
+			return &ch.FloatSample{Name: vss.FieldSpeed, Timestamp: at, Value: value}
+		}
+		mocks.CHService.EXPECT().
+			GetEvents(gomock.Any(), subject, from, to, filter, ch.EventQueryOptions{Limit: 1000}).
+			Return(vssEvents, nil)
+		mocks.CHService.EXPECT().
+			GetFloatSamples(gomock.Any(), subject, from.Add(-5*time.Second), to, []string{vss.FieldSpeed, vss.FieldAngularVelocityYaw}).
+			Return([]*ch.FloatSample{
+				// Samples before from only complete accelerations in the range.
+				speed(from.Add(-2*time.Second), 0),
+				speed(from.Add(-time.Second), 20),
+				speed(from.Add(15*time.Minute), 0),
+				speed(from.Add(15*time.Minute+time.Second), 20),
+			}, nil)
+		result, err := repo.GetEvents(context.Background(), tokenID, from, to, filter, &model.DerivedEventsConfig{}, nil, repositories.LocationHidden)
+		require.NoError(t, err)
+		require.Len(t, result, 3)
+		require.Equal(t, "event2", result[0].Name)
+		require.Equal(t, "behavior.harshAcceleration", result[1].Name)
+		require.Equal(t, repositories.DerivedEventSource, result[1].Source)
+		require.Equal(t, from.Add(15*time.Minute), result[1].Timestamp)
+		require.Equal(t, "event1", result[2].Name)
+	})
+
+	t.Run("tags filter excludes derived events", func(t *testing.T) {
+		tagged := &model.EventFilter{Tags: &model.StringArrayFilter{ContainsAny: []string{"tag"}}}
+		mocks.CHService.EXPECT().
+			GetEvents(gomock.Any(), subject, from, to, tagged, ch.EventQueryOptions{Limit: 1000}).
+			Return(vssEvents[1:], nil)
+		result, err := repo.GetEvents(context.Background(), tokenID, from, to, tagged, &model.DerivedEventsConfig{}, nil, repositories.LocationHidden)
+		require.NoError(t, err)
+		require.Len(t, result, 1)
+		require.Equal(t, "event2", result[0].Name)
+	})
+
+	t.Run("invalid derived events config", func(t *testing.T) {
+		_, err := repo.GetEvents(context.Background(), tokenID, from, to, filter, &model.DerivedEventsConfig{HarshBrakingThreshold: ref(50.0)}, nil, repositories.LocationHidden)
+		require.Error(t, err)
+	})
+
+	t.Run("limit", func(t *testing.T) {
+		mocks.CHService.EXPECT().
+			GetEvents(gomock.Any(), subject, from, to, filter, ch.EventQueryOptions{Limit: 1}).
+			Return(vssEvents, nil)
+		result, err := repo.GetEvents(context.Background(), tokenID, from, to, filter, nil, ref(1), repositories.LocationHidden)
+		require.NoError(t, err)
+		require.Len(t, result, 1)
+		require.Equal(t, "event1", result[0].Name)
+	})
+
+	t.Run("limit above maximum", func(t *testing.T) {
+		_, err := repo.GetEvents(context.Background(), tokenID, from, to, filter, nil, ref(1001), repositories.LocationHidden)
+		require.Error(t, err)
+	})
 }
 
 func ref[T any](t T) *T {
@@ -532,19 +604,19 @@ func TestGetDataSummaryFiltersUnqueryableSignals(t *testing.T) {
 
 	mocks := setupMocks(t)
 	mocks.CHService.EXPECT().
-		GetSignalSummaries(gomock.Any(), testSubject, nil).
+		GetSignalSummaries(gomock.Any(), testSubject, nil, nil, nil).
 		Return([]*model.SignalDataSummary{
 			{Name: "speed", NumberOfSignals: 10, FirstSeen: queryableSeen, LastSeen: queryableSeen},
 			{Name: "currentLocationIsRedacted", NumberOfSignals: 5, FirstSeen: staleSeen, LastSeen: staleSeen},
 		}, nil)
 	mocks.CHService.EXPECT().
-		GetEventSummaries(gomock.Any(), testSubject).
+		GetEventSummaries(gomock.Any(), testSubject, nil, nil).
 		Return(nil, nil)
 
 	repo, err := repositories.NewRepository(mocks.CHService, baseSettings)
 	require.NoError(t, err)
 
-	result, err := repo.GetDataSummary(context.Background(), 1, nil)
+	result, err := repo.GetDataSummary(context.Background(), 1, nil, nil, nil)
 	require.NoError(t, err)
 
 	require.Equal(t, []string{"speed"}, result.AvailableSignals)
@@ -554,3 +626,223 @@ func TestGetDataSummaryFiltersUnqueryableSignals(t *testing.T) {
 	require.Equal(t, queryableSeen, result.FirstSeen, "first/last seen must exclude unqueryable signals")
 	require.Equal(t, queryableSeen, result.LastSeen)
 }
+
+func TestGetDataSummaryTimeRange(t *testing.T) {
+	testSubject := cloudevent.ERC721DID{
+		ChainID:         baseSettings.ChainID,
+		ContractAddress: baseSettings.VehicleNFTAddress,
+		TokenID:         big.NewInt(1),
+	}.String()
+	from := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("range is passed through", func(t *testing.T) {
+		mocks := setupMocks(t)
+		mocks.CHService.EXPECT().
+			GetSignalSummaries(gomock.Any(), testSubject, &from, &to, nil).
+			Return([]*model.SignalDataSummary{
+				{Name: "speed", NumberOfSignals: 3, FirstSeen: from, LastSeen: from.Add(time.Hour)},
+			}, nil)
+		mocks.CHService.EXPECT().
+			GetEventSummaries(gomock.Any(), testSubject, &from, &to).
+			Return(nil, nil)
+
+		repo, err := repositories.NewRepository(mocks.CHService, baseSettings)
+		require.NoError(t, err)
+
+		result, err := repo.GetDataSummary(context.Background(), 1, &from, &to, nil)
+		require.NoError(t, err)
+		require.Equal(t, uint64(3), result.NumberOfSignals)
+		require.Equal(t, from, result.FirstSeen)
+		require.Equal(t, from.Add(time.Hour), result.LastSeen)
+	})
+
+	t.Run("from after to", func(t *testing.T) {
+		mocks := setupMocks(t)
+		repo, err := repositories.NewRepository(mocks.CHService, baseSettings)
+		require.NoError(t, err)
+
+		_, err = repo.GetDataSummary(context.Background(), 1, &to, &from, nil)
+		require.Error(t, err)
+	})
+
+	t.Run("one bound", func(t *testing.T) {
+		mocks := setupMocks(t)
+		repo, err := repositories.NewRepository(mocks.CHService, baseSettings)
+		require.NoError(t, err)
+
+		_, err = repo.GetDataSummary(context.Background(), 1, &from, nil, nil)
+		require.Error(t, err)
+		_, err = repo.GetAvailableSignals(context.Background(), 1, nil, &to, nil)
+		require.Error(t, err)
+	})
+
+	t.Run("range too long", func(t *testing.T) {
+		mocks := setupMocks(t)
+		repo, err := repositories.NewRepository(mocks.CHService, baseSettings)
+		require.NoError(t, err)
+
+		later := from.AddDate(2, 0, 0)
+		_, err = repo.GetDataSummary(context.Background(), 1, &from, &later, nil)
+		require.Error(t, err)
+	})
+}
+
+func TestGetEventsAggregated(t *testing.T) {
+	subject := cloudevent.ERC721DID{
+		ChainID:         baseSettings.ChainID,
+		ContractAddress: baseSettings.VehicleNFTAddress,
+		TokenID:         big.NewInt(1),
+	}.String()
+	from := time.Date(2024, 6, 12, 0, 0, 0, 0, time.UTC)
+	to := from.Add(24 * time.Hour)
+	interval := time.Hour.Microseconds()
+	aggs := []*model.EventAggregation{
+		{Timestamp: from, Name: "behavior.harshBraking", Count: 3, DurationNs: 1500},
+	}
+
+	mocks := setupMocks(t)
+	repo, err := repositories.NewRepository(mocks.CHService, baseSettings)
+	require.NoError(t, err)
+
+	t.Run("success", func(t *testing.T) {
+		mocks.CHService.EXPECT().
+			GetEventAggregations(gomock.Any(), subject, from, to, interval, nil).
+			Return(aggs, nil)
+		result, err := repo.GetEventsAggregated(context.Background(), 1, from, to, interval, nil)
+		require.NoError(t, err)
+		require.Equal(t, aggs, result)
+	})
+
+	t.Run("invalid interval", func(t *testing.T) {
+		_, err := repo.GetEventsAggregated(context.Background(), 1, from, to, 0, nil)
+		require.Error(t, err)
+	})
+}
+
+func TestGetSegmentByID(t *testing.T) {
+	testSubject := cloudevent.ERC721DID{
+		ChainID:         baseSettings.ChainID,
+		ContractAddress: baseSettings.VehicleNFTAddress,
+		TokenID:         big.NewInt(1),
+	}.String()
+	mechanism := model.DetectionMechanismIgnitionDetection
+	start := time.Now().Add(-48 * time.Hour).Truncate(time.Microsecond).UTC()
+	end := start.Add(30 * time.Minute)
+	segment := func() *model.Segment {
+		return &model.Segment{Start: &model.SignalLocation{Timestamp: start}, End: &model.SignalLocation{Timestamp: end}, Duration: 1800}
+	}
+
+	mocks := setupMocks(t)
+	mocks.CHService.EXPECT().GetSummariesForRanges(gomock.Any(), testSubject, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	mocks.CHService.EXPECT().
+		GetSegments(gomock.Any(), testSubject, start.Add(-time.Hour), gomock.Any(), mechanism, nil).
+		Return([]*model.Segment{segment()}, nil)
+	// The lookup detects from the segment start, over a day.
+	mocks.CHService.EXPECT().
+		GetSegments(gomock.Any(), testSubject, start, start.Add(24*time.Hour), mechanism, nil).
+		Return([]*model.Segment{segment()}, nil)
+
+	repo, err := repositories.NewRepository(mocks.CHService, baseSettings)
+	require.NoError(t, err)
+
+	listed, err := repo.GetSegments(context.Background(), 1, start.Add(-time.Hour), start.Add(time.Hour), mechanism, nil, nil, nil, nil, nil, repositories.SegmentOptions{})
+	require.NoError(t, err)
+	require.Len(t, listed, 1)
+	require.NotEmpty(t, listed[0].ID)
+
+	found, err := repo.GetSegment(context.Background(), 1, listed[0].ID, mechanism, nil, nil, nil, repositories.SegmentOptions{})
+	require.NoError(t, err)
+	require.NotNil(t, found)
+	require.Equal(t, listed[0].ID, found.ID)
+	require.Equal(t, end, found.End.Timestamp)
+
+	// The ID was issued for token 1.
+	found, err = repo.GetSegment(context.Background(), 2, listed[0].ID, mechanism, nil, nil, nil, repositories.SegmentOptions{})
+	require.NoError(t, err)
+	require.Nil(t, found)
+
+	_, err = repo.GetSegment(context.Background(), 1, listed[0].ID, model.DetectionMechanismFrequencyAnalysis, nil, nil, nil, repositories.SegmentOptions{})
+	require.Error(t, err)
+}
+
+func TestGetSegmentsAutoMechanism(t *testing.T) {
+	testSubject := cloudevent.ERC721DID{
+		ChainID:         baseSettings.ChainID,
+		ContractAddress: baseSettings.VehicleNFTAddress,
+		TokenID:         big.NewInt(1),
+	}.String()
+	start := time.Now().Add(-48 * time.Hour).Truncate(time.Microsecond).UTC()
+	end := start.Add(30 * time.Minute)
+	segment := func() *model.Segment {
+		return &model.Segment{Start: &model.SignalLocation{Timestamp: start}, End: &model.SignalLocation{Timestamp: end}, Duration: 1800}
+	}
+	lastSeen := time.Now().Add(-time.Hour)
+
+	mocks := setupMocks(t)
+	mocks.CHService.EXPECT().GetSummariesForRanges(gomock.Any(), testSubject, gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+	// Only the listing resolves auto; the lookup reuses the mechanism in the ID.
+	mocks.CHService.EXPECT().
+		GetSignalSummaries(gomock.Any(), testSubject, nil, nil, nil).
+		Return([]*model.SignalDataSummary{{Name: vss.FieldIsIgnitionOn, NumberOfSignals: 500, LastSeen: lastSeen}}, nil)
+	mocks.CHService.EXPECT().
+		GetSegments(gomock.Any(), testSubject, start.Add(-time.Hour), gomock.Any(), model.DetectionMechanismIgnitionDetection, nil).
+		Return([]*model.Segment{segment()}, nil)
+	mocks.CHService.EXPECT().
+		GetSegments(gomock.Any(), testSubject, start, start.Add(24*time.Hour), model.DetectionMechanismIgnitionDetection, nil).
+		Return([]*model.Segment{segment()}, nil)
+
+	repo, err := repositories.NewRepository(mocks.CHService, baseSettings)
+	require.NoError(t, err)
+
+	listed, err := repo.GetSegments(context.Background(), 1, start.Add(-time.Hour), start.Add(time.Hour), model.DetectionMechanismAuto, nil, nil, nil, nil, nil, repositories.SegmentOptions{})
+	require.NoError(t, err)
+	require.Len(t, listed, 1)
+	require.Equal(t, model.DetectionMechanismIgnitionDetection, listed[0].Mechanism)
+
+	found, err := repo.GetSegment(context.Background(), 1, listed[0].ID, model.DetectionMechanismAuto, nil, nil, nil, repositories.SegmentOptions{})
+	require.NoError(t, err)
+	require.NotNil(t, found)
+	require.Equal(t, listed[0].ID, found.ID)
+	require.Equal(t, model.DetectionMechanismIgnitionDetection, found.Mechanism)
+}
+
+func TestGetDailyActivitySummaries(t *testing.T) {
+	testSubject := cloudevent.ERC721DID{
+		ChainID:         baseSettings.ChainID,
+		ContractAddress: baseSettings.VehicleNFTAddress,
+		TokenID:         big.NewInt(1),
+	}.String()
+	mechanism := model.DetectionMechanismIgnitionDetection
+	from := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(48 * time.Hour)
+	days := []ch.SummaryRange{
+		{From: from, To: from.Add(24 * time.Hour), SignalsTo: from.Add(24 * time.Hour)},
+		{From: from.Add(24 * time.Hour), To: to, SignalsTo: to},
+		{From: to, To: to.Add(24 * time.Hour), SignalsTo: to.Add(24 * time.Hour)},
+	}
+
+	mocks := setupMocks(t)
+	mocks.CHService.EXPECT().
+		GetSegments(gomock.Any(), testSubject, from, from.Add(72*time.Hour), mechanism, nil).
+		Return([]*model.Segment{}, nil)
+	// Every day is summarized in one call.
+	mocks.CHService.EXPECT().
+		GetSummariesForRanges(gomock.Any(), testSubject, days, gomock.Any(), gomock.Any(), []string{"harshBraking"}).
+		Return([]*ch.RangeSummary{
+			{Aggs: []*ch.AggSignal{}, EventCounts: []*ch.EventCount{{Name: "harshBraking", Count: 2}}},
+			{Aggs: []*ch.AggSignal{}, EventCounts: []*ch.EventCount{}},
+			{Aggs: []*ch.AggSignal{}, EventCounts: []*ch.EventCount{{Name: "harshBraking", Count: 1}}},
+		}, nil)
+
+	repo, err := repositories.NewRepository(mocks.CHService, baseSettings)
+	require.NoError(t, err)
+
+	activity, err := repo.GetDailyActivity(context.Background(), 1, from, to, mechanism, nil, nil,
+		[]*model.SegmentEventRequest{{Name: "harshBraking"}}, nil, repositories.SegmentOptions{})
+	require.NoError(t, err)
+	require.Len(t, activity, 3)
+	require.Equal(t, []*model.EventCount{{Name: "harshBraking", Count: 2}}, activity[0].EventCounts)
+	require.Equal(t, []*model.EventCount{{Name: "harshBraking", Count: 0}}, activity[1].EventCounts)
+	require.Equal(t, []*model.EventCount{{Name: "harshBraking", Count: 1}}, activity[2].EventCounts)
+}
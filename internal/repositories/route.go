@@ -0,0 +1,146 @@
+package repositories
+
+import (
+	"encoding/json"
+	"math"
+	"strings"
+
+	"github.com/DIMO-Network/telemetry-api/internal/graph/model"
+	"github.com/DIMO-Network/telemetry-api/internal/service/ch"
+)
+
+const (
+	defaultRouteToleranceMeters = 10
+	maxRouteToleranceMeters     = 1000
+	// metersPerDegree is the length of a degree of latitude, close enough for simplification.
+	metersPerDegree = 111_320
+)
+
+// buildSegmentRoute turns the location samples of a segment, in timestamp order, into a route
+// simplified with toleranceMeters. Returns nil if there are no points.
+func buildSegmentRoute(samples []*ch.LocationForRange, toleranceMeters float64, format model.RouteFormat) (*model.SegmentRoute, error) {
+	points := make([]*model.Location, 0, len(samples))
+	for i := range samples {
+		loc := locationToModel(&samples[i].Location, LocationExact)
+		// Points repeat while the vehicle is parked.
+		if n := len(points); n > 0 && points[n-1].Latitude == loc.Latitude && points[n-1].Longitude == loc.Longitude {
+			continue
+		}
+		points = append(points, loc)
+	}
+	if len(points) == 0 {
+		return nil, nil
+	}
+	points = simplifyRoute(points, toleranceMeters)
+
+	route := &model.SegmentRoute{
+		Format:     format,
+		PointCount: len(points),
+	}
+	if format == model.RouteFormatGeojson {
+		value, err := geoJSONLineString(points)
+		if err != nil {
+			return nil, err
+		}
+		route.Value = value
+	} else {
+		route.Value = encodePolyline(points)
+	}
+	return route, nil
+}
+
+// simplifyRoute applies the Douglas-Peucker algorithm: points closer than toleranceMeters to the
+// simplified path are dropped. Distances are computed on an equirectangular projection around the
+// first point, which is accurate enough at trip scale.
+func simplifyRoute(points []*model.Location, toleranceMeters float64) []*model.Location {
+	if len(points) <= 2 || toleranceMeters <= 0 {
+		return points
+	}
+	cosLat := math.Cos(points[0].Latitude * math.Pi / 180)
+	xs := make([]float64, len(points))
+	ys := make([]float64, len(points))
+	for i, p := range points {
+		xs[i] = (p.Longitude - points[0].Longitude) * cosLat * metersPerDegree
+		ys[i] = (p.Latitude - points[0].Latitude) * metersPerDegree
+	}
+
+	keep := make([]bool, len(points))
+	keep[0], keep[len(points)-1] = true, true
+	// An explicit stack instead of recursion: long trips have tens of thousands of points.
+	stack := [][2]int{{0, len(points) - 1}}
+	for len(stack) > 0 {
+		first, last := stack[len(stack)-1][0], stack[len(stack)-1][1]
+		stack = stack[:len(stack)-1]
+		maxDist, maxIdx := 0.0, -1
+		for i := first + 1; i < last; i++ {
+			if d := segmentDistance(xs[i], ys[i], xs[first], ys[first], xs[last], ys[last]); d > maxDist {
+				maxDist, maxIdx = d, i
+			}
+		}
+		if maxIdx >= 0 && maxDist > toleranceMeters {
+			keep[maxIdx] = true
+			stack = append(stack, [2]int{first, maxIdx}, [2]int{maxIdx, last})
+		}
+	}
+
+	out := make([]*model.Location, 0, len(points))
+	for i, p := range points {
+		if keep[i] {
+			out = append(out, p)
+		}
+	}
+	return out
+}
+
+// segmentDistance returns the distance from point (px, py) to the line segment (ax, ay)-(bx, by).
+func segmentDistance(px, py, ax, ay, bx, by float64) float64 {
+	dx, dy := bx-ax, by-ay
+	if dx == 0 && dy == 0 {
+		return math.Hypot(px-ax, py-ay)
+	}
+	t := ((px-ax)*dx + (py-ay)*dy) / (dx*dx + dy*dy)
+	t = math.Max(0, math.Min(1, t))
+	return math.Hypot(px-(ax+t*dx), py-(ay+t*dy))
+}
+
+// encodePolyline encodes points in the encoded polyline algorithm format with precision 5.
+func encodePolyline(points []*model.Location) string {
+	var sb strings.Builder
+	var prevLat, prevLng int64
+	for _, p := range points {
+		lat := int64(math.Round(p.Latitude * 1e5))
+		lng := int64(math.Round(p.Longitude * 1e5))
+		encodePolylineValue(&sb, lat-prevLat)
+		encodePolylineValue(&sb, lng-prevLng)
+		prevLat, prevLng = lat, lng
+	}
+	return sb.String()
+}
+
+func encodePolylineValue(sb *strings.Builder, v int64) {
+	u := v << 1
+	if v < 0 {
+		u = ^u
+	}
+	for u >= 0x20 {
+		sb.WriteByte(byte((0x20 | (u & 0x1f)) + 63))
+		u >>= 5
+	}
+	sb.WriteByte(byte(u + 63))
+}
+
+// geoJSONLineString returns points as a GeoJSON LineString geometry.
+func geoJSONLineString(points []*model.Location) (string, error) {
+	coordinates := make([][2]float64, len(points))
+	for i, p := range points {
+		coordinates[i] = [2]float64{p.Longitude, p.Latitude}
+	}
+	b, err := json.Marshal(struct {
+		Type        string       `json:"type"`
+		Coordinates [][2]float64 `json:"coordinates"`
+	}{Type: "LineString", Coordinates: coordinates})
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
@@ -0,0 +1,93 @@
+package repositories
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DIMO-Network/model-garage/pkg/vss"
+	"github.com/DIMO-Network/telemetry-api/internal/graph/model"
+	"github.com/DIMO-Network/telemetry-api/internal/service/ch"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodePolyline(t *testing.T) {
+	// Example from the encoded polyline algorithm format documentation.
+	points := []*model.Location{
+		{Latitude: 38.5, Longitude: -120.2},
+		{Latitude: 40.7, Longitude: -120.95},
+		{Latitude: 43.252, Longitude: -126.453},
+	}
+	require.Equal(t, "_p~iF~ps|U_ulLnnqC_mqNvxq`@", encodePolyline(points))
+	require.Empty(t, encodePolyline(nil))
+}
+
+func TestSimplifyRoute(t *testing.T) {
+	// A straight line east with 1 m of noise, then a 90 degree turn north.
+	points := []*model.Location{
+		{Latitude: 40.0, Longitude: -74.0},
+		{Latitude: 40.00001, Longitude: -73.999},
+		{Latitude: 40.0, Longitude: -73.998},
+		{Latitude: 40.0, Longitude: -73.997},
+		{Latitude: 40.001, Longitude: -73.997},
+		{Latitude: 40.002, Longitude: -73.997},
+	}
+
+	simplified := simplifyRoute(points, 10)
+	require.Equal(t, []*model.Location{points[0], points[3], points[5]}, simplified)
+
+	// Zero tolerance keeps every point.
+	require.Equal(t, points, simplifyRoute(points, 0))
+	// With a tolerance below the noise, only the point in the middle of the northbound leg goes.
+	require.Equal(t, []*model.Location{points[0], points[1], points[2], points[3], points[5]}, simplifyRoute(points, 0.5))
+}
+
+func TestBuildSegmentRoute(t *testing.T) {
+	samples := []vss.Location{
+		{Latitude: 38.5, Longitude: -120.2},
+		{Latitude: 40.7, Longitude: -120.95},
+		{Latitude: 43.252, Longitude: -126.453},
+	}
+
+	t.Run("polyline", func(t *testing.T) {
+		route, err := buildSegmentRoute(rangeLocations(samples...), 10, model.RouteFormatPolyline)
+		require.NoError(t, err)
+		require.Equal(t, &model.SegmentRoute{
+			Format:     model.RouteFormatPolyline,
+			Value:      "_p~iF~ps|U_ulLnnqC_mqNvxq`@",
+			PointCount: 3,
+		}, route)
+	})
+
+	t.Run("geojson", func(t *testing.T) {
+		route, err := buildSegmentRoute(rangeLocations(samples[:2]...), 10, model.RouteFormatGeojson)
+		require.NoError(t, err)
+		require.Equal(t, `{"type":"LineString","coordinates":[[-120.2,38.5],[-120.95,40.7]]}`, route.Value)
+		require.Equal(t, 2, route.PointCount)
+	})
+
+	t.Run("repeated points", func(t *testing.T) {
+		route, err := buildSegmentRoute(rangeLocations(
+			vss.Location{Latitude: 38.5, Longitude: -120.2},
+			vss.Location{Latitude: 38.5, Longitude: -120.2},
+			vss.Location{Latitude: 40.7, Longitude: -120.95},
+		), 0, model.RouteFormatGeojson)
+		require.NoError(t, err)
+		require.Equal(t, 2, route.PointCount)
+	})
+
+	t.Run("no samples", func(t *testing.T) {
+		route, err := buildSegmentRoute(nil, 10, model.RouteFormatPolyline)
+		require.NoError(t, err)
+		require.Nil(t, route)
+	})
+}
+
+// rangeLocations wraps locations as the samples of one segment, one minute apart.
+func rangeLocations(locations ...vss.Location) []*ch.LocationForRange {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	out := make([]*ch.LocationForRange, len(locations))
+	for i, loc := range locations {
+		out[i] = &ch.LocationForRange{Timestamp: base.Add(time.Duration(i) * time.Minute), Location: loc}
+	}
+	return out
+}
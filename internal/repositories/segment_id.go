@@ -0,0 +1,96 @@
+package repositories
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/DIMO-Network/server-garage/pkg/gql/errorhandler"
+	"github.com/DIMO-Network/telemetry-api/internal/graph/model"
+)
+
+// segmentLookupWindows are the ranges after a segment's start searched by GetSegment, shortest first.
+// The longer window is only queried if the segment may run past the shorter one: it is still open or
+// ends within segmentLookupEndMargin of the window end.
+var segmentLookupWindows = []time.Duration{24 * time.Hour, maxDateRangeDays * 24 * time.Hour}
+
+// segmentLookupEndMargin exceeds the largest maxGapSeconds, so a segment cut at the window end is retried.
+const segmentLookupEndMargin = 2 * time.Hour
+
+// segmentID returns the ID of the segment of subject detected by mechanism that starts at start:
+// "<mechanism>-<start in unix microseconds>-<checksum>". The checksum covers all three parts, so
+// an ID only resolves for the vehicle it was issued for.
+func segmentID(subject string, mechanism model.DetectionMechanism, start time.Time) string {
+	prefix := string(mechanism) + "-" + strconv.FormatInt(start.UnixMicro(), 10)
+	sum := sha256.Sum256([]byte(subject + "|" + prefix))
+	return prefix + "-" + hex.EncodeToString(sum[:6])
+}
+
+// parseSegmentID returns the mechanism and start encoded in id. It does not verify the checksum.
+func parseSegmentID(id string) (model.DetectionMechanism, time.Time, error) {
+	parts := strings.Split(id, "-")
+	if len(parts) != 3 {
+		return "", time.Time{}, fmt.Errorf("invalid segment id %q", id)
+	}
+	mechanism := model.DetectionMechanism(parts[0])
+	if !mechanism.IsValid() {
+		return "", time.Time{}, fmt.Errorf("invalid segment id %q: unknown mechanism", id)
+	}
+	micros, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("invalid segment id %q: bad start", id)
+	}
+	return mechanism, time.UnixMicro(micros).UTC(), nil
+}
+
+// GetSegment re-derives the segment with the given id, as returned by GetSegments with the same
+// mechanism and config, with its summaries. Detection starts at the segment start, so a segment that
+// began before the range of the original query is found by its clipped start. With auto, any id from a
+// mechanism auto chooses is accepted. Returns nil if the vehicle has no such segment.
+func (r *Repository) GetSegment(ctx context.Context, tokenID int, id string, mechanism model.DetectionMechanism, config *model.SegmentConfig, signalRequests []*model.SegmentSignalRequest, eventRequests []*model.SegmentEventRequest, opts SegmentOptions) (*model.Segment, error) {
+	idMechanism, start, err := parseSegmentID(id)
+	if err != nil {
+		return nil, errorhandler.NewBadRequestError(ctx, err)
+	}
+	if mechanism == model.DetectionMechanismAuto {
+		// The id records the mechanism auto chose, which is reused so the segment is found even if
+		// auto would choose differently today.
+		if _, ok := autoMechanisms[idMechanism]; ok {
+			mechanism = idMechanism
+		}
+	}
+	if idMechanism != mechanism {
+		return nil, errorhandler.NewBadRequestError(ctx, fmt.Errorf("segment id %q was not detected by mechanism %s", id, mechanism))
+	}
+	if tokenID <= 0 {
+		return nil, errorhandler.NewBadRequestError(ctx, fmt.Errorf("invalid tokenID: %d", tokenID))
+	}
+	if segmentID(r.toSubject(uint32(tokenID)), mechanism, start) != id {
+		return nil, nil
+	}
+	if !start.Before(time.Now()) {
+		return nil, nil
+	}
+
+	limit := 1
+	for i, window := range segmentLookupWindows {
+		to := start.Add(window)
+		segments, err := r.GetSegments(ctx, tokenID, start, to, mechanism, config, signalRequests, eventRequests, &limit, nil, opts)
+		if err != nil {
+			return nil, err
+		}
+		if len(segments) == 0 || segments[0].ID != id {
+			return nil, nil
+		}
+		seg := segments[0]
+		last := i == len(segmentLookupWindows)-1
+		if last || !to.Before(time.Now()) || (seg.End != nil && to.Sub(seg.End.Timestamp) > segmentLookupEndMargin) {
+			return seg, nil
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,29 @@
+package repositories
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DIMO-Network/telemetry-api/internal/graph/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSegmentID(t *testing.T) {
+	start := time.Date(2024, 1, 1, 8, 30, 0, 123456000, time.UTC)
+	id := segmentID("did:erc721:1:0x1:1", model.DetectionMechanismIgnitionDetection, start)
+
+	require.Regexp(t, `^ignitionDetection-1704097800123456-[0-9a-f]{12}$`, id)
+	require.Equal(t, id, segmentID("did:erc721:1:0x1:1", model.DetectionMechanismIgnitionDetection, start), "IDs are deterministic")
+	require.NotEqual(t, id, segmentID("did:erc721:1:0x1:2", model.DetectionMechanismIgnitionDetection, start))
+	require.NotEqual(t, id, segmentID("did:erc721:1:0x1:1", model.DetectionMechanismFrequencyAnalysis, start))
+
+	mechanism, parsedStart, err := parseSegmentID(id)
+	require.NoError(t, err)
+	require.Equal(t, model.DetectionMechanismIgnitionDetection, mechanism)
+	require.True(t, parsedStart.Equal(start))
+
+	for _, bad := range []string{"", "ignitionDetection-1704097800123456", "walking-1704097800123456-abc", "ignitionDetection-x-abc"} {
+		_, _, err := parseSegmentID(bad)
+		require.Error(t, err, bad)
+	}
+}
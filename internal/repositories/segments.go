@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"math/big"
+	"slices"
 	"sort"
 	"time"
 
@@ -19,20 +20,22 @@ const (
 	maxDateRangeDays = 32
 	// maxDateRangeDuration: 31 days + 1 second so exactly-31-day requests don't flake on boundary
 	maxDateRangeDuration = maxDateRangeDays*24*time.Hour + time.Second
-	maxSegmentLimit      = 200
+	// maxStoredDateRangeDays is the segment date range limit when the segment store covers the range.
+	maxStoredDateRangeDays = 366
+	maxSegmentLimit        = 200
 )
 
-// validateSegmentDateRange returns an error if the range from-to exceeds the maximum allowed (31 days + 1 second).
+// validateSegmentDateRange returns an error if the range from-to exceeds maxDays (plus 1 second).
 // Used by both segments and dailyActivity.
-func validateSegmentDateRange(from, to time.Time) error {
-	if to.Sub(from) > maxDateRangeDuration {
-		return fmt.Errorf("date range exceeds maximum of %d days", maxDateRangeDays)
+func validateSegmentDateRange(from, to time.Time, maxDays int) error {
+	if to.Sub(from) > time.Duration(maxDays)*24*time.Hour+time.Second {
+		return fmt.Errorf("date range exceeds maximum of %d days", maxDays)
 	}
 	return nil
 }
 
 // validateSegmentArgs validates the arguments for segment queries.
-func validateSegmentArgs(tokenID int, from, to time.Time) error {
+func validateSegmentArgs(tokenID int, from, to time.Time, maxDays int) error {
 	if tokenID <= 0 {
 		return fmt.Errorf("invalid tokenID: %d", tokenID)
 	}
@@ -47,7 +50,7 @@ func validateSegmentArgs(tokenID int, from, to time.Time) error {
 
 	// to in future is handled by caller (GetSegments caps to now before calling)
 
-	if err := validateSegmentDateRange(from, to); err != nil {
+	if err := validateSegmentDateRange(from, to, maxDays); err != nil {
 		return err
 	}
 
@@ -55,9 +58,18 @@ func validateSegmentArgs(tokenID int, from, to time.Time) error {
 }
 
 // validateSegmentConfig validates the segment configuration parameters.
-// When mechanism is idling, also validates idling-specific fields; refuel/recharge validate minIncreasePercent.
+// When mechanism is idling, also validates idling-specific fields; refuel/recharge validate minIncreasePercent;
+// threshold validates thresholdSignal and thresholdFilter; geofence validates geofence; stops validates stopRadiusMeters;
+// recharge also validates batteryCapacityKwh; refuel and fuelDrop also validate fuelTankCapacityLiters and fuelPricePerLiter;
+// fuelDrop validates minDropPercent. routeToleranceMeters is validated for all mechanisms.
 func validateSegmentConfig(config *model.SegmentConfig, mechanism model.DetectionMechanism) error {
 	if config == nil {
+		switch mechanism {
+		case model.DetectionMechanismThreshold:
+			return fmt.Errorf("threshold mechanism requires thresholdSignal and thresholdFilter")
+		case model.DetectionMechanismGeofence:
+			return fmt.Errorf("geofence mechanism requires geofence with inPolygon or inCircle")
+		}
 		return nil
 	}
 
@@ -79,6 +91,12 @@ func validateSegmentConfig(config *model.SegmentConfig, mechanism model.Detectio
 		}
 	}
 
+	if config.RouteToleranceMeters != nil {
+		if *config.RouteToleranceMeters < 0 || *config.RouteToleranceMeters > maxRouteToleranceMeters {
+			return fmt.Errorf("routeToleranceMeters must be between 0 and 1000")
+		}
+	}
+
 	if mechanism == model.DetectionMechanismIdling {
 		if config.MaxIdleRpm != nil {
 			if *config.MaxIdleRpm < 300 || *config.MaxIdleRpm > 3000 {
@@ -87,6 +105,56 @@ func validateSegmentConfig(config *model.SegmentConfig, mechanism model.Detectio
 		}
 	}
 
+	if mechanism == model.DetectionMechanismThreshold {
+		if err := validateThresholdConfig(config); err != nil {
+			return err
+		}
+	}
+
+	if mechanism == model.DetectionMechanismGeofence {
+		if config.Geofence == nil || (len(config.Geofence.InPolygon) == 0 && config.Geofence.InCircle == nil) {
+			return fmt.Errorf("geofence mechanism requires geofence with inPolygon or inCircle")
+		}
+		if err := validateLocationFilter(config.Geofence); err != nil {
+			return err
+		}
+	}
+
+	if mechanism == model.DetectionMechanismStops {
+		if config.StopRadiusMeters != nil {
+			if *config.StopRadiusMeters < 10 || *config.StopRadiusMeters > 1000 {
+				return fmt.Errorf("stopRadiusMeters must be between 10 and 1000")
+			}
+		}
+	}
+
+	if mechanism == model.DetectionMechanismRecharge {
+		if config.BatteryCapacityKwh != nil {
+			if *config.BatteryCapacityKwh < 1 || *config.BatteryCapacityKwh > 1000 {
+				return fmt.Errorf("batteryCapacityKwh must be between 1 and 1000")
+			}
+		}
+	}
+
+	if mechanism == model.DetectionMechanismFuelDrop {
+		if config.MinDropPercent != nil {
+			if *config.MinDropPercent < 1 || *config.MinDropPercent > 100 {
+				return fmt.Errorf("minDropPercent must be between 1 and 100")
+			}
+		}
+	}
+
+	if mechanism == model.DetectionMechanismRefuel || mechanism == model.DetectionMechanismFuelDrop {
+		if config.FuelTankCapacityLiters != nil {
+			if *config.FuelTankCapacityLiters < 1 || *config.FuelTankCapacityLiters > 2000 {
+				return fmt.Errorf("fuelTankCapacityLiters must be between 1 and 2000")
+			}
+		}
+		if config.FuelPricePerLiter != nil && *config.FuelPricePerLiter <= 0 {
+			return fmt.Errorf("fuelPricePerLiter must be positive")
+		}
+	}
+
 	if mechanism == model.DetectionMechanismRefuel || mechanism == model.DetectionMechanismRecharge {
 		if config.MinIncreasePercent != nil {
 			if *config.MinIncreasePercent < 1 || *config.MinIncreasePercent > 100 {
@@ -98,6 +166,21 @@ func validateSegmentConfig(config *model.SegmentConfig, mechanism model.Detectio
 	return nil
 }
 
+// validateThresholdConfig checks that the threshold mechanism has a float signal and a filter with a condition.
+func validateThresholdConfig(config *model.SegmentConfig) error {
+	if config.ThresholdSignal == nil || config.ThresholdFilter == nil {
+		return fmt.Errorf("threshold mechanism requires thresholdSignal and thresholdFilter")
+	}
+	spec, ok := model.SignalSpecs[*config.ThresholdSignal]
+	if !ok || spec.ValueType != "Float" {
+		return fmt.Errorf("thresholdSignal %q is not a float signal", *config.ThresholdSignal)
+	}
+	if !ch.HasFloatCondition(config.ThresholdFilter) {
+		return fmt.Errorf("thresholdFilter has no condition")
+	}
+	return nil
+}
+
 // validateSegmentLimit validates optional pagination limit. If non-nil, must be in [1, maxSegmentLimit].
 func validateSegmentLimit(limit *int) error {
 	if limit == nil {
@@ -123,8 +206,9 @@ var (
 	// Mechanisms not listed fall through to baseSignalSet.
 	mechanismSignalSets = map[model.DetectionMechanism][]*model.SegmentSignalRequest{
 		model.DetectionMechanismIdling:   {sigSpeed, sigFuelFirst, sigFuelLast, sigOdoFirst, sigOdoLast},
-		model.DetectionMechanismRefuel:   {sigSpeed, sigFuelFirst, sigFuelLast, sigOdoFirst, sigOdoLast},
-		model.DetectionMechanismRecharge: {sigSpeed, sigSoCFirst, sigSoCLast, sigOdoFirst, sigOdoLast},
+		model.DetectionMechanismRefuel:   {sigSpeed, sigFuelFirst, sigFuelLast, sigOdoFirst, sigOdoLast, sigFuelAbsFirst, sigFuelAbsLast},
+		model.DetectionMechanismFuelDrop: {sigSpeed, sigFuelFirst, sigFuelLast, sigOdoFirst, sigOdoLast, sigFuelAbsFirst, sigFuelAbsLast},
+		model.DetectionMechanismRecharge: {sigSpeed, sigSoCFirst, sigSoCLast, sigOdoFirst, sigOdoLast, sigChargingPowerMax, sigChargingPowerAvg, sigChargeCurrentAC, sigGrossCapacity},
 	}
 
 	baseSignalSet = []*model.SegmentSignalRequest{sigSpeed, sigFuelFirst, sigFuelLast, sigSoCFirst, sigSoCLast, sigOdoFirst, sigOdoLast}
@@ -195,15 +279,34 @@ func sortSegmentSignals(signals []*model.SignalAggregationValue) {
 	})
 }
 
+// SegmentOptions select the optional segment fields that need extra queries.
+type SegmentOptions struct {
+	// WithRoute builds the route of each segment.
+	WithRoute bool
+	// WithDistance computes the distance traveled in each segment.
+	WithDistance bool
+}
+
+// maxSegmentRangeDays returns the longest date range of segment queries from from with config:
+// maxStoredDateRangeDays where the segment store covers it, maxDateRangeDays otherwise.
+func (r *Repository) maxSegmentRangeDays(from time.Time, config *model.SegmentConfig) int {
+	if r.segmentStoreEnabled && ch.SegmentStoreCovers(from, config) {
+		return maxStoredDateRangeDays
+	}
+	return maxDateRangeDays
+}
+
 // GetSegments returns segments detected using the specified mechanism in the time range.
 // Pagination: pass after (exclusive cursor = startTime of last segment from previous page) and limit (default 100, max 200).
 // Segments are ordered by startTime ascending. When after is set, only segments with startTime > after are requested from CH.
 // If to is in the future (e.g. client sent end-of-day in user TZ), it is capped to now so the query succeeds.
-func (r *Repository) GetSegments(ctx context.Context, tokenID int, from, to time.Time, mechanism model.DetectionMechanism, config *model.SegmentConfig, signalRequests []*model.SegmentSignalRequest, eventRequests []*model.SegmentEventRequest, limit *int, after *time.Time) ([]*model.Segment, error) {
+// The auto mechanism is resolved to a mechanism for the vehicle first; segments report the mechanism used.
+// Routes and distances are computed only when opts asks for them.
+func (r *Repository) GetSegments(ctx context.Context, tokenID int, from, to time.Time, mechanism model.DetectionMechanism, config *model.SegmentConfig, signalRequests []*model.SegmentSignalRequest, eventRequests []*model.SegmentEventRequest, limit *int, after *time.Time, opts SegmentOptions) ([]*model.Segment, error) {
 	if now := time.Now(); to.After(now) {
 		to = now
 	}
-	if err := validateSegmentArgs(tokenID, from, to); err != nil {
+	if err := validateSegmentArgs(tokenID, from, to, r.maxSegmentRangeDays(from, config)); err != nil {
 		return nil, errorhandler.NewBadRequestError(ctx, err)
 	}
 	if err := validateSegmentConfig(config, mechanism); err != nil {
@@ -221,6 +324,10 @@ func (r *Repository) GetSegments(ctx context.Context, tokenID int, from, to time
 	}
 
 	subject := r.toSubject(uint32(tokenID))
+	mechanism, err := r.resolveAutoMechanism(ctx, subject, mechanism)
+	if err != nil {
+		return nil, handleDBError(ctx, err)
+	}
 	chSegments, err := r.chService.GetSegments(ctx, subject, from, to, mechanism, config)
 	if err != nil {
 		return nil, handleDBError(ctx, err)
@@ -231,7 +338,14 @@ func (r *Repository) GetSegments(ctx context.Context, tokenID int, from, to time
 	}
 
 	// Default signal set is always included; client signalRequests are added on top (deduped by name+agg).
+	// Threshold segments also report the extremes of the threshold signal.
 	defaultReqs := defaultSegmentSignalSet(mechanism)
+	if mechanism == model.DetectionMechanismThreshold {
+		defaultReqs = append(slices.Clone(defaultReqs),
+			&model.SegmentSignalRequest{Name: *config.ThresholdSignal, Agg: model.FloatAggregationMin},
+			&model.SegmentSignalRequest{Name: *config.ThresholdSignal, Agg: model.FloatAggregationMax},
+		)
+	}
 	signalReqs := mergeSegmentSignalRequests(defaultReqs, signalRequests)
 	wantSummary := len(signalReqs) > 0 || len(eventRequests) > 0
 	var eventNames []string
@@ -241,18 +355,29 @@ func (r *Repository) GetSegments(ctx context.Context, tokenID int, from, to time
 			eventNames[i] = e.Name
 		}
 	}
+	// Without eventNames all event names are counted, including those the driver score needs.
+	_, scored := scoredMechanisms[mechanism]
+	countEventNames := eventNames
+	if scored && len(eventNames) > 0 {
+		countEventNames = append(slices.Clone(eventNames), scoreEventNames()...)
+	}
 
-	// For refuel/recharge, segment end is when the event is "done"; telemetry often has
+	// For refuel/recharge/fuelDrop, segment end is when the event is "done"; telemetry often has
 	// the first fuel/charge/odometer reading shortly after. Use an extended summary
 	// window for signal aggregation so those readings are included.
 	const summaryEndBuffer = 2 * time.Minute
-	extendSummaryEnd := mechanism == model.DetectionMechanismRefuel || mechanism == model.DetectionMechanismRecharge
+	extendSummaryEnd := mechanism == model.DetectionMechanismRefuel || mechanism == model.DetectionMechanismRecharge ||
+		mechanism == model.DetectionMechanismFuelDrop
+
+	withLocations := opts.WithRoute || opts.WithDistance
 
 	var eventCountsBySeg map[int]map[string]int
 	var aggsBySeg map[int][]*ch.AggSignal
-	if wantSummary && len(chSegments) > 0 {
+	var speedingIndex int
+	var locationsBySeg map[int][]*ch.LocationForRange
+	if (wantSummary || withLocations) && len(chSegments) > 0 {
 		ranges := make([]ch.TimeRange, len(chSegments))
-		aggRanges := make([]ch.TimeRange, len(chSegments))
+		summaryRanges := make([]ch.SummaryRange, len(chSegments))
 		var globalFrom, globalTo time.Time
 		for i, seg := range chSegments {
 			segTo := to
@@ -264,7 +389,7 @@ func (r *Repository) GetSegments(ctx context.Context, tokenID int, from, to time
 			if extendSummaryEnd {
 				summaryTo = segTo.Add(summaryEndBuffer)
 			}
-			aggRanges[i] = ch.TimeRange{From: seg.Start.Timestamp, To: summaryTo}
+			summaryRanges[i] = ch.SummaryRange{From: seg.Start.Timestamp, To: segTo, SignalsTo: summaryTo}
 			if i == 0 {
 				globalFrom, globalTo = seg.Start.Timestamp, summaryTo
 			} else {
@@ -277,43 +402,53 @@ func (r *Repository) GetSegments(ctx context.Context, tokenID int, from, to time
 			}
 		}
 		floatArgs, locationArgs := buildAggArgs(signalReqs)
-		var batchCounts []*ch.EventCountForRange
-		var batchAggs []*ch.AggSignalForRange
+		if scored {
+			// The last aggregation only feeds the driver score; it is past the indexes of the signals.
+			floatArgs = append(floatArgs, r.driverScorer.speedingArg())
+			speedingIndex = len(floatArgs) - 1
+		}
+		var summaries []*ch.RangeSummary
+		var batchLocations []*ch.LocationForRange
 		g, gctx := errgroup.WithContext(ctx)
-		g.Go(func() error {
-			var err error
-			batchCounts, err = r.chService.GetEventCountsForRanges(gctx, subject, ranges, eventNames)
-			return err
-		})
-		g.Go(func() error {
-			var err error
-			batchAggs, err = r.chService.GetAggregatedSignalsForRanges(gctx, subject, aggRanges, globalFrom, globalTo, floatArgs, locationArgs)
-			return err
-		})
+		if wantSummary {
+			g.Go(func() error {
+				var err error
+				summaries, err = r.chService.GetSummariesForRanges(gctx, subject, summaryRanges, floatArgs, locationArgs, countEventNames)
+				return err
+			})
+		}
+		if withLocations {
+			g.Go(func() error {
+				var err error
+				batchLocations, err = r.chService.GetLocationsForRanges(gctx, subject, ranges, globalFrom, globalTo)
+				return err
+			})
+		}
 		if err := g.Wait(); err != nil {
 			return nil, handleDBError(ctx, err)
 		}
-		eventCountsBySeg = make(map[int]map[string]int, len(chSegments))
-		for _, ec := range batchCounts {
-			if eventCountsBySeg[ec.SegIndex] == nil {
-				eventCountsBySeg[ec.SegIndex] = make(map[string]int)
+		if withLocations {
+			locationsBySeg = make(map[int][]*ch.LocationForRange, len(chSegments))
+			for _, l := range batchLocations {
+				locationsBySeg[l.SegIndex] = append(locationsBySeg[l.SegIndex], l)
+			}
+		}
+		if wantSummary {
+			eventCountsBySeg = make(map[int]map[string]int, len(chSegments))
+			aggsBySeg = make(map[int][]*ch.AggSignal, len(chSegments))
+			for i, summary := range summaries {
+				if len(summary.EventCounts) > 0 {
+					eventCountsBySeg[i] = eventCountsToMap(summary.EventCounts)
+				}
+				aggsBySeg[i] = summary.Aggs
 			}
-			eventCountsBySeg[ec.SegIndex][ec.Name] = ec.Count
-		}
-		aggsBySeg = make(map[int][]*ch.AggSignal, len(chSegments))
-		for _, a := range batchAggs {
-			aggsBySeg[a.SegIndex] = append(aggsBySeg[a.SegIndex], &ch.AggSignal{
-				SignalType:    a.SignalType,
-				SignalIndex:   a.SignalIndex,
-				ValueNumber:   a.ValueNumber,
-				ValueString:   a.ValueString,
-				ValueLocation: a.ValueLocation,
-			})
 		}
 	}
 
 	segments := chSegments
 	for i, seg := range segments {
+		seg.ID = segmentID(subject, mechanism, seg.Start.Timestamp)
+		seg.Mechanism = mechanism
 		if wantSummary {
 			var eventCounts []*ch.EventCount
 			if eventCountsBySeg != nil {
@@ -343,6 +478,51 @@ func (r *Repository) GetSegments(ctx context.Context, tokenID int, from, to time
 				seg.End.Value = summary.EndLocation
 			}
 		}
+		if scored && eventCountsBySeg != nil {
+			segEnd := to
+			if seg.End != nil {
+				segEnd = seg.End.Timestamp
+			}
+			seg.Score = r.driverScorer.segmentScore(seg, segEnd, eventCountsBySeg[i], floatAggValue(aggsBySeg[i], speedingIndex))
+		}
+		if mechanism == model.DetectionMechanismRecharge && wantSummary {
+			var capacityKwh *float64
+			if config != nil {
+				capacityKwh = config.BatteryCapacityKwh
+			}
+			seg.Charging = chargingSession(seg, capacityKwh)
+		}
+		if mechanism == model.DetectionMechanismRefuel && wantSummary {
+			var capacityLiters, pricePerLiter *float64
+			if config != nil {
+				capacityLiters, pricePerLiter = config.FuelTankCapacityLiters, config.FuelPricePerLiter
+			}
+			addRefuelVolume(seg, capacityLiters, pricePerLiter)
+		}
+		if mechanism == model.DetectionMechanismFuelDrop && wantSummary {
+			var capacityLiters *float64
+			if config != nil {
+				capacityLiters = config.FuelTankCapacityLiters
+			}
+			addFuelDropVolume(seg, capacityLiters)
+		}
+		if opts.WithRoute {
+			tolerance, format := float64(defaultRouteToleranceMeters), model.RouteFormatPolyline
+			if config != nil && config.RouteToleranceMeters != nil {
+				tolerance = *config.RouteToleranceMeters
+			}
+			if config != nil && config.RouteFormat != nil {
+				format = *config.RouteFormat
+			}
+			route, err := buildSegmentRoute(locationsBySeg[i], tolerance, format)
+			if err != nil {
+				return nil, err
+			}
+			seg.Route = route
+		}
+		if opts.WithDistance {
+			seg.DistanceKm, seg.DistanceSource = segmentDistanceKm(seg.Signals, locationsBySeg[i])
+		}
 		// Ensure non-nil location for GraphQL (schema: value: Location!)
 		if seg.Start.Value == nil {
 			seg.Start.Value = noDataLocation()
@@ -359,14 +539,9 @@ func (r *Repository) GetSegments(ctx context.Context, tokenID int, from, to time
 	return segments, nil
 }
 
-// segmentMaxSpeed returns the MAX speed value from segment signals, or -1 if not found.
-func segmentMaxSpeed(signals []*model.SignalAggregationValue) float64 {
-	for _, s := range signals {
-		if s != nil && s.Name == vss.FieldSpeed && s.Agg == "MAX" {
-			return s.Value
-		}
-	}
-	return -1
+// segmentMaxSpeed returns the MAX speed value from segment signals, or nil if not found.
+func segmentMaxSpeed(signals []*model.SignalAggregationValue) *float64 {
+	return segmentSignal(signals, sigSpeed)
 }
 
 // filterIdlingSegmentsBySpeed keeps only segments with speed <= maxSpeedKph or no speed signal.
@@ -374,8 +549,7 @@ func segmentMaxSpeed(signals []*model.SignalAggregationValue) float64 {
 func filterIdlingSegmentsBySpeed(segments []*model.Segment, maxSpeedKph float64) []*model.Segment {
 	out := make([]*model.Segment, 0, len(segments))
 	for _, seg := range segments {
-		maxSpeed := segmentMaxSpeed(seg.Signals)
-		if maxSpeed < 0 || maxSpeed <= maxSpeedKph {
+		if maxSpeed := segmentMaxSpeed(seg.Signals); maxSpeed == nil || *maxSpeed <= maxSpeedKph {
 			out = append(out, seg)
 		}
 	}
@@ -498,10 +672,11 @@ func (r *Repository) segmentSummary(ctx context.Context, tokenID uint32, subject
 }
 
 // GetDailyActivity returns one record per calendar day in the requested date range, including days with zero segments.
-// mechanism must be ignitionDetection, frequencyAnalysis, or changePointDetection; idling, refuel, recharge return 400.
-func (r *Repository) GetDailyActivity(ctx context.Context, tokenID int, from, to time.Time, mechanism model.DetectionMechanism, config *model.SegmentConfig, signalRequests []*model.SegmentSignalRequest, eventRequests []*model.SegmentEventRequest, timezone *string) ([]*model.DailyActivity, error) {
-	if mechanism == model.DetectionMechanismIdling || mechanism == model.DetectionMechanismRefuel || mechanism == model.DetectionMechanismRecharge {
-		return nil, errorhandler.NewBadRequestError(ctx, fmt.Errorf("dailyActivity does not accept mechanism %s; use ignitionDetection, frequencyAnalysis, or changePointDetection", mechanism))
+// mechanism must be ignitionDetection, frequencyAnalysis, changePointDetection, auto, or stops; idling, refuel, recharge, threshold, geofence, fuelDrop return 400.
+func (r *Repository) GetDailyActivity(ctx context.Context, tokenID int, from, to time.Time, mechanism model.DetectionMechanism, config *model.SegmentConfig, signalRequests []*model.SegmentSignalRequest, eventRequests []*model.SegmentEventRequest, timezone *string, opts SegmentOptions) ([]*model.DailyActivity, error) {
+	if mechanism == model.DetectionMechanismIdling || mechanism == model.DetectionMechanismRefuel || mechanism == model.DetectionMechanismRecharge ||
+		mechanism == model.DetectionMechanismThreshold || mechanism == model.DetectionMechanismGeofence || mechanism == model.DetectionMechanismFuelDrop {
+		return nil, errorhandler.NewBadRequestError(ctx, fmt.Errorf("dailyActivity does not accept mechanism %s; use ignitionDetection, frequencyAnalysis, changePointDetection, auto, or stops", mechanism))
 	}
 	loc := time.UTC
 	if timezone != nil && *timezone != "" {
@@ -521,7 +696,7 @@ func (r *Repository) GetDailyActivity(ctx context.Context, tokenID int, from, to
 	if toDate.After(time.Now().In(loc)) {
 		return nil, errorhandler.NewBadRequestError(ctx, fmt.Errorf("to date cannot be in the future"))
 	}
-	if err := validateSegmentDateRange(fromDate, toDate); err != nil {
+	if err := validateSegmentDateRange(fromDate, toDate, r.maxSegmentRangeDays(fromDate, config)); err != nil {
 		return nil, errorhandler.NewBadRequestError(ctx, err)
 	}
 	rangeStart := fromDate
@@ -537,7 +712,7 @@ func (r *Repository) GetDailyActivity(ctx context.Context, tokenID int, from, to
 		}
 	}
 
-	segments, err := r.GetSegments(ctx, tokenID, rangeStart, rangeEnd, mechanism, config, signalReqs, eventRequests, nil, nil)
+	segments, err := r.GetSegments(ctx, tokenID, rangeStart, rangeEnd, mechanism, config, signalReqs, eventRequests, nil, nil, SegmentOptions{WithDistance: opts.WithDistance})
 	if err != nil {
 		return nil, err
 	}
@@ -547,16 +722,28 @@ func (r *Repository) GetDailyActivity(ctx context.Context, tokenID int, from, to
 		TokenID:         big.NewInt(int64(tokenID)),
 	}.String()
 
-	var out []*model.DailyActivity
+	var days []ch.SummaryRange
 	for d := fromDate; !d.After(toDate); d = d.Add(24 * time.Hour) {
-		dayStart := d
-		dayEnd := d.Add(24 * time.Hour)
-		dayStartUTC := dayStart.UTC()
-		dayEndUTC := dayEnd.UTC()
+		dayEnd := d.Add(24 * time.Hour).UTC()
+		days = append(days, ch.SummaryRange{From: d.UTC(), To: dayEnd, SignalsTo: dayEnd})
+	}
+	floatArgs, locationArgs := buildAggArgs(signalReqs)
+	daySummaries, err := r.chService.GetSummariesForRanges(ctx, subject, days, floatArgs, locationArgs, eventNames)
+	if err != nil {
+		return nil, handleDBError(ctx, err)
+	}
+
+	var out []*model.DailyActivity
+	for i, day := range days {
+		dayStartUTC := day.From
+		dayEndUTC := day.To
 
 		var segmentCount int
 		var totalActiveSeconds int
 		var firstSeg, lastSeg *model.Segment
+		var scores []*model.DriverScore
+		var scoreDurations []int
+		var distance dayDistance
 		for _, seg := range segments {
 			segEnd := dayEndUTC
 			if seg.End != nil && seg.End.Timestamp.Before(dayEndUTC) {
@@ -575,16 +762,19 @@ func (r *Repository) GetDailyActivity(ctx context.Context, tokenID int, from, to
 				overlapEnd = dayEndUTC
 			}
 			totalActiveSeconds += int(overlapEnd.Sub(overlapStart).Seconds())
+			distance.add(seg, int(overlapEnd.Sub(overlapStart).Seconds()))
 			if firstSeg == nil {
 				firstSeg = seg
 			}
 			lastSeg = seg
+			if seg.Score != nil {
+				scores = append(scores, seg.Score)
+				scoreDurations = append(scoreDurations, seg.Duration)
+			}
 		}
 
-		signalSummary, startLoc, endLoc, eventSummary, err := r.daySummary(ctx, uint32(tokenID), subject, dayStartUTC, dayEndUTC, signalReqs, eventNames)
-		if err != nil {
-			return nil, err
-		}
+		signalSummary, startLoc, endLoc := buildSummaryFromAggs(daySummaries[i].Aggs, floatArgs)
+		eventSummary := buildEventSummary(eventCountsToMap(daySummaries[i].EventCounts), eventNames)
 		if firstSeg != nil && firstSeg.Start != nil && firstSeg.Start.Value != nil {
 			startLoc = firstSeg.Start.Value
 		}
@@ -600,13 +790,17 @@ func (r *Repository) GetDailyActivity(ctx context.Context, tokenID int, from, to
 
 		startSignalLoc := &model.SignalLocation{Timestamp: dayStartUTC, Value: startLoc}
 		endSignalLoc := &model.SignalLocation{Timestamp: dayEndUTC, Value: endLoc}
+		distanceKm, distanceSource := distance.result()
 		out = append(out, &model.DailyActivity{
-			SegmentCount: segmentCount,
-			Duration:     totalActiveSeconds,
-			Start:        startSignalLoc,
-			End:          endSignalLoc,
-			Signals:      signalSummary,
-			EventCounts:  eventSummary,
+			SegmentCount:   segmentCount,
+			Duration:       totalActiveSeconds,
+			Start:          startSignalLoc,
+			End:            endSignalLoc,
+			Signals:        signalSummary,
+			EventCounts:    eventSummary,
+			Score:          r.driverScorer.averageScore(scores, scoreDurations),
+			DistanceKm:     distanceKm,
+			DistanceSource: distanceSource,
 		})
 	}
 	if out == nil {
@@ -614,31 +808,3 @@ func (r *Repository) GetDailyActivity(ctx context.Context, tokenID int, from, to
 	}
 	return out, nil
 }
-
-func (r *Repository) daySummary(ctx context.Context, tokenID uint32, subject string, dayStart, dayEnd time.Time, signalReqs []*model.SegmentSignalRequest, eventNames []string) ([]*model.SignalAggregationValue, *model.Location, *model.Location, []*model.EventCount, error) {
-	intervalMicro := dayEnd.Sub(dayStart).Microseconds()
-	if intervalMicro <= 0 {
-		intervalMicro = 1
-	}
-	floatArgs, locationArgs := buildAggArgs(signalReqs)
-	aggArgs := &model.AggregatedSignalArgs{
-		SignalArgs:   model.SignalArgs{TokenID: tokenID},
-		FromTS:       dayStart,
-		ToTS:         dayEnd,
-		Interval:     intervalMicro,
-		FloatArgs:    floatArgs,
-		LocationArgs: locationArgs,
-	}
-	aggs, err := r.chService.GetAggregatedSignals(ctx, subject, aggArgs)
-	if err != nil {
-		return nil, nil, nil, nil, handleDBError(ctx, err)
-	}
-	signalSummary, startLoc, endLoc := buildSummaryFromAggs(aggs, floatArgs)
-
-	eventCounts, err := r.chService.GetEventCounts(ctx, subject, dayStart, dayEnd, eventNames)
-	if err != nil {
-		return nil, nil, nil, nil, handleDBError(ctx, err)
-	}
-	eventSummary := buildEventSummary(eventCountsToMap(eventCounts), eventNames)
-	return signalSummary, startLoc, endLoc, eventSummary, nil
-}
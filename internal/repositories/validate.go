@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"math"
 	"regexp"
+	"strings"
 	"time"
 
 	"github.com/DIMO-Network/cloudevent"
@@ -53,27 +54,35 @@ func validateAggSigArgs(args *model.AggregatedSignalArgs) error {
 	// TODO(elffjs): Awkward place to put this. Certainly this would get
 	// worse if we allowed ORs.
 	for _, locArg := range args.LocationArgs {
-		if fil := locArg.Filter; fil != nil {
-			// TODO(elffjs): Should we check polygon orientation?
-			// Could apply isFilterLocationValid here, but failure there
-			// doesn't actually break queries.
-			if len(fil.InPolygon) != 0 && len(fil.InPolygon) < 3 {
-				return ValidationError("not enough points in geofence filter")
-			}
-
-			if fil.InCircle != nil {
-				if !isFilterLocationValid(fil.InCircle.Center) {
-					return ValidationError("invalid circle filter location")
-				}
-				// Could think about checking for radius < 0, but nothing
-				// actually breaks.
-			}
+		if err := validateLocationFilter(locArg.Filter); err != nil {
+			return err
 		}
 	}
 
 	return validateSignalArgs(&args.SignalArgs)
 }
 
+func validateLocationFilter(fil *model.SignalLocationFilter) error {
+	if fil == nil {
+		return nil
+	}
+	// TODO(elffjs): Should we check polygon orientation?
+	// Could apply isFilterLocationValid here, but failure there
+	// doesn't actually break queries.
+	if len(fil.InPolygon) != 0 && len(fil.InPolygon) < 3 {
+		return ValidationError("not enough points in geofence filter")
+	}
+
+	if fil.InCircle != nil {
+		if !isFilterLocationValid(fil.InCircle.Center) {
+			return ValidationError("invalid circle filter location")
+		}
+		// Could think about checking for radius < 0, but nothing
+		// actually breaks.
+	}
+	return nil
+}
+
 func isFilterLocationValid(loc *model.FilterLocation) bool {
 	return -90 <= loc.Latitude && loc.Latitude <= 90 && -180 <= loc.Longitude && loc.Longitude <= 180
 }
@@ -122,9 +131,101 @@ func validateEventArgs(tokenID int, from, to time.Time, filter *model.EventFilte
 	if from.After(to) {
 		return ValidationError("from timestamp is after to timestamp")
 	}
-	if filter != nil {
-		if err := validateEventNameFilter(filter.Name); err != nil {
-			return err
+	return validateEventFilter(filter)
+}
+
+// validateEventFilter checks the name, metadata and location conditions of an event filter.
+func validateEventFilter(filter *model.EventFilter) error {
+	if filter == nil {
+		return nil
+	}
+	if err := validateEventNameFilter(filter.Name); err != nil {
+		return err
+	}
+	if err := validateEventMetadataFilters(filter.Metadata); err != nil {
+		return err
+	}
+	return validateLocationFilter(filter.Location)
+}
+
+// validateDerivedEventsConfig checks the thresholds of derived events, if set, and that the
+// range is at most maxDateRangeDays: derived events are computed from every speed and yaw sample.
+func validateDerivedEventsConfig(config *model.DerivedEventsConfig, from, to time.Time) error {
+	if config == nil {
+		return nil
+	}
+	if to.Sub(from) > maxDateRangeDuration {
+		return ValidationError(fmt.Sprintf("date range with derivedEvents exceeds maximum of %d days", maxDateRangeDays))
+	}
+	thresholds := map[string]*float64{
+		"harshAccelerationThreshold": config.HarshAccelerationThreshold,
+		"harshBrakingThreshold":      config.HarshBrakingThreshold,
+		"harshCorneringThreshold":    config.HarshCorneringThreshold,
+	}
+	for name, threshold := range thresholds {
+		if threshold != nil && (*threshold < minDerivedEventThreshold || *threshold > maxDerivedEventThreshold) {
+			return ValidationError(fmt.Sprintf("%s must be between %g and %g", name, minDerivedEventThreshold, maxDerivedEventThreshold))
+		}
+	}
+	if gap := config.MaxSampleGapSeconds; gap != nil && (*gap < minMaxSampleGapSeconds || *gap > maxMaxSampleGapSeconds) {
+		return ValidationError(fmt.Sprintf("maxSampleGapSeconds must be between %d and %d", minMaxSampleGapSeconds, maxMaxSampleGapSeconds))
+	}
+	return nil
+}
+
+// maxOptionalRangeDays is the longest optional time range; bounded ranges
+// scan the raw tables instead of the precomputed summaries.
+const maxOptionalRangeDays = 366
+
+// validateOptionalTimeRange checks a range that is either omitted or has both
+// bounds set.
+func validateOptionalTimeRange(from, to *time.Time) error {
+	if from == nil && to == nil {
+		return nil
+	}
+	if from == nil || to == nil {
+		return ValidationError("from and to must be set together")
+	}
+	if from.After(*to) {
+		return ValidationError("from timestamp is after to timestamp")
+	}
+	if to.Sub(*from) > maxOptionalRangeDays*24*time.Hour {
+		return ValidationError(fmt.Sprintf("date range exceeds maximum of %d days", maxOptionalRangeDays))
+	}
+	return nil
+}
+
+func validateEventSubscriptionArgs(tokenID int, filter *model.EventFilter) error {
+	if tokenID < 1 {
+		return ValidationError("tokenID is not a positive integer")
+	}
+	return validateEventFilter(filter)
+}
+
+const (
+	maxEventMetadataFilters = 10
+	maxEventMetadataPathLen = 5
+)
+
+// eventMetadataKeyPattern matches one key of an event metadata path.
+var eventMetadataKeyPattern = regexp.MustCompile(`^[a-zA-Z0-9_]+$`)
+
+func validateEventMetadataFilters(filters []*model.EventMetadataFilter) error {
+	if len(filters) > maxEventMetadataFilters {
+		return ValidationError(fmt.Sprintf("too many metadata filters, max %d", maxEventMetadataFilters))
+	}
+	for _, filter := range filters {
+		keys := strings.Split(filter.Path, ".")
+		if len(keys) > maxEventMetadataPathLen {
+			return ValidationError(fmt.Sprintf("metadata path '%s' has more than %d keys", filter.Path, maxEventMetadataPathLen))
+		}
+		for _, key := range keys {
+			if !eventMetadataKeyPattern.MatchString(key) {
+				return ValidationError(fmt.Sprintf("invalid metadata path '%s'", filter.Path))
+			}
+		}
+		if filter.Eq == nil && filter.In == nil && filter.Gt == nil && filter.Lt == nil {
+			return ValidationError(fmt.Sprintf("metadata filter on '%s' has no condition", filter.Path))
 		}
 	}
 	return nil
@@ -39,6 +39,22 @@ func TestValidateEventArgs(t *testing.T) {
 		require.Error(t, err)
 	})
 
+	t.Run("polygon with too few points", func(t *testing.T) {
+		filter := &model.EventFilter{Location: &model.SignalLocationFilter{
+			InPolygon: []*model.FilterLocation{{Latitude: 1, Longitude: 1}, {Latitude: 2, Longitude: 2}},
+		}}
+		err := validateEventArgs(1, validFrom, validTo, filter)
+		require.Error(t, err)
+	})
+
+	t.Run("circle center out of range", func(t *testing.T) {
+		filter := &model.EventFilter{Location: &model.SignalLocationFilter{
+			InCircle: &model.InCircleFilter{Center: &model.FilterLocation{Latitude: 91, Longitude: 0}, Radius: 1},
+		}}
+		err := validateEventArgs(1, validFrom, validTo, filter)
+		require.Error(t, err)
+	})
+
 }
 
 func TestValidateSegmentArgs(t *testing.T) {
@@ -46,34 +62,34 @@ func TestValidateSegmentArgs(t *testing.T) {
 	validTo := time.Now()
 
 	t.Run("valid args", func(t *testing.T) {
-		err := validateSegmentArgs(1, validFrom, validTo)
+		err := validateSegmentArgs(1, validFrom, validTo, maxDateRangeDays)
 		require.NoError(t, err)
 	})
 
 	t.Run("exactly 31 days passes", func(t *testing.T) {
 		from := validTo.Add(-31 * 24 * time.Hour)
-		err := validateSegmentArgs(1, from, validTo)
+		err := validateSegmentArgs(1, from, validTo, maxDateRangeDays)
 		require.NoError(t, err)
 	})
 
 	t.Run("tokenID <= 0", func(t *testing.T) {
-		err := validateSegmentArgs(0, validFrom, validTo)
+		err := validateSegmentArgs(0, validFrom, validTo, maxDateRangeDays)
 		require.Error(t, err)
 	})
 
 	t.Run("from after to", func(t *testing.T) {
-		err := validateSegmentArgs(1, validTo.Add(time.Minute), validTo)
+		err := validateSegmentArgs(1, validTo.Add(time.Minute), validTo, maxDateRangeDays)
 		require.Error(t, err)
 	})
 
 	t.Run("from equal to", func(t *testing.T) {
-		err := validateSegmentArgs(1, validFrom, validFrom)
+		err := validateSegmentArgs(1, validFrom, validFrom, maxDateRangeDays)
 		require.Error(t, err)
 	})
 
 	t.Run("date range exceeded", func(t *testing.T) {
 		from := validTo.Add(-33 * 24 * time.Hour) // max is 32 days
-		err := validateSegmentArgs(1, from, validTo)
+		err := validateSegmentArgs(1, from, validTo, maxDateRangeDays)
 		require.Error(t, err)
 	})
 }
@@ -84,25 +100,41 @@ func TestValidateSegmentDateRange(t *testing.T) {
 
 	t.Run("short range passes", func(t *testing.T) {
 		from := to.Add(-time.Hour)
-		require.NoError(t, validateSegmentDateRange(from, to))
+		require.NoError(t, validateSegmentDateRange(from, to, maxDateRangeDays))
 	})
 
 	t.Run("exactly 31 days passes", func(t *testing.T) {
 		from := to.Add(-31 * 24 * time.Hour)
-		require.NoError(t, validateSegmentDateRange(from, to))
+		require.NoError(t, validateSegmentDateRange(from, to, maxDateRangeDays))
 	})
 
 	t.Run("31 days plus 1 second passes", func(t *testing.T) {
 		from := to.Add(-31*24*time.Hour - time.Second)
-		require.NoError(t, validateSegmentDateRange(from, to))
+		require.NoError(t, validateSegmentDateRange(from, to, maxDateRangeDays))
 	})
 
 	t.Run("32 days plus 2 seconds fails", func(t *testing.T) {
 		from := to.Add(-32*24*time.Hour - 2*time.Second)
-		require.Error(t, validateSegmentDateRange(from, to))
+		require.Error(t, validateSegmentDateRange(from, to, maxDateRangeDays))
+	})
+
+	t.Run("a year passes with the segment store", func(t *testing.T) {
+		from := to.Add(-366 * 24 * time.Hour)
+		require.NoError(t, validateSegmentDateRange(from, to, maxStoredDateRangeDays))
+		require.Error(t, validateSegmentDateRange(from.Add(-time.Hour), to, maxStoredDateRangeDays))
 	})
 }
 
+func TestMaxSegmentRangeDays(t *testing.T) {
+	from := time.Now().AddDate(0, -6, 0)
+	maxGap := 600
+	r := &Repository{segmentStoreEnabled: true}
+	require.Equal(t, maxStoredDateRangeDays, r.maxSegmentRangeDays(from, nil))
+	require.Equal(t, maxDateRangeDays, r.maxSegmentRangeDays(from, &model.SegmentConfig{MaxGapSeconds: &maxGap}), "detection settings aren't stored")
+	require.Equal(t, maxDateRangeDays, r.maxSegmentRangeDays(time.Now().AddDate(-2, 0, 0), nil), "older than the store retention")
+	require.Equal(t, maxDateRangeDays, (&Repository{}).maxSegmentRangeDays(from, nil), "store disabled")
+}
+
 func TestValidateSegmentConfig(t *testing.T) {
 	validConfig := &model.SegmentConfig{}
 	otherMechanism := model.DetectionMechanismIgnitionDetection
@@ -125,6 +157,76 @@ func TestValidateSegmentConfig(t *testing.T) {
 		require.NoError(t, validateSegmentConfig(cfg, idlingMechanism))
 	})
 
+	t.Run("threshold", func(t *testing.T) {
+		threshold := model.DetectionMechanismThreshold
+		require.Error(t, validateSegmentConfig(nil, threshold))
+		require.Error(t, validateSegmentConfig(&model.SegmentConfig{ThresholdSignal: strRef("speed")}, threshold))
+		require.Error(t, validateSegmentConfig(&model.SegmentConfig{
+			ThresholdSignal: strRef("speed"),
+			ThresholdFilter: &model.SignalFloatFilter{Or: []*model.SignalFloatFilter{{}}},
+		}, threshold))
+		require.Error(t, validateSegmentConfig(&model.SegmentConfig{
+			ThresholdSignal: strRef("currentLocationCoordinates"),
+			ThresholdFilter: &model.SignalFloatFilter{Gt: floatRef(1.0)},
+		}, threshold))
+		require.NoError(t, validateSegmentConfig(&model.SegmentConfig{
+			ThresholdSignal: strRef("speed"),
+			ThresholdFilter: &model.SignalFloatFilter{Gt: floatRef(120.0)},
+		}, threshold))
+	})
+
+	t.Run("geofence", func(t *testing.T) {
+		geofence := model.DetectionMechanismGeofence
+		require.Error(t, validateSegmentConfig(nil, geofence))
+		require.Error(t, validateSegmentConfig(&model.SegmentConfig{Geofence: &model.SignalLocationFilter{}}, geofence))
+		require.Error(t, validateSegmentConfig(&model.SegmentConfig{Geofence: &model.SignalLocationFilter{
+			InPolygon: []*model.FilterLocation{{Latitude: 1, Longitude: 1}},
+		}}, geofence))
+		require.NoError(t, validateSegmentConfig(&model.SegmentConfig{Geofence: &model.SignalLocationFilter{
+			InCircle: &model.InCircleFilter{Center: &model.FilterLocation{Latitude: 40.75, Longitude: -73.99}, Radius: 0.5},
+		}}, geofence))
+	})
+
+	t.Run("routeToleranceMeters", func(t *testing.T) {
+		frequency := model.DetectionMechanismFrequencyAnalysis
+		require.NoError(t, validateSegmentConfig(&model.SegmentConfig{RouteToleranceMeters: floatRef(0)}, frequency))
+		require.NoError(t, validateSegmentConfig(&model.SegmentConfig{RouteToleranceMeters: floatRef(25)}, frequency))
+		require.Error(t, validateSegmentConfig(&model.SegmentConfig{RouteToleranceMeters: floatRef(-1)}, frequency))
+		require.Error(t, validateSegmentConfig(&model.SegmentConfig{RouteToleranceMeters: floatRef(5000)}, frequency))
+	})
+
+	t.Run("stops stopRadiusMeters", func(t *testing.T) {
+		stops := model.DetectionMechanismStops
+		require.NoError(t, validateSegmentConfig(nil, stops))
+		require.NoError(t, validateSegmentConfig(&model.SegmentConfig{StopRadiusMeters: ptr(50)}, stops))
+		require.Error(t, validateSegmentConfig(&model.SegmentConfig{StopRadiusMeters: ptr(5)}, stops))
+		require.Error(t, validateSegmentConfig(&model.SegmentConfig{StopRadiusMeters: ptr(2000)}, stops))
+	})
+
+	t.Run("recharge batteryCapacityKwh", func(t *testing.T) {
+		recharge := model.DetectionMechanismRecharge
+		require.NoError(t, validateSegmentConfig(&model.SegmentConfig{BatteryCapacityKwh: floatRef(75)}, recharge))
+		require.Error(t, validateSegmentConfig(&model.SegmentConfig{BatteryCapacityKwh: floatRef(0)}, recharge))
+		require.Error(t, validateSegmentConfig(&model.SegmentConfig{BatteryCapacityKwh: floatRef(5000)}, recharge))
+	})
+
+	t.Run("refuel capacity and price", func(t *testing.T) {
+		refuel := model.DetectionMechanismRefuel
+		require.NoError(t, validateSegmentConfig(&model.SegmentConfig{FuelTankCapacityLiters: floatRef(60), FuelPricePerLiter: floatRef(1.5)}, refuel))
+		require.Error(t, validateSegmentConfig(&model.SegmentConfig{FuelTankCapacityLiters: floatRef(0.5)}, refuel))
+		require.Error(t, validateSegmentConfig(&model.SegmentConfig{FuelTankCapacityLiters: floatRef(3000)}, refuel))
+		require.Error(t, validateSegmentConfig(&model.SegmentConfig{FuelPricePerLiter: floatRef(0)}, refuel))
+	})
+
+	t.Run("fuelDrop minDropPercent", func(t *testing.T) {
+		fuelDrop := model.DetectionMechanismFuelDrop
+		require.NoError(t, validateSegmentConfig(nil, fuelDrop))
+		require.NoError(t, validateSegmentConfig(&model.SegmentConfig{MinDropPercent: ptr(5), FuelTankCapacityLiters: floatRef(60)}, fuelDrop))
+		require.Error(t, validateSegmentConfig(&model.SegmentConfig{MinDropPercent: ptr(0)}, fuelDrop))
+		require.Error(t, validateSegmentConfig(&model.SegmentConfig{MinDropPercent: ptr(101)}, fuelDrop))
+		require.Error(t, validateSegmentConfig(&model.SegmentConfig{FuelTankCapacityLiters: floatRef(0)}, fuelDrop))
+	})
+
 	t.Run("idling maxIdleRpm out of range", func(t *testing.T) {
 		cfg := &model.SegmentConfig{MaxIdleRpm: ptr(100)}
 		require.Error(t, validateSegmentConfig(cfg, idlingMechanism))
@@ -258,4 +360,55 @@ func TestValidateEventNameFilter(t *testing.T) {
 	})
 }
 
-func ptr(i int) *int { return &i }
+func TestValidateEventMetadataFilters(t *testing.T) {
+	gt := 0.5
+
+	t.Run("valid", func(t *testing.T) {
+		require.NoError(t, validateEventMetadataFilters([]*model.EventMetadataFilter{
+			{Path: "code", Eq: strRef("P0420")},
+			{Path: "acceleration.peak_g", Gt: &gt},
+		}))
+	})
+
+	t.Run("no condition", func(t *testing.T) {
+		require.Error(t, validateEventMetadataFilters([]*model.EventMetadataFilter{{Path: "code"}}))
+	})
+
+	t.Run("invalid key", func(t *testing.T) {
+		require.Error(t, validateEventMetadataFilters([]*model.EventMetadataFilter{{Path: "code'", Eq: strRef("x")}}))
+		require.Error(t, validateEventMetadataFilters([]*model.EventMetadataFilter{{Path: "a..b", Eq: strRef("x")}}))
+	})
+
+	t.Run("path too deep", func(t *testing.T) {
+		require.Error(t, validateEventMetadataFilters([]*model.EventMetadataFilter{{Path: "a.b.c.d.e.f", Eq: strRef("x")}}))
+	})
+
+	t.Run("too many filters", func(t *testing.T) {
+		filters := make([]*model.EventMetadataFilter, maxEventMetadataFilters+1)
+		for i := range filters {
+			filters[i] = &model.EventMetadataFilter{Path: "code", Eq: strRef("x")}
+		}
+		require.Error(t, validateEventMetadataFilters(filters))
+	})
+}
+
+func ptr(i int) *int              { return &i }
+func floatRef(f float64) *float64 { return &f }
+
+func TestValidateDerivedEventsConfig(t *testing.T) {
+	to := time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC)
+	from := to.AddDate(0, 0, -7)
+	require.NoError(t, validateDerivedEventsConfig(nil, from, to))
+	require.NoError(t, validateDerivedEventsConfig(&model.DerivedEventsConfig{}, from, to))
+	require.NoError(t, validateDerivedEventsConfig(&model.DerivedEventsConfig{HarshBrakingThreshold: floatRef(0.5), HarshCorneringThreshold: floatRef(20)}, from, to))
+
+	err := validateDerivedEventsConfig(&model.DerivedEventsConfig{HarshAccelerationThreshold: floatRef(0.1)}, from, to)
+	require.ErrorContains(t, err, "harshAccelerationThreshold must be between 0.5 and 20")
+	require.Error(t, validateDerivedEventsConfig(&model.DerivedEventsConfig{HarshCorneringThreshold: floatRef(25)}, from, to))
+	require.NoError(t, validateDerivedEventsConfig(&model.DerivedEventsConfig{MaxSampleGapSeconds: ptr(30)}, from, to))
+	require.ErrorContains(t, validateDerivedEventsConfig(&model.DerivedEventsConfig{MaxSampleGapSeconds: ptr(0)}, from, to), "maxSampleGapSeconds must be between 1 and 60")
+
+	longFrom := to.Add(-maxDateRangeDuration - time.Second)
+	require.NoError(t, validateDerivedEventsConfig(nil, longFrom, to), "no limit without derived events")
+	require.ErrorContains(t, validateDerivedEventsConfig(&model.DerivedEventsConfig{}, longFrom, to), "date range with derivedEvents exceeds maximum of 32 days")
+}
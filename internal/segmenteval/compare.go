@@ -0,0 +1,150 @@
+// Package segmenteval evaluates segment detection mechanisms against a baseline mechanism or
+// labelled ground truth, reporting precision, recall, boundary error and runtime.
+package segmenteval
+
+import (
+	"sort"
+	"time"
+
+	"github.com/DIMO-Network/telemetry-api/internal/graph/model"
+)
+
+// Interval is a time range covered by a segment or a ground truth label.
+type Interval struct {
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+}
+
+// Metrics are the counts and boundary errors of comparing detected segments to a baseline.
+type Metrics struct {
+	// Detected is the number of detected segments.
+	Detected int
+	// Baseline is the number of baseline segments.
+	Baseline int
+	// Matched is the number of detected segments matched one to one to a baseline segment.
+	Matched int
+	// StartError and EndError are the summed absolute boundary errors of matched segments.
+	StartError time.Duration
+	EndError   time.Duration
+	// MaxBoundaryError is the largest start or end error of a matched segment.
+	MaxBoundaryError time.Duration
+	// Runtime is the time spent detecting.
+	Runtime time.Duration
+}
+
+// Precision returns the share of detected segments that match a baseline segment, or 1 if nothing was detected.
+func (m Metrics) Precision() float64 {
+	if m.Detected == 0 {
+		return 1
+	}
+	return float64(m.Matched) / float64(m.Detected)
+}
+
+// Recall returns the share of baseline segments matched by a detected segment, or 1 if the baseline is empty.
+func (m Metrics) Recall() float64 {
+	if m.Baseline == 0 {
+		return 1
+	}
+	return float64(m.Matched) / float64(m.Baseline)
+}
+
+// MeanStartError returns the mean absolute start error of matched segments.
+func (m Metrics) MeanStartError() time.Duration {
+	if m.Matched == 0 {
+		return 0
+	}
+	return m.StartError / time.Duration(m.Matched)
+}
+
+// MeanEndError returns the mean absolute end error of matched segments.
+func (m Metrics) MeanEndError() time.Duration {
+	if m.Matched == 0 {
+		return 0
+	}
+	return m.EndError / time.Duration(m.Matched)
+}
+
+// Add adds the counts, errors and runtime of o to m.
+func (m *Metrics) Add(o Metrics) {
+	m.Detected += o.Detected
+	m.Baseline += o.Baseline
+	m.Matched += o.Matched
+	m.StartError += o.StartError
+	m.EndError += o.EndError
+	m.MaxBoundaryError = max(m.MaxBoundaryError, o.MaxBoundaryError)
+	m.Runtime += o.Runtime
+}
+
+// SegmentIntervals returns the intervals of segments. Ongoing segments end at to.
+func SegmentIntervals(segments []*model.Segment, to time.Time) []Interval {
+	intervals := make([]Interval, 0, len(segments))
+	for _, seg := range segments {
+		end := to
+		if seg.End != nil {
+			end = seg.End.Timestamp
+		}
+		intervals = append(intervals, Interval{Start: seg.Start.Timestamp, End: end})
+	}
+	return intervals
+}
+
+// Compare matches detected intervals to baseline intervals one to one and returns the counts and
+// boundary errors. Pairs are matched greedily by temporal intersection over union, highest first;
+// pairs with an intersection over union below minIoU don't match.
+func Compare(detected, baseline []Interval, minIoU float64) Metrics {
+	m := Metrics{Detected: len(detected), Baseline: len(baseline)}
+
+	type pair struct {
+		d, b int
+		iou  float64
+	}
+	var pairs []pair
+	for i, d := range detected {
+		for j, b := range baseline {
+			if iou := intersectionOverUnion(d, b); iou > 0 && iou >= minIoU {
+				pairs = append(pairs, pair{d: i, b: j, iou: iou})
+			}
+		}
+	}
+	sort.SliceStable(pairs, func(i, j int) bool { return pairs[i].iou > pairs[j].iou })
+
+	usedDetected := make([]bool, len(detected))
+	usedBaseline := make([]bool, len(baseline))
+	for _, p := range pairs {
+		if usedDetected[p.d] || usedBaseline[p.b] {
+			continue
+		}
+		usedDetected[p.d], usedBaseline[p.b] = true, true
+		m.Matched++
+		startErr := absDuration(detected[p.d].Start.Sub(baseline[p.b].Start))
+		endErr := absDuration(detected[p.d].End.Sub(baseline[p.b].End))
+		m.StartError += startErr
+		m.EndError += endErr
+		m.MaxBoundaryError = max(m.MaxBoundaryError, startErr, endErr)
+	}
+	return m
+}
+
+// intersectionOverUnion returns the length of the overlap of a and b divided by the length of their union.
+func intersectionOverUnion(a, b Interval) float64 {
+	start, end := a.Start, a.End
+	if b.Start.After(start) {
+		start = b.Start
+	}
+	if b.End.Before(end) {
+		end = b.End
+	}
+	overlap := end.Sub(start)
+	if overlap <= 0 {
+		return 0
+	}
+	union := a.End.Sub(a.Start) + b.End.Sub(b.Start) - overlap
+	return float64(overlap) / float64(union)
+}
+
+func absDuration(d time.Duration) time.Duration {
+	if d < 0 {
+		return -d
+	}
+	return d
+}
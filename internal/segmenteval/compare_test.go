@@ -0,0 +1,61 @@
+package segmenteval
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompare(t *testing.T) {
+	t0 := time.Date(2025, 3, 1, 8, 0, 0, 0, time.UTC)
+	interval := func(startMin, endMin int) Interval {
+		return Interval{Start: t0.Add(time.Duration(startMin) * time.Minute), End: t0.Add(time.Duration(endMin) * time.Minute)}
+	}
+	baseline := []Interval{interval(0, 30), interval(60, 90), interval(120, 130)}
+
+	t.Run("identical", func(t *testing.T) {
+		m := Compare(baseline, baseline, 0.5)
+		require.Equal(t, 3, m.Matched)
+		require.Equal(t, 1.0, m.Precision())
+		require.Equal(t, 1.0, m.Recall())
+		require.Zero(t, m.MaxBoundaryError)
+	})
+
+	t.Run("shifted, split and extra", func(t *testing.T) {
+		detected := []Interval{
+			interval(1, 32),
+			// The second trip is split; only the larger half matches.
+			interval(60, 80), interval(82, 90),
+			interval(200, 210),
+		}
+		m := Compare(detected, baseline, 0.5)
+		require.Equal(t, 4, m.Detected)
+		require.Equal(t, 3, m.Baseline)
+		require.Equal(t, 2, m.Matched)
+		require.InDelta(t, 0.5, m.Precision(), 1e-9)
+		require.InDelta(t, 2.0/3, m.Recall(), 1e-9)
+		require.Equal(t, 30*time.Second, m.MeanStartError())
+		require.Equal(t, 6*time.Minute, m.MeanEndError())
+		require.Equal(t, 10*time.Minute, m.MaxBoundaryError)
+	})
+
+	t.Run("merged trips match once", func(t *testing.T) {
+		m := Compare([]Interval{interval(0, 90)}, baseline[:2], 0.3)
+		require.Equal(t, 1, m.Matched)
+		require.InDelta(t, 0.5, m.Recall(), 1e-9)
+	})
+
+	t.Run("nothing detected", func(t *testing.T) {
+		m := Compare(nil, baseline, 0.5)
+		require.Equal(t, 1.0, m.Precision())
+		require.Zero(t, m.Recall())
+	})
+}
+
+func TestMetricsAdd(t *testing.T) {
+	m := Metrics{Detected: 2, Baseline: 2, Matched: 2, StartError: time.Minute, MaxBoundaryError: time.Minute, Runtime: time.Second}
+	m.Add(Metrics{Detected: 1, Baseline: 2, Matched: 1, EndError: 3 * time.Minute, MaxBoundaryError: 3 * time.Minute, Runtime: time.Second})
+	require.Equal(t, Metrics{Detected: 3, Baseline: 4, Matched: 3, StartError: time.Minute, EndError: 3 * time.Minute, MaxBoundaryError: 3 * time.Minute, Runtime: 2 * time.Second}, m)
+	require.Equal(t, 20*time.Second, m.MeanStartError())
+}
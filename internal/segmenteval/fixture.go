@@ -0,0 +1,261 @@
+package segmenteval
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/ClickHouse/clickhouse-go/v2"
+	"github.com/DIMO-Network/cloudevent"
+	"github.com/DIMO-Network/model-garage/pkg/vss"
+)
+
+const (
+	// fixtureSignalsFile and fixtureStateChangesFile are the files of a fixture directory.
+	fixtureSignalsFile      = "signal.jsonl"
+	fixtureStateChangesFile = "signal_state_changes.jsonl"
+	stateChangesTableName   = "signal_state_changes"
+	// fixtureSignalLookback is how far before a case's from signals are dumped; detectors look back
+	// up to maxGapSeconds (at most an hour) for segments that started before the range.
+	fixtureSignalLookback = 2 * time.Hour
+	// fixtureStateChangeLookback is how far before a case's from state changes are dumped, so ignition
+	// detection finds the ignition state at from.
+	fixtureStateChangeLookback = 30 * 24 * time.Hour
+	fixtureBatchSize           = 5000
+)
+
+// FixtureSignal is a row of the signal table in a fixture dump.
+type FixtureSignal struct {
+	Subject       string           `json:"subject"`
+	Timestamp     time.Time        `json:"timestamp"`
+	Name          string           `json:"name"`
+	Source        string           `json:"source"`
+	Producer      string           `json:"producer,omitempty"`
+	CloudEventID  string           `json:"cloudEventId,omitempty"`
+	ValueNumber   float64          `json:"valueNumber,omitempty"`
+	ValueString   string           `json:"valueString,omitempty"`
+	ValueLocation *FixtureLocation `json:"valueLocation,omitempty"`
+}
+
+// FixtureLocation is the value of a location signal in a fixture dump.
+type FixtureLocation struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+	HDOP      float64 `json:"hdop,omitempty"`
+	Heading   float64 `json:"heading,omitempty"`
+}
+
+// FixtureStateChange is a row of the signal_state_changes table in a fixture dump.
+type FixtureStateChange struct {
+	Subject              string    `json:"subject"`
+	SignalName           string    `json:"signalName"`
+	Timestamp            time.Time `json:"timestamp"`
+	NewState             float64   `json:"newState"`
+	PrevState            float64   `json:"prevState"`
+	TimeSincePrevSeconds uint32    `json:"timeSincePrevSeconds"`
+	Source               string    `json:"source"`
+	Producer             string    `json:"producer,omitempty"`
+	CloudEventID         string    `json:"cloudEventId,omitempty"`
+	Version              uint64    `json:"version"`
+}
+
+// Fixture is a dump of the rows detectors read, so mechanisms can be evaluated without access to
+// production data. On disk it is a directory with one JSON object per line in signal.jsonl and
+// signal_state_changes.jsonl.
+type Fixture struct {
+	Signals      []FixtureSignal
+	StateChanges []FixtureStateChange
+}
+
+// LoadFixture reads the fixture in dir. A missing state changes file is treated as empty.
+func LoadFixture(dir string) (*Fixture, error) {
+	var f Fixture
+	if err := readJSONLines(filepath.Join(dir, fixtureSignalsFile), &f.Signals); err != nil {
+		return nil, err
+	}
+	err := readJSONLines(filepath.Join(dir, fixtureStateChangesFile), &f.StateChanges)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	return &f, nil
+}
+
+// Write writes the fixture to dir, creating it if needed.
+func (f *Fixture) Write(dir string) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("failed to create fixture directory: %w", err)
+	}
+	if err := writeJSONLines(filepath.Join(dir, fixtureSignalsFile), f.Signals); err != nil {
+		return err
+	}
+	return writeJSONLines(filepath.Join(dir, fixtureStateChangesFile), f.StateChanges)
+}
+
+// Insert inserts the fixture rows into the ClickHouse database of conn, which must have the
+// model-garage migrations applied.
+func (f *Fixture) Insert(ctx context.Context, conn clickhouse.Conn) error {
+	cols := strings.Join(vss.SignalColNames(), ", ")
+	for i := 0; i < len(f.Signals); i += fixtureBatchSize {
+		batch, err := conn.PrepareBatch(ctx, fmt.Sprintf("INSERT INTO %s (%s)", vss.TableName, cols))
+		if err != nil {
+			return fmt.Errorf("failed to prepare signal batch: %w", err)
+		}
+		for _, s := range f.Signals[i:min(i+fixtureBatchSize, len(f.Signals))] {
+			if err := batch.Append(vss.SignalToSlice(s.toSignal())...); err != nil {
+				return fmt.Errorf("failed to append signal: %w", err)
+			}
+		}
+		if err := batch.Send(); err != nil {
+			return fmt.Errorf("failed to send signal batch: %w", err)
+		}
+	}
+	if len(f.StateChanges) == 0 {
+		return nil
+	}
+	batch, err := conn.PrepareBatch(ctx, "INSERT INTO "+stateChangesTableName)
+	if err != nil {
+		return fmt.Errorf("failed to prepare state change batch: %w", err)
+	}
+	for _, sc := range f.StateChanges {
+		err := batch.Append(sc.Subject, sc.SignalName, sc.Timestamp, sc.NewState, sc.PrevState, sc.TimeSincePrevSeconds,
+			sc.Source, sc.Producer, sc.CloudEventID, sc.Version)
+		if err != nil {
+			return fmt.Errorf("failed to append state change: %w", err)
+		}
+	}
+	if err := batch.Send(); err != nil {
+		return fmt.Errorf("failed to send state change batch: %w", err)
+	}
+	return nil
+}
+
+// DumpFixture reads the rows detectors need to evaluate cases from the ClickHouse database of conn.
+func DumpFixture(ctx context.Context, conn clickhouse.Conn, cases []Case) (*Fixture, error) {
+	var f Fixture
+	for _, c := range cases {
+		signals, err := dumpSignals(ctx, conn, c.Subject, c.From.Add(-fixtureSignalLookback), c.To)
+		if err != nil {
+			return nil, fmt.Errorf("case %s: %w", c.Name, err)
+		}
+		f.Signals = append(f.Signals, signals...)
+		stateChanges, err := dumpStateChanges(ctx, conn, c.Subject, c.From.Add(-fixtureStateChangeLookback), c.To)
+		if err != nil {
+			return nil, fmt.Errorf("case %s: %w", c.Name, err)
+		}
+		f.StateChanges = append(f.StateChanges, stateChanges...)
+	}
+	return &f, nil
+}
+
+func dumpSignals(ctx context.Context, conn clickhouse.Conn, subject string, from, to time.Time) ([]FixtureSignal, error) {
+	query := fmt.Sprintf("SELECT %s FROM %s WHERE %s = ? AND %s >= ? AND %s < ? ORDER BY %s",
+		strings.Join(vss.SignalColNames(), ", "), vss.TableName, vss.SubjectCol, vss.TimestampCol, vss.TimestampCol, vss.TimestampCol)
+	rows, err := conn.Query(ctx, query, subject, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed querying signals: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+	var signals []FixtureSignal
+	for rows.Next() {
+		var s FixtureSignal
+		var loc vss.Location
+		if err := rows.Scan(&s.Subject, &s.Timestamp, &s.Name, &s.Source, &s.Producer, &s.CloudEventID, &s.ValueNumber, &s.ValueString, &loc); err != nil {
+			return nil, fmt.Errorf("failed scanning signal: %w", err)
+		}
+		if loc != (vss.Location{}) {
+			s.ValueLocation = &FixtureLocation{Latitude: loc.Latitude, Longitude: loc.Longitude, HDOP: loc.HDOP, Heading: loc.Heading}
+		}
+		signals = append(signals, s)
+	}
+	return signals, rows.Err()
+}
+
+func dumpStateChanges(ctx context.Context, conn clickhouse.Conn, subject string, from, to time.Time) ([]FixtureStateChange, error) {
+	// Columns are read in table order, the order Insert appends them in.
+	query := "SELECT * FROM " + stateChangesTableName + " FINAL WHERE subject = ? AND timestamp >= ? AND timestamp < ? ORDER BY timestamp"
+	rows, err := conn.Query(ctx, query, subject, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed querying state changes: %w", err)
+	}
+	defer func() { _ = rows.Close() }()
+	var stateChanges []FixtureStateChange
+	for rows.Next() {
+		var sc FixtureStateChange
+		if err := rows.Scan(&sc.Subject, &sc.SignalName, &sc.Timestamp, &sc.NewState, &sc.PrevState, &sc.TimeSincePrevSeconds,
+			&sc.Source, &sc.Producer, &sc.CloudEventID, &sc.Version); err != nil {
+			return nil, fmt.Errorf("failed scanning state change: %w", err)
+		}
+		stateChanges = append(stateChanges, sc)
+	}
+	return stateChanges, rows.Err()
+}
+
+func (s FixtureSignal) toSignal() vss.Signal {
+	sig := vss.Signal{
+		CloudEventHeader: cloudevent.CloudEventHeader{
+			Subject:  s.Subject,
+			Source:   s.Source,
+			Producer: s.Producer,
+		},
+		Data: vss.SignalData{
+			Timestamp:    s.Timestamp,
+			Name:         s.Name,
+			CloudEventID: s.CloudEventID,
+			ValueNumber:  s.ValueNumber,
+			ValueString:  s.ValueString,
+		},
+	}
+	if s.ValueLocation != nil {
+		sig.Data.ValueLocation = vss.Location{
+			Latitude:  s.ValueLocation.Latitude,
+			Longitude: s.ValueLocation.Longitude,
+			HDOP:      s.ValueLocation.HDOP,
+			Heading:   s.ValueLocation.Heading,
+		}
+	}
+	return sig
+}
+
+func readJSONLines[T any](path string, out *[]T) error {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = file.Close() }()
+	dec := json.NewDecoder(bufio.NewReader(file))
+	for {
+		var v T
+		if err := dec.Decode(&v); err == io.EOF {
+			return nil
+		} else if err != nil {
+			return fmt.Errorf("failed to parse %s: %w", path, err)
+		}
+		*out = append(*out, v)
+	}
+}
+
+func writeJSONLines[T any](path string, rows []T) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", path, err)
+	}
+	w := bufio.NewWriter(file)
+	enc := json.NewEncoder(w)
+	for _, row := range rows {
+		if err := enc.Encode(row); err != nil {
+			_ = file.Close()
+			return fmt.Errorf("failed to write %s: %w", path, err)
+		}
+	}
+	if err := w.Flush(); err != nil {
+		_ = file.Close()
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	return file.Close()
+}
@@ -0,0 +1,41 @@
+package segmenteval
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/DIMO-Network/model-garage/pkg/vss"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFixtureRoundTrip(t *testing.T) {
+	ts := time.Date(2025, 3, 1, 8, 0, 0, 0, time.UTC)
+	fixture := &Fixture{
+		Signals: []FixtureSignal{
+			{Subject: "did:erc721:1:0x0:1", Timestamp: ts, Name: vss.FieldSpeed, Source: "s", ValueNumber: 42},
+			{Subject: "did:erc721:1:0x0:1", Timestamp: ts, Name: vss.FieldCurrentLocationCoordinates, Source: "s",
+				ValueLocation: &FixtureLocation{Latitude: 40, Longitude: -74, HDOP: 1.5}},
+		},
+		StateChanges: []FixtureStateChange{
+			{Subject: "did:erc721:1:0x0:1", SignalName: vss.FieldIsIgnitionOn, Timestamp: ts, NewState: 1, Source: "s", Version: 1},
+		},
+	}
+	dir := filepath.Join(t.TempDir(), "fixture")
+	require.NoError(t, fixture.Write(dir))
+
+	loaded, err := LoadFixture(dir)
+	require.NoError(t, err)
+	require.Equal(t, fixture, loaded)
+
+	sig := loaded.Signals[1].toSignal()
+	require.Equal(t, "did:erc721:1:0x0:1", sig.Subject)
+	require.Equal(t, vss.Location{Latitude: 40, Longitude: -74, HDOP: 1.5}, sig.Data.ValueLocation)
+
+	// The state changes file is optional.
+	require.NoError(t, os.Remove(filepath.Join(dir, fixtureStateChangesFile)))
+	loaded, err = LoadFixture(dir)
+	require.NoError(t, err)
+	require.Empty(t, loaded.StateChanges)
+}
@@ -0,0 +1,151 @@
+package segmenteval
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/DIMO-Network/telemetry-api/internal/graph/model"
+)
+
+// BaselineLabels is the baseline that compares mechanisms to the labels of each case.
+const BaselineLabels = "labels"
+
+// Case is a vehicle and time range to evaluate the mechanisms on.
+type Case struct {
+	// Name identifies the case in errors.
+	Name string `json:"name"`
+	// Subject is the vehicle DID. If empty, it is built from TokenID.
+	Subject string `json:"subject"`
+	// TokenID is the vehicle token ID, used when Subject is empty.
+	TokenID uint32    `json:"tokenId"`
+	From    time.Time `json:"from"`
+	To      time.Time `json:"to"`
+	// Config is passed to every mechanism. Required for threshold and geofence.
+	Config *model.SegmentConfig `json:"config,omitempty"`
+	// Labels are the ground truth segments, used by the labels baseline.
+	Labels []Interval `json:"labels,omitempty"`
+}
+
+// LoadCases reads a JSON array of cases from path.
+func LoadCases(path string) ([]Case, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read cases: %w", err)
+	}
+	var cases []Case
+	if err := json.Unmarshal(b, &cases); err != nil {
+		return nil, fmt.Errorf("failed to parse cases %s: %w", path, err)
+	}
+	for i, c := range cases {
+		if c.Subject == "" && c.TokenID == 0 {
+			return nil, fmt.Errorf("case %d (%s) has neither subject nor tokenId", i, c.Name)
+		}
+		if !c.From.Before(c.To) {
+			return nil, fmt.Errorf("case %d (%s): from must be before to", i, c.Name)
+		}
+	}
+	return cases, nil
+}
+
+// Detector detects the segments of a subject; ch.Service implements it.
+type Detector interface {
+	GetSegments(ctx context.Context, subject string, from, to time.Time, mechanism model.DetectionMechanism, config *model.SegmentConfig) ([]*model.Segment, error)
+}
+
+// Result is the evaluation of one mechanism over all cases.
+type Result struct {
+	Mechanism model.DetectionMechanism
+	Metrics   Metrics
+}
+
+// Run detects the segments of every case with the baseline and each of mechanisms, and compares each
+// mechanism to the baseline. baseline is a mechanism name or BaselineLabels. A baseline mechanism is
+// also evaluated against itself, which reports its runtime. Cases must have a subject.
+func Run(ctx context.Context, detector Detector, cases []Case, baseline string, mechanisms []model.DetectionMechanism, minIoU float64) ([]Result, error) {
+	baselineMechanism := model.DetectionMechanism(baseline)
+	if baseline != BaselineLabels && !baselineMechanism.IsValid() {
+		return nil, fmt.Errorf("unknown baseline %q", baseline)
+	}
+	evaluated := mechanisms
+	if baseline != BaselineLabels {
+		evaluated = append([]model.DetectionMechanism{baselineMechanism}, mechanisms...)
+	}
+	results := make([]Result, 0, len(evaluated))
+	for _, mechanism := range evaluated {
+		if len(results) > 0 && mechanism == baselineMechanism {
+			continue
+		}
+		results = append(results, Result{Mechanism: mechanism})
+	}
+
+	for _, c := range cases {
+		truth := c.Labels
+		for i := range results {
+			detected, runtime, err := detect(ctx, detector, c, results[i].Mechanism)
+			if err != nil {
+				return nil, err
+			}
+			if i == 0 && baseline != BaselineLabels {
+				truth = detected
+			}
+			m := Compare(detected, truth, minIoU)
+			m.Runtime = runtime
+			results[i].Metrics.Add(m)
+		}
+	}
+	return results, nil
+}
+
+// detect returns the intervals of the segments mechanism detects for c and the time it took.
+func detect(ctx context.Context, detector Detector, c Case, mechanism model.DetectionMechanism) ([]Interval, time.Duration, error) {
+	start := time.Now()
+	segments, err := detector.GetSegments(ctx, c.Subject, c.From, c.To, mechanism, c.Config)
+	runtime := time.Since(start)
+	if err != nil {
+		return nil, 0, fmt.Errorf("case %s: %s: %w", c.Name, mechanism, err)
+	}
+	return SegmentIntervals(segments, c.To), runtime, nil
+}
+
+// WriteReport writes results as a table to w.
+func WriteReport(w io.Writer, baseline string, results []Result) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintf(tw, "baseline: %s\t\t\t\t\t\t\t\t\t\t\n", baseline)
+	fmt.Fprintln(tw, "mechanism\tdetected\tbaseline\tmatched\tprecision\trecall\tmean start err\tmean end err\tmax boundary err\truntime\t")
+	for _, r := range results {
+		m := r.Metrics
+		fmt.Fprintf(tw, "%s\t%d\t%d\t%d\t%.3f\t%.3f\t%s\t%s\t%s\t%s\t\n",
+			r.Mechanism, m.Detected, m.Baseline, m.Matched, m.Precision(), m.Recall(),
+			m.MeanStartError(), m.MeanEndError(), m.MaxBoundaryError, m.Runtime.Round(time.Millisecond))
+	}
+	return tw.Flush()
+}
+
+// Check returns an error if the precision or recall of a result for one of mechanisms is below
+// minPrecision or minRecall.
+func Check(results []Result, mechanisms []model.DetectionMechanism, minPrecision, minRecall float64) error {
+	for _, mechanism := range mechanisms {
+		found := false
+		for _, r := range results {
+			if r.Mechanism != mechanism {
+				continue
+			}
+			found = true
+			if p := r.Metrics.Precision(); p < minPrecision {
+				return fmt.Errorf("%s precision %.3f is below %.3f", mechanism, p, minPrecision)
+			}
+			if rc := r.Metrics.Recall(); rc < minRecall {
+				return fmt.Errorf("%s recall %.3f is below %.3f", mechanism, rc, minRecall)
+			}
+		}
+		if !found {
+			return fmt.Errorf("%s was not evaluated", mechanism)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,84 @@
+package segmenteval
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/DIMO-Network/telemetry-api/internal/graph/model"
+	"github.com/stretchr/testify/require"
+)
+
+// staticDetector returns fixed segments per mechanism.
+type staticDetector map[model.DetectionMechanism][]Interval
+
+func (d staticDetector) GetSegments(_ context.Context, _ string, _, _ time.Time, mechanism model.DetectionMechanism, _ *model.SegmentConfig) ([]*model.Segment, error) {
+	var segments []*model.Segment
+	for _, i := range d[mechanism] {
+		segments = append(segments, &model.Segment{Start: &model.SignalLocation{Timestamp: i.Start}, End: &model.SignalLocation{Timestamp: i.End}})
+	}
+	return segments, nil
+}
+
+func TestRun(t *testing.T) {
+	t0 := time.Date(2025, 3, 1, 8, 0, 0, 0, time.UTC)
+	trip1 := Interval{Start: t0, End: t0.Add(30 * time.Minute)}
+	trip2 := Interval{Start: t0.Add(time.Hour), End: t0.Add(90 * time.Minute)}
+	detector := staticDetector{
+		model.DetectionMechanismIgnitionDetection:    {trip1, trip2},
+		model.DetectionMechanismChangePointDetection: {trip1, trip2},
+		model.DetectionMechanismFrequencyAnalysis:    {trip1},
+	}
+	cases := []Case{{Name: "day", Subject: "did:erc721:1:0x0:1", From: t0, To: t0.Add(24 * time.Hour), Labels: []Interval{trip1}}}
+	mechanisms := []model.DetectionMechanism{model.DetectionMechanismChangePointDetection, model.DetectionMechanismFrequencyAnalysis}
+
+	t.Run("mechanism baseline", func(t *testing.T) {
+		results, err := Run(context.Background(), detector, cases, string(model.DetectionMechanismIgnitionDetection), mechanisms, 0.5)
+		require.NoError(t, err)
+		require.Len(t, results, 3)
+		require.Equal(t, model.DetectionMechanismIgnitionDetection, results[0].Mechanism)
+		require.Equal(t, 1.0, results[1].Metrics.Recall())
+		require.Equal(t, 0.5, results[2].Metrics.Recall())
+
+		require.NoError(t, Check(results, mechanisms[:1], 1, 1))
+		require.ErrorContains(t, Check(results, mechanisms, 1, 1), "frequencyAnalysis recall")
+		require.ErrorContains(t, Check(results, []model.DetectionMechanism{model.DetectionMechanismStops}, 1, 1), "not evaluated")
+
+		var report bytes.Buffer
+		require.NoError(t, WriteReport(&report, string(model.DetectionMechanismIgnitionDetection), results))
+		require.Contains(t, report.String(), "changePointDetection")
+	})
+
+	t.Run("labels baseline", func(t *testing.T) {
+		results, err := Run(context.Background(), detector, cases, BaselineLabels, mechanisms, 0.5)
+		require.NoError(t, err)
+		require.Len(t, results, 2)
+		require.Equal(t, 0.5, results[0].Metrics.Precision())
+		require.Equal(t, 1.0, results[1].Metrics.Precision())
+	})
+
+	t.Run("unknown baseline", func(t *testing.T) {
+		_, err := Run(context.Background(), detector, cases, "odometer", mechanisms, 0.5)
+		require.Error(t, err)
+	})
+}
+
+func TestLoadCases(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "cases.json")
+
+	require.NoError(t, os.WriteFile(path, []byte(`[{"name": "a", "tokenId": 7, "from": "2025-03-01T00:00:00Z", "to": "2025-03-02T00:00:00Z",
+		"labels": [{"start": "2025-03-01T08:00:00Z", "end": "2025-03-01T08:30:00Z"}]}]`), 0o600))
+	cases, err := LoadCases(path)
+	require.NoError(t, err)
+	require.Len(t, cases, 1)
+	require.Equal(t, uint32(7), cases[0].TokenID)
+	require.Len(t, cases[0].Labels, 1)
+
+	require.NoError(t, os.WriteFile(path, []byte(`[{"name": "a", "from": "2025-03-01T00:00:00Z", "to": "2025-03-02T00:00:00Z"}]`), 0o600))
+	_, err = LoadCases(path)
+	require.ErrorContains(t, err, "neither subject nor tokenId")
+}
@@ -4,6 +4,7 @@ package ch
 import (
 	"context"
 	"crypto/tls"
+	"errors"
 	"fmt"
 	"time"
 
@@ -11,7 +12,7 @@ import (
 	"github.com/DIMO-Network/model-garage/pkg/vss"
 	"github.com/DIMO-Network/telemetry-api/internal/config"
 	"github.com/DIMO-Network/telemetry-api/internal/graph/model"
-	"github.com/aarondl/sqlboiler/v4/queries/qm"
+	"github.com/DIMO-Network/telemetry-api/internal/service/ch/migrations"
 	"github.com/prometheus/client_golang/prometheus"
 )
 
@@ -25,16 +26,61 @@ const (
 // Service is a ClickHouse service that interacts with the ClickHouse database.
 type Service struct {
 	conn clickhouse.Conn
+	// segmentStore is nil unless the segment store is enabled.
+	segmentStore *segmentStore
 }
 
 // NewService creates a new ClickHouse service.
 func NewService(settings config.Settings) (*Service, error) {
+	options, err := clickhouseOptions(settings)
+	if err != nil {
+		return nil, err
+	}
+	conn, err := clickhouse.Open(options)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open clickhouse connection: %w", err)
+	}
+	err = conn.Ping(context.Background())
+	if err != nil {
+		return nil, fmt.Errorf("failed to ping clickhouse: %w", err)
+	}
+	service := &Service{conn: conn}
+	if settings.SegmentStoreEnabled {
+		service.segmentStore = newSegmentStore(conn)
+		go service.segmentStore.run()
+	}
+	return service, nil
+}
+
+// Close stops the segment store writer and closes the ClickHouse connection.
+func (s *Service) Close() error {
+	if s.segmentStore != nil {
+		s.segmentStore.stop()
+	}
+	if err := s.conn.Close(); err != nil {
+		return fmt.Errorf("failed to close clickhouse connection: %w", err)
+	}
+	return nil
+}
+
+// Migrate applies the migrations for the tables owned by this service.
+func Migrate(ctx context.Context, settings config.Settings) (retErr error) {
+	options, err := clickhouseOptions(settings)
+	if err != nil {
+		return err
+	}
+	db := clickhouse.OpenDB(options)
+	defer func() { retErr = errors.Join(retErr, db.Close()) }()
+	return migrations.Up(ctx, db)
+}
+
+func clickhouseOptions(settings config.Settings) (*clickhouse.Options, error) {
 	maxExecutionTime, err := getMaxExecutionTime(settings.MaxRequestDuration)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get max execution time: %w", err)
 	}
 	addr := fmt.Sprintf("%s:%d", settings.Clickhouse.Host, settings.Clickhouse.Port)
-	conn, err := clickhouse.Open(&clickhouse.Options{
+	return &clickhouse.Options{
 		Addr: []string{addr},
 		Auth: clickhouse.Auth{
 			Username: settings.Clickhouse.User,
@@ -51,15 +97,7 @@ func NewService(settings config.Settings) (*Service, error) {
 			"max_execution_time":                      maxExecutionTime,
 			"timeout_before_checking_execution_speed": defaultTimeoutBeforeCheckingExecutionSpeed,
 		},
-	})
-	if err != nil {
-		return nil, fmt.Errorf("failed to open clickhouse connection: %w", err)
-	}
-	err = conn.Ping(context.Background())
-	if err != nil {
-		return nil, fmt.Errorf("failed to ping clickhouse: %w", err)
-	}
-	return &Service{conn: conn}, nil
+	}, nil
 }
 
 func getMaxExecutionTime(maxRequestDuration string) (int, error) {
@@ -249,8 +287,13 @@ func (s *Service) getAggSignals(ctx context.Context, stmt string, args []any) ([
 
 // GetAvailableSignals returns a slice of available signals from the ClickHouse database.
 // if no signals are available, a nil slice is returned.
-func (s *Service) GetAvailableSignals(ctx context.Context, subject string, filter *model.SignalFilter) ([]string, error) {
+// Without time bounds the precomputed signal_latest table answers the query;
+// with either bound the signal table is scanned over [from, to).
+func (s *Service) GetAvailableSignals(ctx context.Context, subject string, from, to *time.Time, filter *model.SignalFilter) ([]string, error) {
 	stmt, args := getDistinctQuery(subject, filter)
+	if from != nil || to != nil {
+		stmt, args = getRangeDistinctQuery(subject, from, to, filter)
+	}
 	rows, err := s.conn.Query(ctx, stmt, args...)
 	if err != nil {
 		return nil, fmt.Errorf("failed querying clickhouse: %w", err)
@@ -273,8 +316,14 @@ func (s *Service) GetAvailableSignals(ctx context.Context, subject string, filte
 	return signals, nil
 }
 
-func (s *Service) GetSignalSummaries(ctx context.Context, subject string, filter *model.SignalFilter) ([]*model.SignalDataSummary, error) {
+// GetSignalSummaries returns per-signal count and first/last seen for a subject.
+// Without time bounds the lifetime signal_summary table answers the query;
+// with either bound the signal table is grouped over [from, to).
+func (s *Service) GetSignalSummaries(ctx context.Context, subject string, from, to *time.Time, filter *model.SignalFilter) ([]*model.SignalDataSummary, error) {
 	stmt, args := getSignalSummariesQuery(subject, filter)
+	if from != nil || to != nil {
+		stmt, args = getRangeSignalSummariesQuery(subject, from, to, filter)
+	}
 	rows, err := s.conn.Query(ctx, stmt, args...)
 	if err != nil {
 		return nil, fmt.Errorf("failed querying clickhouse: %w", err)
@@ -305,6 +354,20 @@ type EventCountForRange struct {
 	Count    int
 }
 
+// LocationForRange is a location sample with its segment index (from GetLocationsForRanges).
+type LocationForRange struct {
+	SegIndex  int
+	Timestamp time.Time
+	Location  vss.Location
+}
+
+// FloatSample is a raw sample of a float signal (from GetFloatSamples).
+type FloatSample struct {
+	Name      string
+	Timestamp time.Time
+	Value     float64
+}
+
 // EventSummary is the per-event summary for a vehicle (all time): name, count, first/last seen.
 type EventSummary struct {
 	Name      string
@@ -314,10 +377,14 @@ type EventSummary struct {
 }
 
 // GetEventSummaries returns per-event summaries (name, count, first/last seen)
-// for a subject (vehicle), over all time, read from the precomputed
-// event_summary table.
-func (s *Service) GetEventSummaries(ctx context.Context, subject string) ([]*EventSummary, error) {
+// for a subject (vehicle). Without time bounds they cover all time and are read
+// from the precomputed event_summary table; with either bound the event table
+// is grouped over [from, to).
+func (s *Service) GetEventSummaries(ctx context.Context, subject string, from, to *time.Time) ([]*EventSummary, error) {
 	stmt, args := getEventSummariesQuery(subject)
+	if from != nil || to != nil {
+		stmt, args = getRangeEventSummariesQuery(subject, from, to)
+	}
 	rows, err := s.conn.Query(ctx, stmt, args...)
 	if err != nil {
 		return nil, fmt.Errorf("failed querying clickhouse for event summaries: %w", err)
@@ -338,30 +405,52 @@ func (s *Service) GetEventSummaries(ctx context.Context, subject string) ([]*Eve
 	return result, nil
 }
 
-func (s *Service) GetEvents(ctx context.Context, subject string, from, to time.Time, filter *model.EventFilter) ([]*vss.Event, error) {
-	mods := []qm.QueryMod{
-		qm.Select(vss.EventNameCol, vss.EventSourceCol, vss.EventTimestampCol, vss.EventDurationNsCol, vss.EventMetadataCol, vss.EventTagsCol),
-		qm.From(vss.EventTableName),
-		qm.Where(eventSubjectWhere, subject),
-		qm.Where(vss.EventTimestampCol + " >= " + dateTime64Micro(from)),
-		qm.Where(vss.EventTimestampCol + " < " + dateTime64Micro(to)),
-		qm.OrderBy(vss.EventTimestampCol + " DESC"),
-	}
-	mods = appendEventFilterMods(mods, filter)
-	stmt, args := newQuery(mods...)
+// EventQueryOptions are the options of an events query.
+type EventQueryOptions struct {
+	// Ascending orders events oldest first instead of newest first.
+	Ascending bool
+	// Limit caps the number of events if positive. Events sharing the
+	// timestamp of the last one are all returned, so that a page of events
+	// never splits a timestamp.
+	Limit int
+	// After returns only the events after this timestamp.
+	After *time.Time
+	// WithLocation attaches the vehicle location at each event's timestamp.
+	WithLocation bool
+}
 
+// Event is a stored event. Location is the latest non-zero
+// currentLocationCoordinates sample at or before the event, no older than
+// eventLocationLookback. It is nil if not requested or no sample was found.
+type Event struct {
+	vss.Event
+	Location *vss.Location
+}
+
+// GetEvents returns the events in the given time range matching the filter.
+func (s *Service) GetEvents(ctx context.Context, subject string, from, to time.Time, filter *model.EventFilter, opts EventQueryOptions) ([]*Event, error) {
+	stmt, args := getEventsQuery(subject, from, to, filter, opts)
 	rows, err := s.conn.Query(ctx, stmt, args...)
 	if err != nil {
 		return nil, fmt.Errorf("failed querying clickhouse for events: %w", err)
 	}
-	events := []*vss.Event{}
+	events := []*Event{}
 	for rows.Next() {
-		var event vss.Event
-		err := rows.Scan(&event.Data.Name, &event.Source, &event.Data.Timestamp, &event.Data.DurationNs, &event.Data.Metadata, &event.Tags)
-		if err != nil {
+		var event Event
+		dest := []any{&event.Data.Name, &event.Source, &event.Data.Timestamp, &event.Data.DurationNs, &event.Data.Metadata, &event.Tags}
+		var locTimestamp time.Time
+		var loc vss.Location
+		if opts.WithLocation {
+			dest = append(dest, &locTimestamp, &loc)
+		}
+		if err := rows.Scan(dest...); err != nil {
 			_ = rows.Close()
 			return nil, fmt.Errorf("failed scanning clickhouse event row: %w", err)
 		}
+		// Events without a matching sample get the column defaults.
+		if opts.WithLocation && !locTimestamp.Before(event.Data.Timestamp.Add(-eventLocationLookback)) {
+			event.Location = &loc
+		}
 		events = append(events, &event)
 	}
 	_ = rows.Close()
@@ -396,6 +485,93 @@ func (s *Service) GetEventCounts(ctx context.Context, subject string, from, to t
 	return result, nil
 }
 
+// GetEventAggregations returns event counts and total durations by name per
+// interval bucket, ordered by bucket and name. Buckets start at from.
+func (s *Service) GetEventAggregations(ctx context.Context, subject string, from, to time.Time, intervalMicro int64, filter *model.EventFilter) ([]*model.EventAggregation, error) {
+	stmt, args := getEventAggregationsQuery(subject, from, to, intervalMicro, filter)
+	rows, err := s.conn.Query(ctx, stmt, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed querying clickhouse for event aggregations: %w", err)
+	}
+	result := []*model.EventAggregation{}
+	for rows.Next() {
+		var agg model.EventAggregation
+		var count, durationNs uint64
+		if err := rows.Scan(&agg.Timestamp, &agg.Name, &count, &durationNs); err != nil {
+			_ = rows.Close()
+			return nil, fmt.Errorf("failed scanning event aggregation row: %w", err)
+		}
+		agg.Count = int(count)
+		agg.DurationNs = int(durationNs)
+		result = append(result, &agg)
+	}
+	_ = rows.Close()
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("clickhouse event aggregation row error: %w", rows.Err())
+	}
+	return result, nil
+}
+
+// GetLocationsForRanges returns the non-zero location samples in each range, downsampled to one per
+// 10 seconds and capped per range, ordered by segment index and timestamp, in one query over
+// [globalFrom, globalTo).
+func (s *Service) GetLocationsForRanges(ctx context.Context, subject string, ranges []TimeRange, globalFrom, globalTo time.Time) ([]*LocationForRange, error) {
+	if len(ranges) == 0 {
+		return nil, nil
+	}
+	stmt, args := getLocationsForRangesQuery(subject, ranges, globalFrom, globalTo)
+	timer := prometheus.NewTimer(GetLocationsForRangesLatency)
+	rows, err := s.conn.Query(ctx, stmt, args...)
+	timer.ObserveDuration()
+	if err != nil {
+		return nil, fmt.Errorf("failed querying clickhouse for locations by range: %w", err)
+	}
+	var result []*LocationForRange
+	for rows.Next() {
+		var segIdx int16 // ClickHouse multiIf returns Int16 for small segment indices
+		loc := &LocationForRange{}
+		if err := rows.Scan(&segIdx, &loc.Timestamp, &loc.Location); err != nil {
+			_ = rows.Close()
+			return nil, fmt.Errorf("failed scanning location by range row: %w", err)
+		}
+		loc.SegIndex = int(segIdx)
+		result = append(result, loc)
+	}
+	_ = rows.Close()
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("clickhouse location by range row error: %w", rows.Err())
+	}
+	return result, nil
+}
+
+// GetFloatSamples returns the raw samples of the float signals names in [from, to), ordered by timestamp.
+func (s *Service) GetFloatSamples(ctx context.Context, subject string, from, to time.Time, names []string) ([]*FloatSample, error) {
+	if len(names) == 0 {
+		return nil, nil
+	}
+	stmt, args := getFloatSamplesQuery(subject, from, to, names)
+	timer := prometheus.NewTimer(GetFloatSamplesLatency)
+	rows, err := s.conn.Query(ctx, stmt, args...)
+	timer.ObserveDuration()
+	if err != nil {
+		return nil, fmt.Errorf("failed querying clickhouse for float samples: %w", err)
+	}
+	var result []*FloatSample
+	for rows.Next() {
+		sample := &FloatSample{}
+		if err := rows.Scan(&sample.Name, &sample.Timestamp, &sample.Value); err != nil {
+			_ = rows.Close()
+			return nil, fmt.Errorf("failed scanning float sample row: %w", err)
+		}
+		result = append(result, sample)
+	}
+	_ = rows.Close()
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("clickhouse float sample row error: %w", rows.Err())
+	}
+	return result, nil
+}
+
 // GetEventCountsForRanges returns event counts by name per segment index for multiple time ranges in one query.
 // If eventNames is nil or empty, all event names are returned; otherwise only requested names (missing get count 0 at call site).
 func (s *Service) GetEventCountsForRanges(ctx context.Context, subject string, ranges []TimeRange, eventNames []string) ([]*EventCountForRange, error) {
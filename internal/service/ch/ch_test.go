@@ -941,20 +941,35 @@ func (c *CHServiceTestSuite) TestGetLatestSignal() {
 func (c *CHServiceTestSuite) TestGetAvailableSignals() {
 	ctx := context.Background()
 	c.Run("has signals", func() {
-		result, err := c.chService.GetAvailableSignals(ctx, testSubject1, nil)
+		result, err := c.chService.GetAvailableSignals(ctx, testSubject1, nil, nil, nil)
 		c.Require().NoError(err)
 		c.Require().Len(result, 3)
 		c.Require().Equal([]string{vss.FieldCurrentLocationCoordinates, vss.FieldPowertrainType, vss.FieldSpeed}, result)
 	})
 
 	c.Run("no signals", func() {
-		result, err := c.chService.GetAvailableSignals(ctx, testSubject2, nil)
+		result, err := c.chService.GetAvailableSignals(ctx, testSubject2, nil, nil, nil)
 		c.Require().NoError(err)
 		c.Require().Nil(result)
 	})
 
 	c.Run("filter signals", func() {
-		result, err := c.chService.GetAvailableSignals(ctx, testSubject1, &model.SignalFilter{Source: ref("did:ethr:137:0x0000000000000000000000000000000000000000")})
+		result, err := c.chService.GetAvailableSignals(ctx, testSubject1, nil, nil, &model.SignalFilter{Source: ref("did:ethr:137:0x0000000000000000000000000000000000000000")})
+		c.Require().NoError(err)
+		c.Require().Nil(result)
+	})
+
+	c.Run("time range with signals", func() {
+		from := c.dataStartTime
+		to := c.dataStartTime.Add(time.Hour)
+		result, err := c.chService.GetAvailableSignals(ctx, testSubject1, &from, &to, nil)
+		c.Require().NoError(err)
+		c.Require().Equal([]string{vss.FieldCurrentLocationCoordinates, vss.FieldPowertrainType, vss.FieldSpeed}, result)
+	})
+
+	c.Run("time range before data", func() {
+		to := c.dataStartTime
+		result, err := c.chService.GetAvailableSignals(ctx, testSubject1, nil, &to, nil)
 		c.Require().NoError(err)
 		c.Require().Nil(result)
 	})
@@ -1108,7 +1123,7 @@ func (c *CHServiceTestSuite) TestGetEvents() {
 	to := baseTime.Add(15 * time.Minute)
 
 	c.Run("all events for subject and time range", func() {
-		result, err := c.chService.GetEvents(ctx, subject, from, to, nil)
+		result, err := c.chService.GetEvents(ctx, subject, from, to, nil, EventQueryOptions{})
 		c.Require().NoError(err)
 		c.Require().Len(result, 3)
 		// Should be ordered by timestamp DESC
@@ -1133,7 +1148,7 @@ func (c *CHServiceTestSuite) TestGetEvents() {
 
 	c.Run("filter by name", func() {
 		filter := &model.EventFilter{Name: &model.StringValueFilter{Eq: ref("event.a")}}
-		result, err := c.chService.GetEvents(ctx, subject, from, to, filter)
+		result, err := c.chService.GetEvents(ctx, subject, from, to, filter, EventQueryOptions{})
 		c.Require().NoError(err)
 		c.Require().Len(result, 2)
 		for _, ev := range result {
@@ -1143,7 +1158,7 @@ func (c *CHServiceTestSuite) TestGetEvents() {
 
 	c.Run("filter by source", func() {
 		filter := &model.EventFilter{Source: &model.StringValueFilter{Eq: ref("source2")}}
-		result, err := c.chService.GetEvents(ctx, subject, from, to, filter)
+		result, err := c.chService.GetEvents(ctx, subject, from, to, filter, EventQueryOptions{})
 		c.Require().NoError(err)
 		c.Require().Len(result, 2)
 		for _, ev := range result {
@@ -1152,14 +1167,14 @@ func (c *CHServiceTestSuite) TestGetEvents() {
 	})
 
 	c.Run("no events in range", func() {
-		result, err := c.chService.GetEvents(ctx, subject, baseTime.Add(-2*time.Hour), baseTime.Add(-time.Hour), nil)
+		result, err := c.chService.GetEvents(ctx, subject, baseTime.Add(-2*time.Hour), baseTime.Add(-time.Hour), nil, EventQueryOptions{})
 		c.Require().NoError(err)
 		c.Require().Len(result, 0)
 	})
 
 	c.Run("filter by name neq", func() {
 		filter := &model.EventFilter{Name: &model.StringValueFilter{Neq: ref("event.a")}}
-		result, err := c.chService.GetEvents(ctx, subject, from, to, filter)
+		result, err := c.chService.GetEvents(ctx, subject, from, to, filter, EventQueryOptions{})
 		c.Require().NoError(err)
 		c.Require().Len(result, 1)
 		c.Require().Equal("event.b", result[0].Data.Name)
@@ -1167,14 +1182,14 @@ func (c *CHServiceTestSuite) TestGetEvents() {
 
 	c.Run("filter by name in", func() {
 		filter := &model.EventFilter{Name: &model.StringValueFilter{In: []string{"event.a", "event.b"}}}
-		result, err := c.chService.GetEvents(ctx, subject, from, to, filter)
+		result, err := c.chService.GetEvents(ctx, subject, from, to, filter, EventQueryOptions{})
 		c.Require().NoError(err)
 		c.Require().Len(result, 3)
 	})
 
 	c.Run("filter by name notin", func() {
 		filter := &model.EventFilter{Name: &model.StringValueFilter{NotIn: []string{"event.a"}}}
-		result, err := c.chService.GetEvents(ctx, subject, from, to, filter)
+		result, err := c.chService.GetEvents(ctx, subject, from, to, filter, EventQueryOptions{})
 		c.Require().NoError(err)
 		c.Require().Len(result, 1)
 		c.Require().Equal("event.b", result[0].Data.Name)
@@ -1182,7 +1197,7 @@ func (c *CHServiceTestSuite) TestGetEvents() {
 
 	c.Run("filter by source neq", func() {
 		filter := &model.EventFilter{Source: &model.StringValueFilter{Neq: ref("source2")}}
-		result, err := c.chService.GetEvents(ctx, subject, from, to, filter)
+		result, err := c.chService.GetEvents(ctx, subject, from, to, filter, EventQueryOptions{})
 		c.Require().NoError(err)
 		c.Require().Len(result, 1)
 		c.Require().Equal("source1", result[0].Source)
@@ -1190,14 +1205,14 @@ func (c *CHServiceTestSuite) TestGetEvents() {
 
 	c.Run("filter by source in", func() {
 		filter := &model.EventFilter{Source: &model.StringValueFilter{In: []string{"source1", "source2"}}}
-		result, err := c.chService.GetEvents(ctx, subject, from, to, filter)
+		result, err := c.chService.GetEvents(ctx, subject, from, to, filter, EventQueryOptions{})
 		c.Require().NoError(err)
 		c.Require().Len(result, 3)
 	})
 
 	c.Run("filter by source notin", func() {
 		filter := &model.EventFilter{Source: &model.StringValueFilter{NotIn: []string{"source2"}}}
-		result, err := c.chService.GetEvents(ctx, subject, from, to, filter)
+		result, err := c.chService.GetEvents(ctx, subject, from, to, filter, EventQueryOptions{})
 		c.Require().NoError(err)
 		c.Require().Len(result, 1)
 		c.Require().Equal("source1", result[0].Source)
@@ -1205,7 +1220,7 @@ func (c *CHServiceTestSuite) TestGetEvents() {
 
 	c.Run("filter by tags hasAny", func() {
 		filter := &model.EventFilter{Tags: &model.StringArrayFilter{ContainsAny: []string{"behavior.harshAcceleration"}}}
-		result, err := c.chService.GetEvents(ctx, subject, from, to, filter)
+		result, err := c.chService.GetEvents(ctx, subject, from, to, filter, EventQueryOptions{})
 		c.Require().NoError(err)
 		c.Require().Len(result, 2)
 		c.Require().Equal("event.a", result[0].Data.Name)
@@ -1218,7 +1233,7 @@ func (c *CHServiceTestSuite) TestGetEvents() {
 
 	c.Run("filter by tags hasAll", func() {
 		filter := &model.EventFilter{Tags: &model.StringArrayFilter{ContainsAll: []string{"behavior.harshAcceleration", "behavior.harshCornering"}}}
-		result, err := c.chService.GetEvents(ctx, subject, from, to, filter)
+		result, err := c.chService.GetEvents(ctx, subject, from, to, filter, EventQueryOptions{})
 		c.Require().NoError(err)
 		c.Require().Len(result, 1)
 		c.Require().Equal("event.a", result[0].Data.Name)
@@ -1233,14 +1248,14 @@ func (c *CHServiceTestSuite) TestGetEvents() {
 				ContainsAny: []string{"behavior.harshAcceleration", "safety.collision"},
 			}},
 		}}
-		result, err := c.chService.GetEvents(ctx, subject, from, to, filter)
+		result, err := c.chService.GetEvents(ctx, subject, from, to, filter, EventQueryOptions{})
 		c.Require().NoError(err)
 		c.Require().Len(result, 3)
 	})
 
 	c.Run("filter by tags hasAll no matching events", func() {
 		filter := &model.EventFilter{Tags: &model.StringArrayFilter{ContainsAll: []string{"behavior.harshBraking", "safety.collision"}}}
-		result, err := c.chService.GetEvents(ctx, subject, from, to, filter)
+		result, err := c.chService.GetEvents(ctx, subject, from, to, filter, EventQueryOptions{})
 		c.Require().NoError(err)
 		c.Require().Len(result, 0)
 	})
@@ -1251,7 +1266,7 @@ func (c *CHServiceTestSuite) TestGetEvents() {
 				NotContainsAny: []string{"behavior.harshAcceleration", "safety.collision"},
 			},
 		}
-		result, err := c.chService.GetEvents(ctx, subject, from, to, filter)
+		result, err := c.chService.GetEvents(ctx, subject, from, to, filter, EventQueryOptions{})
 		c.Require().NoError(err)
 		c.Require().Len(result, 1)
 		c.Require().Equal("event.b", result[0].Data.Name)
@@ -1264,7 +1279,7 @@ func (c *CHServiceTestSuite) TestGetEvents() {
 				NotContainsAll: []string{"behavior.harshAcceleration", "safety.collision"},
 			},
 		}
-		result, err := c.chService.GetEvents(ctx, subject, from, to, filter)
+		result, err := c.chService.GetEvents(ctx, subject, from, to, filter, EventQueryOptions{})
 		c.Require().NoError(err)
 		c.Require().Len(result, 2)
 		c.Require().Equal("event.b", result[0].Data.Name)
@@ -1280,7 +1295,7 @@ func (c *CHServiceTestSuite) TestGetEvents() {
 				ContainsAny: []string{"safety.collision"},
 			}},
 		}}
-		result, err := c.chService.GetEvents(ctx, subject, from, to, filter)
+		result, err := c.chService.GetEvents(ctx, subject, from, to, filter, EventQueryOptions{})
 		c.Require().NoError(err)
 		c.Require().Len(result, 2)
 		c.Require().Equal("event.a", result[0].Data.Name)
@@ -1295,7 +1310,7 @@ func (c *CHServiceTestSuite) TestGetEvents() {
 		filter := &model.EventFilter{Tags: &model.StringArrayFilter{
 			NotContainsAny: []string{"safety.collision"},
 		}}
-		result, err := c.chService.GetEvents(ctx, subject, from, to, filter)
+		result, err := c.chService.GetEvents(ctx, subject, from, to, filter, EventQueryOptions{})
 		c.Require().NoError(err)
 		c.Require().Len(result, 2)
 		c.Require().Equal("event.b", result[0].Data.Name)
@@ -1312,7 +1327,7 @@ func (c *CHServiceTestSuite) TestGetEvents() {
 				ContainsAny: []string{"behavior.harshBraking"},
 			}},
 		}}
-		result, err := c.chService.GetEvents(ctx, subject, from, to, filter)
+		result, err := c.chService.GetEvents(ctx, subject, from, to, filter, EventQueryOptions{})
 		c.Require().NoError(err)
 		c.Require().Len(result, 2)
 		c.Require().Equal("event.b", result[0].Data.Name)
@@ -1326,7 +1341,7 @@ func (c *CHServiceTestSuite) TestGetEvents() {
 			ContainsAll:    []string{"behavior.harshBraking"},
 			NotContainsAny: []string{"safety.collision"},
 		}}
-		result, err := c.chService.GetEvents(ctx, subject, from, to, filter)
+		result, err := c.chService.GetEvents(ctx, subject, from, to, filter, EventQueryOptions{})
 		c.Require().NoError(err)
 		c.Require().Len(result, 2)
 		c.Require().Equal("event.b", result[0].Data.Name)
@@ -1342,7 +1357,7 @@ func (c *CHServiceTestSuite) TestGetEvents() {
 			ContainsAny: []string{"behavior.harshBraking", "safety.collision"},
 			ContainsAll: []string{"behavior.harshAcceleration", "behavior.harshCornering"},
 		}}
-		result, err := c.chService.GetEvents(ctx, subject, from, to, filter)
+		result, err := c.chService.GetEvents(ctx, subject, from, to, filter, EventQueryOptions{})
 		c.Require().NoError(err)
 		c.Require().Len(result, 1)
 		c.Require().Equal("event.a", result[0].Data.Name)
@@ -1351,14 +1366,14 @@ func (c *CHServiceTestSuite) TestGetEvents() {
 
 	c.Run("filter by tags no matches", func() {
 		filter := &model.EventFilter{Tags: &model.StringArrayFilter{ContainsAny: []string{"nonexistent"}}}
-		result, err := c.chService.GetEvents(ctx, subject, from, to, filter)
+		result, err := c.chService.GetEvents(ctx, subject, from, to, filter, EventQueryOptions{})
 		c.Require().NoError(err)
 		c.Require().Len(result, 0)
 	})
 
 	c.Run("filter by name startsWith", func() {
 		filter := &model.EventFilter{Name: &model.StringValueFilter{StartsWith: ref("event.a")}}
-		result, err := c.chService.GetEvents(ctx, subject, from, to, filter)
+		result, err := c.chService.GetEvents(ctx, subject, from, to, filter, EventQueryOptions{})
 		c.Require().NoError(err)
 		c.Require().Len(result, 2)
 		for _, ev := range result {
@@ -1368,7 +1383,7 @@ func (c *CHServiceTestSuite) TestGetEvents() {
 
 	c.Run("filter by name startsWith prefix only", func() {
 		filter := &model.EventFilter{Name: &model.StringValueFilter{StartsWith: ref("event.")}}
-		result, err := c.chService.GetEvents(ctx, subject, from, to, filter)
+		result, err := c.chService.GetEvents(ctx, subject, from, to, filter, EventQueryOptions{})
 		c.Require().NoError(err)
 		c.Require().Len(result, 3) // event.a (x2) + event.b (x1)
 	})
@@ -1380,14 +1395,14 @@ func (c *CHServiceTestSuite) TestGetEvents() {
 				{Eq: ref("event.b")},
 			},
 		}}
-		result, err := c.chService.GetEvents(ctx, subject, from, to, filter)
+		result, err := c.chService.GetEvents(ctx, subject, from, to, filter, EventQueryOptions{})
 		c.Require().NoError(err)
 		c.Require().Len(result, 3)
 	})
 
 	c.Run("filter by name startsWith no match", func() {
 		filter := &model.EventFilter{Name: &model.StringValueFilter{StartsWith: ref("nonexistent.")}}
-		result, err := c.chService.GetEvents(ctx, subject, from, to, filter)
+		result, err := c.chService.GetEvents(ctx, subject, from, to, filter, EventQueryOptions{})
 		c.Require().NoError(err)
 		c.Require().Len(result, 0)
 	})
@@ -0,0 +1,158 @@
+package ch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/DIMO-Network/telemetry-api/internal/graph/model"
+)
+
+// CoverageWindow is the number of samples of one signal in one window, with
+// the first and last sample times inside the window.
+type CoverageWindow struct {
+	Name        string
+	WindowStart time.Time
+	Count       uint64
+	FirstSeen   time.Time
+	LastSeen    time.Time
+}
+
+// GetSignalCoverage returns per-signal sample counts in windows of
+// windowSizeSeconds over [from, to), ordered by name and window start.
+// Windows without samples are omitted. If names is empty, every signal is
+// counted.
+func (s *Service) GetSignalCoverage(ctx context.Context, subject string, from, to time.Time, windowSizeSeconds int, names []string, filter *model.SignalFilter) (_ []*CoverageWindow, retErr error) {
+	query, args := getSignalCoverageQuery(subject, from, to, windowSizeSeconds, names, filter)
+	rows, err := s.conn.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query signal coverage: %w", err)
+	}
+	defer func() { retErr = errors.Join(retErr, rows.Close()) }()
+
+	var windows []*CoverageWindow
+	for rows.Next() {
+		var w CoverageWindow
+		if err := rows.Scan(&w.Name, &w.WindowStart, &w.Count, &w.FirstSeen, &w.LastSeen); err != nil {
+			return nil, fmt.Errorf("failed to scan signal coverage row: %w", err)
+		}
+		windows = append(windows, &w)
+	}
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("failed to iterate signal coverage rows: %w", rows.Err())
+	}
+	return windows, nil
+}
+
+// getSignalCoverageQuery uses the windowing of getWindowedSignalCounts, split
+// by signal name and without activity thresholds.
+func getSignalCoverageQuery(subject string, from, to time.Time, windowSizeSeconds int, names []string, filter *model.SignalFilter) (string, []any) {
+	var extraWhere strings.Builder
+	args := []any{windowSizeSeconds, subject}
+	if len(names) > 0 {
+		extraWhere.WriteString("\n  AND " + nameIn)
+		args = append(args, names)
+	}
+	if filter != nil && filter.Source != nil {
+		extraWhere.WriteString("\n  AND " + sourceWhere)
+		args = append(args, sourceAddress(*filter.Source))
+	}
+	query := fmt.Sprintf(`
+SELECT
+    name,
+    toStartOfInterval(timestamp, INTERVAL ? second) AS window_start,
+    count() AS signal_count,
+    min(timestamp) AS first_seen,
+    max(timestamp) AS last_seen
+FROM signal FINAL
+PREWHERE subject = ?
+WHERE timestamp >= %s
+  AND timestamp < %s%s
+GROUP BY name, window_start
+ORDER BY name, window_start`, dateTime64Micro(from), dateTime64Micro(to), extraWhere.String())
+	return query, args
+}
+
+// SamplingStats are the inter-sample interval statistics of one signal from
+// one source. Intervals are in milliseconds and only meaningful if
+// IntervalCount is positive.
+type SamplingStats struct {
+	Name             string
+	Source           string
+	SampleCount      uint64
+	ActiveHours      uint64
+	IntervalCount    uint64
+	MedianIntervalMs float64
+	P95IntervalMs    float64
+	MaxIntervalMs    int64
+}
+
+// GetSamplingStats returns sampling statistics per signal name and source over
+// [from, to), ordered by name and source. If names is empty, every signal is
+// included.
+func (s *Service) GetSamplingStats(ctx context.Context, subject string, from, to time.Time, names []string, filter *model.SignalFilter) (_ []*SamplingStats, retErr error) {
+	query, args := getSamplingStatsQuery(subject, from, to, names, filter)
+	rows, err := s.conn.Query(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query sampling stats: %w", err)
+	}
+	defer func() { retErr = errors.Join(retErr, rows.Close()) }()
+
+	var stats []*SamplingStats
+	for rows.Next() {
+		var st SamplingStats
+		if err := rows.Scan(&st.Name, &st.Source, &st.SampleCount, &st.ActiveHours, &st.IntervalCount, &st.MedianIntervalMs, &st.P95IntervalMs, &st.MaxIntervalMs); err != nil {
+			return nil, fmt.Errorf("failed to scan sampling stats row: %w", err)
+		}
+		stats = append(stats, &st)
+	}
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("failed to iterate sampling stats rows: %w", rows.Err())
+	}
+	return stats, nil
+}
+
+// getSamplingStatsQuery computes the interval to the previous sample of the
+// same name and source with a window function. The first sample of each
+// partition has no previous sample and is excluded from the interval
+// aggregates.
+func getSamplingStatsQuery(subject string, from, to time.Time, names []string, filter *model.SignalFilter) (string, []any) {
+	var extraWhere strings.Builder
+	args := []any{subject}
+	if len(names) > 0 {
+		extraWhere.WriteString("\n      AND " + nameIn)
+		args = append(args, names)
+	}
+	if filter != nil && filter.Source != nil {
+		extraWhere.WriteString("\n      AND " + sourceWhere)
+		args = append(args, sourceAddress(*filter.Source))
+	}
+	query := fmt.Sprintf(`
+SELECT
+    name,
+    source,
+    count() AS sample_count,
+    uniqExact(toStartOfHour(timestamp)) AS active_hours,
+    countIf(row_num > 1) AS interval_count,
+    toFloat64(quantileExactIf(0.5)(interval_ms, row_num > 1)) AS median_interval_ms,
+    toFloat64(quantileExactIf(0.95)(interval_ms, row_num > 1)) AS p95_interval_ms,
+    maxIf(interval_ms, row_num > 1) AS max_interval_ms
+FROM (
+    SELECT
+        name,
+        source,
+        timestamp,
+        toUnixTimestamp64Milli(timestamp) - lagInFrame(toUnixTimestamp64Milli(timestamp)) OVER w AS interval_ms,
+        row_number() OVER w AS row_num
+    FROM signal FINAL
+    PREWHERE subject = ?
+    WHERE timestamp >= %s
+      AND timestamp < %s%s
+    WINDOW w AS (PARTITION BY name, source ORDER BY timestamp ROWS BETWEEN UNBOUNDED PRECEDING AND CURRENT ROW)
+)
+GROUP BY name, source
+ORDER BY name, source`, dateTime64Micro(from), dateTime64Micro(to), extraWhere.String())
+	return query, args
+}
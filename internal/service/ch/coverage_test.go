@@ -0,0 +1,42 @@
+package ch
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DIMO-Network/telemetry-api/internal/graph/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetSignalCoverageQuery(t *testing.T) {
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(24 * time.Hour)
+
+	t.Run("all signals", func(t *testing.T) {
+		stmt, args := getSignalCoverageQuery("subj", from, to, 3600, nil, nil)
+		assert.Contains(t, stmt, "FROM signal FINAL")
+		assert.Contains(t, stmt, "GROUP BY name, window_start")
+		assert.NotContains(t, stmt, "name IN")
+		assert.Equal(t, []any{3600, "subj"}, args)
+	})
+
+	t.Run("names and source", func(t *testing.T) {
+		filter := &model.SignalFilter{Source: ref("did:ethr:137:0xcd445F4c6bDAD32b68a2939b912150Fe3C88803E")}
+		stmt, args := getSignalCoverageQuery("subj", from, to, 900, []string{"speed"}, filter)
+		assert.Contains(t, stmt, "AND name IN ?")
+		assert.Contains(t, stmt, "AND source = ?")
+		assert.Equal(t, []any{900, "subj", []string{"speed"}, "0xcd445F4c6bDAD32b68a2939b912150Fe3C88803E"}, args)
+	})
+}
+
+func TestGetSamplingStatsQuery(t *testing.T) {
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(24 * time.Hour)
+
+	stmt, args := getSamplingStatsQuery("subj", from, to, []string{"speed"}, &model.SignalFilter{Source: ref("0xsource")})
+	assert.Contains(t, stmt, "PARTITION BY name, source ORDER BY timestamp")
+	assert.Contains(t, stmt, "GROUP BY name, source")
+	assert.Contains(t, stmt, "AND name IN ?")
+	assert.Contains(t, stmt, "AND source = ?")
+	assert.Equal(t, []any{"subj", []string{"speed"}, "0xsource"}, args)
+}
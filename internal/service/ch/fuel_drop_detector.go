@@ -0,0 +1,187 @@
+package ch
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/ClickHouse/clickhouse-go/v2"
+	"github.com/DIMO-Network/model-garage/pkg/vss"
+	"github.com/DIMO-Network/telemetry-api/internal/graph/model"
+)
+
+const (
+	fuelDropWindowMinutes           = 5   // window length for fuel drop detection
+	fuelDropWindowMinPercent        = 5.0 // fuel must drop at least this much (absolute %) in the window, or minDrop if lower
+	fuelDropDefaultMinPercent       = 10  // peak-to-trough must drop at least this much in absolute % to be a real drop
+	fuelDropTroughSearchMaxMin      = 30  // max minutes to search forward from drop window for the trough
+	fuelDropTroughStabilizationRise = 1.0 // if fuel rises more than this from current sample to next, consider current sample the trough
+	// fuelDropStateLookback is how far back speed and ignition are fetched, so the state of a vehicle
+	// parked for hours before the drop is known.
+	fuelDropStateLookback = 24 * time.Hour
+)
+
+// FuelDropDetector detects sudden fuel level drops while the vehicle is parked (stationary or
+// ignition off), which indicate siphoning or a leak. It mirrors RefuelDetector: segments run
+// from the last high reading (peak) to the first stable low reading (trough).
+type FuelDropDetector struct {
+	conn clickhouse.Conn
+}
+
+// NewFuelDropDetector creates a new FuelDropDetector with the given connection.
+func NewFuelDropDetector(conn clickhouse.Conn) *FuelDropDetector {
+	return &FuelDropDetector{conn: conn}
+}
+
+// DetectSegments finds 5-min windows where fuel drops, then for each drop emits a segment from the
+// peak (last high sample before the drop) to the trough (first stable low after), if the vehicle
+// was parked throughout. 3 CH queries (fuel, speed, ignition).
+func (d *FuelDropDetector) DetectSegments(
+	ctx context.Context,
+	subject string,
+	from, to time.Time,
+	config *model.SegmentConfig,
+) ([]*model.Segment, error) {
+	rc := resolveBaseConfig(config)
+	minDuration := rc.minDuration
+	minDrop := float64(fuelDropDefaultMinPercent)
+	if config != nil && config.MinDropPercent != nil && *config.MinDropPercent > 0 {
+		minDrop = float64(*config.MinDropPercent)
+	}
+	windowMinDrop := min(fuelDropWindowMinPercent, minDrop)
+	windowDur := time.Duration(fuelDropWindowMinutes) * time.Minute
+	fuelFrom := from.Add(-windowDur)
+	fuelTo := to.Add(windowDur)
+
+	samples, err := getLevelSamples(ctx, d.conn, subject, vss.FieldPowertrainFuelSystemRelativeLevel, fuelFrom, fuelTo)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query fuel samples: %w", err)
+	}
+	if len(samples) < 2 {
+		return []*model.Segment{}, nil
+	}
+	stateFrom := from.Add(-fuelDropStateLookback)
+	speeds, err := getLevelSamples(ctx, d.conn, subject, vss.FieldSpeed, stateFrom, fuelTo)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query speed samples: %w", err)
+	}
+	ignition, err := getLevelSamples(ctx, d.conn, subject, vss.FieldIsIgnitionOn, stateFrom, fuelTo)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query ignition samples: %w", err)
+	}
+
+	// Scan 5-min windows for large drops; track sample index incrementally
+	var raw []timeRange
+	t := from.Truncate(time.Minute)
+	if t.Before(from) {
+		t = t.Add(time.Minute)
+	}
+	for !t.Add(windowDur).After(to) {
+		windowEnd := t.Add(windowDur)
+		if sampleAtOrBefore(samples, t)-sampleAtOrBefore(samples, windowEnd) >= windowMinDrop {
+			peakTime, troughTime, absDrop := findFuelDropPeakAndTrough(samples, t, windowEnd)
+			if !peakTime.IsZero() && !troughTime.IsZero() && troughTime.After(peakTime) && absDrop >= minDrop &&
+				parkedDuring(speeds, ignition, peakTime, troughTime) {
+				if peakTime.Before(from) {
+					peakTime = from
+				}
+				if troughTime.After(to) {
+					troughTime = to
+				}
+				if int(troughTime.Sub(peakTime).Seconds()) >= minDuration {
+					raw = append(raw, timeRange{start: peakTime, end: troughTime})
+				}
+			}
+		}
+		t = t.Add(time.Minute)
+	}
+
+	merged := mergeTimeRanges(raw, 0, minDuration, from, to, nil)
+	segments := timeRangesToSegments(merged, from)
+	for i, tr := range merged {
+		if peak, trough, ok := levelFirstLastInRange(samples, tr.start, tr.end); ok {
+			segments[i].FuelDrop = &model.FuelDropDetails{StartLevel: peak, EndLevel: trough}
+		}
+	}
+	return segments, nil
+}
+
+// GetMechanismName returns the name of this detection mechanism.
+func (d *FuelDropDetector) GetMechanismName() string {
+	return "fuelDrop"
+}
+
+// findFuelDropPeakAndTrough finds the peak (last high sample at or before dropStart) and
+// trough (first sample where fuel stabilizes low after dropEnd) around a detected fuel drop.
+// Mirrors findRefuelTroughAndPeak. samples must be sorted by ts.
+func findFuelDropPeakAndTrough(samples []levelSample, dropStart, dropEnd time.Time) (peak, trough time.Time, absDrop float64) {
+	troughDeadline := dropEnd.Add(time.Duration(fuelDropTroughSearchMaxMin) * time.Minute)
+
+	// Find peak: binary search to first index at or before dropStart, then walk backward for local max.
+	startIdx := sort.Search(len(samples), func(i int) bool { return samples[i].ts.After(dropStart) })
+	if startIdx > 0 {
+		startIdx--
+	}
+	peakIdx := -1
+	peakVal := 0.0
+	for i := startIdx; i >= 0; i-- {
+		if peakIdx == -1 || samples[i].value >= peakVal {
+			peakIdx = i
+			peakVal = samples[i].value
+		} else {
+			break
+		}
+	}
+
+	// Find trough: binary search to first index at or after dropEnd, then walk forward capped at deadline.
+	troughStart := sort.Search(len(samples), func(i int) bool { return !samples[i].ts.Before(dropEnd) })
+	troughIdx := -1
+	troughVal := 0.0
+	for i := troughStart; i < len(samples); i++ {
+		if samples[i].ts.After(troughDeadline) {
+			break
+		}
+		if troughIdx == -1 || samples[i].value <= troughVal {
+			troughIdx = i
+			troughVal = samples[i].value
+		}
+		if i+1 < len(samples) && !samples[i+1].ts.After(troughDeadline) && samples[i+1].value > samples[i].value+fuelDropTroughStabilizationRise {
+			troughIdx = i
+			break
+		}
+	}
+
+	if peakIdx < 0 || troughIdx < 0 {
+		return time.Time{}, time.Time{}, 0
+	}
+	drop := samples[peakIdx].value - samples[troughIdx].value
+	return samples[peakIdx].ts, samples[troughIdx].ts, drop
+}
+
+// parkedDuring reports whether the vehicle was parked throughout [start, end]: its speed stayed at most
+// stopMaxSpeedKph, or its ignition stayed off. Without speed or ignition data it is not considered
+// parked, so sensor noise while driving isn't reported as theft.
+func parkedDuring(speeds, ignition []levelSample, start, end time.Time) bool {
+	if maxSpeed, ok := maxLevelDuring(speeds, start, end); ok && maxSpeed <= stopMaxSpeedKph {
+		return true
+	}
+	maxIgnition, ok := maxLevelDuring(ignition, start, end)
+	return ok && maxIgnition == 0
+}
+
+// maxLevelDuring returns the highest level in effect during [start, end]: the latest sample at or
+// before start and the samples after it up to end. ok is false if there is no sample at or before end.
+// samples must be sorted by ts.
+func maxLevelDuring(samples []levelSample, start, end time.Time) (maxLevel float64, ok bool) {
+	idx := sort.Search(len(samples), func(i int) bool { return samples[i].ts.After(start) })
+	if idx > 0 {
+		maxLevel, ok = samples[idx-1].value, true
+	}
+	for i := idx; i < len(samples) && !samples[i].ts.After(end); i++ {
+		if !ok || samples[i].value > maxLevel {
+			maxLevel, ok = samples[i].value, true
+		}
+	}
+	return maxLevel, ok
+}
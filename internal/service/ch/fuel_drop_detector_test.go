@@ -0,0 +1,95 @@
+package ch
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindFuelDropPeakAndTrough(t *testing.T) {
+	base := time.Date(2025, 1, 1, 2, 0, 0, 0, time.UTC)
+	min := func(m int) time.Time { return base.Add(time.Duration(m) * time.Minute) }
+
+	t.Run("basic drop", func(t *testing.T) {
+		samples := []levelSample{
+			{ts: min(0), value: 70},
+			{ts: min(1), value: 80}, // peak
+			{ts: min(2), value: 60},
+			{ts: min(3), value: 45},
+			{ts: min(4), value: 40},
+			{ts: min(5), value: 40},
+		}
+		peak, trough, absDrop := findFuelDropPeakAndTrough(samples, min(2), min(4))
+		require.Equal(t, min(1), peak) // walks back while the level is not lower
+		require.Equal(t, min(5), trough)
+		require.InDelta(t, 40.0, absDrop, 0.01)
+	})
+
+	t.Run("peak walk-back finds local maximum", func(t *testing.T) {
+		samples := []levelSample{
+			{ts: min(0), value: 50},
+			{ts: min(1), value: 82}, // local max
+			{ts: min(2), value: 80}, // dropStart
+			{ts: min(3), value: 40},
+		}
+		peak, _, _ := findFuelDropPeakAndTrough(samples, min(2), min(3))
+		require.Equal(t, min(1), peak)
+	})
+
+	t.Run("trough stabilization detection", func(t *testing.T) {
+		samples := []levelSample{
+			{ts: min(0), value: 80},
+			{ts: min(5), value: 50},
+			{ts: min(6), value: 45},
+			{ts: min(7), value: 47}, // rise of 2.0 from 45 → trough at 45
+			{ts: min(8), value: 30},
+		}
+		_, trough, absDrop := findFuelDropPeakAndTrough(samples, min(0), min(5))
+		require.Equal(t, min(6), trough)
+		require.InDelta(t, 35.0, absDrop, 0.01)
+	})
+
+	t.Run("trough search capped by deadline", func(t *testing.T) {
+		samples := []levelSample{
+			{ts: min(0), value: 80},
+			{ts: min(5), value: 50},
+			{ts: min(60), value: 10}, // beyond deadline
+		}
+		_, trough, _ := findFuelDropPeakAndTrough(samples, min(0), min(5))
+		require.Equal(t, min(5), trough)
+	})
+}
+
+func TestParkedDuring(t *testing.T) {
+	base := time.Date(2025, 1, 1, 2, 0, 0, 0, time.UTC)
+	start, end := base.Add(time.Hour), base.Add(time.Hour+10*time.Minute)
+	parkedSpeed := []levelSample{{ts: base, value: 0}}
+	drivingSpeed := []levelSample{{ts: base, value: 0}, {ts: start.Add(time.Minute), value: 40}}
+	ignitionOff := []levelSample{{ts: base, value: 0}}
+	ignitionOn := []levelSample{{ts: base, value: 1}}
+
+	require.True(t, parkedDuring(parkedSpeed, nil, start, end))
+	require.True(t, parkedDuring(nil, ignitionOff, start, end))
+	require.True(t, parkedDuring(drivingSpeed, ignitionOff, start, end))
+	require.False(t, parkedDuring(drivingSpeed, ignitionOn, start, end))
+	require.False(t, parkedDuring(nil, ignitionOn, start, end))
+	require.False(t, parkedDuring(nil, nil, start, end))
+}
+
+func TestMaxLevelDuring(t *testing.T) {
+	base := time.Date(2025, 1, 1, 2, 0, 0, 0, time.UTC)
+	samples := []levelSample{
+		{ts: base, value: 5},
+		{ts: base.Add(10 * time.Minute), value: 20},
+		{ts: base.Add(30 * time.Minute), value: 50},
+	}
+	_, ok := maxLevelDuring(samples, base.Add(-2*time.Minute), base.Add(-time.Minute))
+	require.False(t, ok)
+	maxLevel, ok := maxLevelDuring(samples, base.Add(5*time.Minute), base.Add(20*time.Minute))
+	require.True(t, ok)
+	require.Equal(t, 20.0, maxLevel)
+	maxLevel, ok = maxLevelDuring(samples, base.Add(-time.Minute), base.Add(5*time.Minute))
+	require.True(t, ok)
+	require.Equal(t, 5.0, maxLevel)
+}
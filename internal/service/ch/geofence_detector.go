@@ -0,0 +1,159 @@
+package ch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ClickHouse/clickhouse-go/v2"
+	"github.com/DIMO-Network/model-garage/pkg/vss"
+	"github.com/DIMO-Network/telemetry-api/internal/graph/model"
+)
+
+// GeofenceDetector detects visits to a polygon or circle: periods where the
+// vehicle's location samples fall inside the geofence.
+// A visit runs from the first inside sample to the last inside sample before
+// outside samples that go on for more than maxGapSeconds. Shorter runs of
+// outside samples are GPS jitter at the fence edge and don't split a visit,
+// and neither does a gap in reporting.
+type GeofenceDetector struct {
+	conn clickhouse.Conn
+}
+
+// NewGeofenceDetector creates a new GeofenceDetector with the given connection.
+func NewGeofenceDetector(conn clickhouse.Conn) *GeofenceDetector {
+	return &GeofenceDetector{conn: conn}
+}
+
+// DetectSegments fetches the location samples with whether they are inside config.Geofence (1 CH query)
+// and groups consecutive inside samples into visits in-memory.
+func (d *GeofenceDetector) DetectSegments(
+	ctx context.Context,
+	subject string,
+	from, to time.Time,
+	config *model.SegmentConfig,
+) ([]*model.Segment, error) {
+	if config == nil || config.Geofence == nil {
+		return nil, errors.New("geofence detection requires geofence")
+	}
+	rc := resolveBaseConfig(config)
+
+	lookbackFrom := from.Add(-time.Duration(rc.maxGapSeconds) * time.Second)
+	samples, err := d.getGeofenceSamples(ctx, subject, lookbackFrom, to, config.Geofence)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query geofence location samples: %w", err)
+	}
+	return findGeofenceVisits(samples, from, to, rc.maxGapSeconds, rc.minDuration), nil
+}
+
+// GetMechanismName returns the name of this detection mechanism.
+func (d *GeofenceDetector) GetMechanismName() string {
+	return "geofence"
+}
+
+// geofenceSample is a location sample and whether it is inside the geofence.
+type geofenceSample struct {
+	ts     time.Time
+	inside bool
+}
+
+// findGeofenceVisits groups runs of inside samples into visits clipped to [from, to]. Runs of outside
+// samples are merged into the visit around them when the next inside sample comes at most maxGap
+// seconds after the first of them. The last visit is ongoing if it has not been outside for more than
+// maxGap seconds by to, and to is within maxGap seconds of now.
+func findGeofenceVisits(samples []geofenceSample, from, to time.Time, maxGap, minDuration int) []*model.Segment {
+	gap := time.Duration(maxGap) * time.Second
+	out := []*model.Segment{}
+	for i := 0; i < len(samples); i++ {
+		if !samples[i].inside {
+			continue
+		}
+		visit := timeRange{start: samples[i].ts, end: samples[i].ts}
+		// exitedAt is the first outside sample of the run that ends the visit, zero if none follows.
+		var exitedAt time.Time
+		last := true
+		for i+1 < len(samples) {
+			if samples[i+1].inside {
+				i++
+				visit.end = samples[i].ts
+				continue
+			}
+			next := i + 1
+			for next < len(samples) && !samples[next].inside {
+				next++
+			}
+			if next < len(samples) && samples[next].ts.Sub(samples[i+1].ts) <= gap {
+				i = next
+				visit.end = samples[i].ts
+				continue
+			}
+			exitedAt = samples[i+1].ts
+			last = next == len(samples)
+			break
+		}
+		startedBefore := !visit.start.After(from)
+		ongoing := last && (exitedAt.IsZero() || to.Sub(exitedAt) <= gap) && timeNow().Sub(to) <= gap
+		if ongoing {
+			start := visit.start
+			if start.Before(from) {
+				start = from
+			}
+			if durSec := int32(to.Sub(start).Seconds()); int(durSec) >= minDuration {
+				out = append(out, newSegment(start, nil, durSec, true, startedBefore))
+			}
+			continue
+		}
+		if tr, ok := clipTimeRange(visit, from, to, minDuration); ok {
+			end := tr.end
+			out = append(out, newSegment(tr.start, &end, int32(end.Sub(tr.start).Seconds()), false, startedBefore))
+		}
+	}
+	return out
+}
+
+// getGeofenceSamples returns the non-zero location samples in [from, to) in timestamp order, with
+// whether they fall inside the geofence.
+func (d *GeofenceDetector) getGeofenceSamples(ctx context.Context, subject string, from, to time.Time, geofence *model.SignalLocationFilter) (_ []geofenceSample, retErr error) {
+	stmt, args := getGeofenceSamplesQuery(subject, from, to, geofence)
+	rows, err := d.conn.Query(ctx, stmt, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { retErr = errors.Join(retErr, rows.Close()) }()
+	var out []geofenceSample
+	for rows.Next() {
+		var s geofenceSample
+		if err := rows.Scan(&s.ts, &s.inside); err != nil {
+			return nil, err
+		}
+		out = append(out, s)
+	}
+	return out, rows.Err()
+}
+
+// getGeofenceSamplesQuery returns a query for the timestamps of the non-zero
+// currentLocationCoordinates samples in [from, to), in order, with whether they
+// are inside the geofence.
+func getGeofenceSamplesQuery(subject string, from, to time.Time, geofence *model.SignalLocationFilter) (string, []any) {
+	inside := "1"
+	var args []any
+	if conds := buildLocationConditions(geofence, vss.ValueLocationCol); len(conds) > 0 {
+		clauses := make([]string, len(conds))
+		for i, cond := range conds {
+			clauses[i] = cond.clause
+			args = append(args, cond.args...)
+		}
+		inside = strings.Join(clauses, " AND ")
+	}
+	stmt := "SELECT " + vss.TimestampCol + ", toBool(" + inside + ") AS inside" +
+		" FROM " + vss.TableName + " FINAL" +
+		" PREWHERE " + subjectWhere +
+		" WHERE " + vss.NameCol + " = ?" +
+		" AND " + vss.TimestampCol + " >= " + dateTime64Micro(from) +
+		" AND " + vss.TimestampCol + " < " + dateTime64Micro(to) +
+		" AND (" + vss.ValueLocationCol + ".latitude != 0 OR " + vss.ValueLocationCol + ".longitude != 0)" +
+		" ORDER BY " + vss.TimestampCol
+	return stmt, append(args, subject, vss.FieldCurrentLocationCoordinates)
+}
@@ -0,0 +1,95 @@
+package ch
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DIMO-Network/model-garage/pkg/vss"
+	"github.com/DIMO-Network/telemetry-api/internal/graph/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetGeofenceSamplesQuery(t *testing.T) {
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(24 * time.Hour)
+	geofence := &model.SignalLocationFilter{
+		InPolygon: []*model.FilterLocation{
+			{Latitude: 1, Longitude: 2},
+			{Latitude: 3, Longitude: 4},
+			{Latitude: 5, Longitude: 6},
+		},
+	}
+
+	stmt, args := getGeofenceSamplesQuery("subj", from, to, geofence)
+	require.Contains(t, stmt, "SELECT timestamp, toBool(pointInPolygon((value_location.longitude, value_location.latitude), [(?, ?), (?, ?), (?, ?)])) AS inside")
+	require.Contains(t, stmt, "FROM signal FINAL PREWHERE subject = ?")
+	require.Contains(t, stmt, "(value_location.latitude != 0 OR value_location.longitude != 0)")
+	require.Contains(t, stmt, "ORDER BY timestamp")
+	require.Equal(t, []any{2.0, 1.0, 4.0, 3.0, 6.0, 5.0, "subj", vss.FieldCurrentLocationCoordinates}, args)
+}
+
+func TestFindGeofenceVisits(t *testing.T) {
+	base := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	min := func(m int) time.Time { return base.Add(time.Duration(m) * time.Minute) }
+	samples := func(inside map[int]bool) []geofenceSample {
+		var out []geofenceSample
+		for m := 0; m <= 120; m++ {
+			if in, ok := inside[m]; ok {
+				out = append(out, geofenceSample{ts: min(m), inside: in})
+			}
+		}
+		return out
+	}
+
+	t.Run("reporting gap does not split a visit", func(t *testing.T) {
+		result := findGeofenceVisits(samples(map[int]bool{0: true, 5: true, 60: true, 65: true, 70: false}), base, min(120), 300, 60)
+		require.Len(t, result, 1)
+		require.Equal(t, min(0), result[0].Start.Timestamp)
+		require.Equal(t, min(65), result[0].End.Timestamp)
+	})
+
+	t.Run("one outside sample between inside samples does not split a visit", func(t *testing.T) {
+		result := findGeofenceVisits(samples(map[int]bool{0: true, 10: true, 11: false, 12: true, 20: true, 30: false}), base, min(120), 300, 60)
+		require.Len(t, result, 1)
+		require.Equal(t, min(0), result[0].Start.Timestamp)
+		require.Equal(t, min(20), result[0].End.Timestamp)
+	})
+
+	t.Run("leaving for longer than maxGap makes two visits", func(t *testing.T) {
+		result := findGeofenceVisits(samples(map[int]bool{0: true, 10: true, 11: false, 15: false, 17: true, 20: true, 21: false}), base, min(120), 300, 60)
+		require.Len(t, result, 2)
+		require.Equal(t, min(10), result[0].End.Timestamp)
+		require.Equal(t, min(17), result[1].Start.Timestamp)
+		require.Equal(t, min(20), result[1].End.Timestamp)
+	})
+
+	t.Run("visit clipped to the range", func(t *testing.T) {
+		result := findGeofenceVisits(samples(map[int]bool{0: true, 30: true, 31: false}), min(10), min(120), 300, 60)
+		require.Len(t, result, 1)
+		require.True(t, result[0].StartedBeforeRange)
+		require.Equal(t, min(10), result[0].Start.Timestamp)
+		require.Equal(t, 20*60, result[0].Duration)
+	})
+
+	t.Run("last visit without an outside sample is ongoing near now", func(t *testing.T) {
+		now := time.Now().Truncate(time.Second)
+		result := findGeofenceVisits([]geofenceSample{
+			{ts: now.Add(-time.Hour), inside: true},
+			{ts: now.Add(-30 * time.Minute), inside: true},
+		}, now.Add(-2*time.Hour), now, 300, 60)
+		require.Len(t, result, 1)
+		require.True(t, result[0].IsOngoing)
+		require.Nil(t, result[0].End)
+		require.Equal(t, 3600, result[0].Duration)
+	})
+
+	t.Run("last visit is ongoing until it has been outside for maxGap", func(t *testing.T) {
+		now := time.Now().Truncate(time.Second)
+		result := findGeofenceVisits([]geofenceSample{
+			{ts: now.Add(-time.Hour), inside: true},
+			{ts: now.Add(-time.Minute), inside: false},
+		}, now.Add(-2*time.Hour), now, 300, 60)
+		require.Len(t, result, 1)
+		require.True(t, result[0].IsOngoing)
+	})
+}
@@ -65,41 +65,8 @@ func (d *IdlingDetector) GetMechanismName() string {
 // A gap between consecutive idle samples larger than maxGap seconds ends the current run.
 // Only runs with duration >= minDuration are emitted. Ranges are clipped to [from, to].
 func findIdleRpmRanges(samples []levelSample, maxIdleRpm, maxGap, minDuration int, from, to time.Time) []timeRange {
-	maxGapDur := time.Duration(maxGap) * time.Second
-	var ranges []timeRange
-	var runStart, runEnd time.Time
-	inRun := false
-
-	for _, s := range samples {
-		isIdle := s.value > minRunningRpm && s.value <= float64(maxIdleRpm)
-		if isIdle {
-			if !inRun {
-				runStart = s.ts
-				runEnd = s.ts
-				inRun = true
-			} else if s.ts.Sub(runEnd) > maxGapDur {
-				appendIdleRange(runStart, runEnd, minDuration, from, to, &ranges)
-				runStart = s.ts
-				runEnd = s.ts
-			} else {
-				runEnd = s.ts
-			}
-		} else {
-			if inRun {
-				appendIdleRange(runStart, runEnd, minDuration, from, to, &ranges)
-				inRun = false
-			}
-		}
-	}
-	if inRun {
-		appendIdleRange(runStart, runEnd, minDuration, from, to, &ranges)
-	}
-	return ranges
-}
-
-// appendIdleRange appends a timeRange if the run meets duration and time-range criteria.
-func appendIdleRange(runStart, runEnd time.Time, minDuration int, from, to time.Time, out *[]timeRange) {
-	if tr, ok := clipTimeRange(timeRange{start: runStart, end: runEnd}, from, to, minDuration); ok {
-		*out = append(*out, tr)
+	isIdle := func(rpm float64) bool {
+		return rpm > minRunningRpm && rpm <= float64(maxIdleRpm)
 	}
+	return findSampleRuns(samples, isIdle, maxGap, minDuration, from, to)
 }
@@ -25,6 +25,24 @@ var (
 		},
 	)
 
+	// GetLocationsForRangesLatency measures latency of batch location samples for segment routes
+	GetLocationsForRangesLatency = promauto.NewHistogram(
+		prometheus.HistogramOpts{
+			Name:    "telemetry_ch_get_locations_for_ranges_latency_seconds",
+			Help:    "Latency of GetLocationsForRanges in seconds",
+			Buckets: prometheus.DefBuckets,
+		},
+	)
+
+	// GetFloatSamplesLatency measures latency of raw float samples for derived events
+	GetFloatSamplesLatency = promauto.NewHistogram(
+		prometheus.HistogramOpts{
+			Name:    "telemetry_ch_get_float_samples_latency_seconds",
+			Help:    "Latency of GetFloatSamples in seconds",
+			Buckets: prometheus.DefBuckets,
+		},
+	)
+
 	// GetEventCountsForRangesLatency measures latency of batch event counts for segment summaries
 	GetEventCountsForRangesLatency = promauto.NewHistogram(
 		prometheus.HistogramOpts{
@@ -0,0 +1,29 @@
+-- +goose Up
+-- Rows are versioned by generation: a store whose coverage fell out of the retention window is
+-- replaced by a new generation, and only the generation in the watermark is read.
+CREATE TABLE IF NOT EXISTS segment_store (
+	subject String,
+	mechanism LowCardinality(String),
+	config_hash String,
+	generation UInt64,
+	start_time DateTime64(6, 'UTC'),
+	end_time DateTime64(6, 'UTC'),
+	details String
+) ENGINE = ReplacingMergeTree
+ORDER BY (subject, mechanism, config_hash, generation, start_time);
+
+CREATE TABLE IF NOT EXISTS segment_store_watermark (
+	subject String,
+	mechanism LowCardinality(String),
+	config_hash String,
+	generation UInt64,
+	covered_from DateTime64(6, 'UTC'),
+	covered_to DateTime64(6, 'UTC'),
+	updated_at DateTime64(6, 'UTC'),
+	reprocessed_at DateTime64(6, 'UTC')
+) ENGINE = ReplacingMergeTree(updated_at)
+ORDER BY (subject, mechanism, config_hash);
+
+-- +goose Down
+DROP TABLE IF EXISTS segment_store_watermark;
+DROP TABLE IF EXISTS segment_store;
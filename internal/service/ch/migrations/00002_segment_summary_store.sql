@@ -0,0 +1,16 @@
+-- +goose Up
+-- Summaries of closed segments and days, keyed by the aggregations they were computed with.
+-- Summaries are stored for ranges within the segment store retention and expire after it.
+CREATE TABLE IF NOT EXISTS segment_summary_store (
+	subject String,
+	args_hash String,
+	from_time DateTime64(6, 'UTC'),
+	to_time DateTime64(6, 'UTC'),
+	signals_to DateTime64(6, 'UTC'),
+	summary String
+) ENGINE = ReplacingMergeTree
+ORDER BY (subject, args_hash, from_time, to_time, signals_to)
+TTL toDateTime(to_time) + INTERVAL 367 DAY;
+
+-- +goose Down
+DROP TABLE IF EXISTS segment_summary_store;
@@ -0,0 +1,34 @@
+// Package migrations holds the ClickHouse migrations for the tables telemetry-api writes to.
+// The signal and event tables are owned by model-garage; these migrations are versioned in
+// their own table so the two histories don't mix.
+package migrations
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"fmt"
+
+	"github.com/pressly/goose/v3"
+)
+
+// versionTable records the applied telemetry-api migrations.
+const versionTable = "telemetry_api_goose_db_version"
+
+//go:embed *.sql
+var migrationFS embed.FS
+
+// Up applies all pending migrations.
+func Up(ctx context.Context, db *sql.DB) error {
+	provider, err := goose.NewProvider(goose.DialectClickHouse, db, migrationFS,
+		goose.WithTableName(versionTable),
+		goose.WithDisableGlobalRegistry(true),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to create migration provider: %w", err)
+	}
+	if _, err := provider.Up(ctx); err != nil {
+		return fmt.Errorf("failed to apply migrations: %w", err)
+	}
+	return nil
+}
@@ -3,6 +3,8 @@ package ch
 import (
 	"errors"
 	"fmt"
+	"slices"
+	"strconv"
 	"strings"
 	"time"
 
@@ -155,11 +157,17 @@ func whereTimestampTo(t time.Time) qm.QueryMod {
 }
 
 func withSource(source string) qm.QueryMod {
+	return qm.Where(sourceWhere, sourceAddress(source))
+}
+
+// sourceAddress returns the value stored in the source column: the address of
+// an ethr DID, or the input unchanged.
+func sourceAddress(source string) string {
 	did, err := cloudevent.DecodeEthrDID(source)
 	if err == nil {
-		source = did.ContractAddress.Hex()
+		return did.ContractAddress.Hex()
 	}
-	return qm.Where(sourceWhere, source)
+	return source
 }
 
 // selectInterval adds a SELECT clause to the query to select the interval group based on the given milliSeconds.
@@ -294,6 +302,9 @@ func batchFloatCaseExprWithAlias(alias string, floatArgs []model.FloatSignalArgs
 	parts := make([]string, 0, len(floatArgs))
 	for i, agg := range floatArgs {
 		expr := floatAggExpr(vNum, ts, agg.Agg)
+		if agg.Agg == model.FloatAggregationShareOver && agg.Filter != nil && agg.Filter.Gt != nil {
+			expr = "avg(" + vNum + " > " + strconv.FormatFloat(*agg.Filter.Gt, 'f', -1, 64) + ")"
+		}
 		parts = append(parts, fmt.Sprintf("WHEN %s = %d AND %s = %d THEN %s", typeCol, FloatType, indexCol, i, expr))
 	}
 	return fmt.Sprintf("CASE %s ELSE NULL END AS %s", strings.Join(parts, " "), AggNumberCol)
@@ -559,7 +570,7 @@ func getAggQuery(subject string, aggArgs *model.AggregatedSignalArgs) (string, [
 			fieldFilters := []qm.QueryMod{
 				qmhelper.Where(signalIndexCol, qmhelper.EQ, i),
 			}
-			fieldFilters = append(fieldFilters, buildLocationConditionList(agg.Filter)...)
+			fieldFilters = append(fieldFilters, buildLocationConditionList(agg.Filter, vss.ValueLocationCol)...)
 
 			innerLocationFilters = append(innerLocationFilters, qm.Or2(qm.Expr(fieldFilters...)))
 		}
@@ -694,12 +705,30 @@ func buildFloatConditionList(fil *model.SignalFloatFilter) []qm.QueryMod {
 	return mods
 }
 
-func buildLocationConditionList(fil *model.SignalLocationFilter) []qm.QueryMod {
+// buildLocationConditionList returns the polygon and circle conditions of the
+// filter on the location column col.
+func buildLocationConditionList(fil *model.SignalLocationFilter, col string) []qm.QueryMod {
+	var mods []qm.QueryMod
+	for _, cond := range buildLocationConditions(fil, col) {
+		mods = append(mods, qm.Where(cond.clause, cond.args...))
+	}
+	return mods
+}
+
+// locationCondition is a SQL condition on a location column with its arguments.
+type locationCondition struct {
+	clause string
+	args   []any
+}
+
+// buildLocationConditions returns the conditions of the location filter on col,
+// all of which must hold.
+func buildLocationConditions(fil *model.SignalLocationFilter, col string) []locationCondition {
 	if fil == nil {
 		return nil
 	}
 
-	var mods []qm.QueryMod
+	var conds []locationCondition
 
 	// This will not work well if points at at the edges of the coordinate system:
 	// for example, around the antimeridian.
@@ -713,22 +742,22 @@ func buildLocationConditionList(fil *model.SignalLocationFilter) []qm.QueryMod {
 
 		// ClickHouse function:
 		// https://clickhouse.com/docs/sql-reference/functions/geo/coordinates#pointinpolygon
-		mods = append(mods, qm.Where(
-			"pointInPolygon(("+vss.ValueLocationCol+".longitude, "+vss.ValueLocationCol+".latitude), ["+repeatWithSep("(?, ?)", len(fil.InPolygon), ", ")+"])",
-			interp...,
-		))
+		conds = append(conds, locationCondition{
+			"pointInPolygon((" + col + ".longitude, " + col + ".latitude), [" + repeatWithSep("(?, ?)", len(fil.InPolygon), ", ") + "])",
+			interp,
+		})
 	}
 
 	// ClickHouse function, which returns meters:
 	// https://clickhouse.com/docs/sql-reference/functions/geo/coordinates#geodistance
 	if fil.InCircle != nil {
-		mods = append(mods, qm.Where(
-			"geoDistance(?, ?, "+vss.ValueLocationCol+".longitude, "+vss.ValueLocationCol+".latitude) <= ?",
-			fil.InCircle.Center.Longitude, fil.InCircle.Center.Latitude, kilometersToMeters(fil.InCircle.Radius),
-		))
+		conds = append(conds, locationCondition{
+			"geoDistance(?, ?, " + col + ".longitude, " + col + ".latitude) <= ?",
+			[]any{fil.InCircle.Center.Longitude, fil.InCircle.Center.Latitude, kilometersToMeters(fil.InCircle.Radius)},
+		})
 	}
 
-	return mods
+	return conds
 }
 
 func kilometersToMeters(d float64) float64 {
@@ -800,6 +829,52 @@ func getSignalSummariesQuery(subject string, filter *model.SignalFilter) (string
 	return newQuery(mods...)
 }
 
+// getRangeDistinctQuery returns the distinct signal names stored in [from, to).
+// A nil bound leaves that side of the range open.
+func getRangeDistinctQuery(subject string, from, to *time.Time, filter *model.SignalFilter) (string, []any) {
+	mods := []qm.QueryMod{
+		qm.Distinct(vss.NameCol),
+		qm.From(vss.TableName),
+		qm.Where(subjectWhere, subject),
+		qm.OrderBy(vss.NameCol),
+	}
+	mods = append(mods, timeRangeMods(vss.TimestampCol, from, to)...)
+	mods = append(mods, getFilterMods(filter)...)
+	return newQuery(mods...)
+}
+
+// getRangeSignalSummariesQuery returns per-name count and first/last seen in
+// [from, to), grouped from the signal table. The columns match
+// getSignalSummariesQuery.
+func getRangeSignalSummariesQuery(subject string, from, to *time.Time, filter *model.SignalFilter) (string, []any) {
+	mods := []qm.QueryMod{
+		qm.Select(vss.NameCol),
+		qm.Select("count(*)"),
+		qm.Select("min(" + vss.TimestampCol + ")"),
+		qm.Select("max(" + vss.TimestampCol + ")"),
+		qm.From(vss.TableName),
+		qm.Where(subjectWhere, subject),
+		qm.GroupBy(vss.NameCol),
+		qm.OrderBy(vss.NameCol),
+	}
+	mods = append(mods, timeRangeMods(vss.TimestampCol, from, to)...)
+	mods = append(mods, getFilterMods(filter)...)
+	return newQuery(mods...)
+}
+
+// timeRangeMods bounds the timestamp column col of the signal or event table
+// to [from, to). A nil bound adds no condition.
+func timeRangeMods(col string, from, to *time.Time) []qm.QueryMod {
+	var mods []qm.QueryMod
+	if from != nil {
+		mods = append(mods, qm.Where(col+" >= "+dateTime64Micro(*from)))
+	}
+	if to != nil {
+		mods = append(mods, qm.Where(col+" < "+dateTime64Micro(*to)))
+	}
+	return mods
+}
+
 // getFilterMods returns the query mods for the filter.
 func getFilterMods(filter *model.SignalFilter) []qm.QueryMod {
 	if filter == nil {
@@ -812,6 +887,84 @@ func getFilterMods(filter *model.SignalFilter) []qm.QueryMod {
 	return mods
 }
 
+// eventLocationLookback is how old a location sample may be to be attached to
+// an event.
+const eventLocationLookback = time.Hour
+
+const (
+	eventLocationTable        = "event_location"
+	eventLocationSubjectCol   = "loc_subject"
+	eventLocationTimestampCol = "loc_timestamp"
+	eventLocationValueCol     = "loc_value"
+)
+
+// getEventsQuery returns a query for the events in [from, to). If
+// opts.WithLocation is set, each event is ASOF joined with the latest non-zero
+// location sample at or before it, adding the columns loc_timestamp and
+// loc_value. The join is also made, without the columns, for a location filter.
+func getEventsQuery(subject string, from, to time.Time, filter *model.EventFilter, opts EventQueryOptions) (string, []any) {
+	order := vss.EventTimestampCol + " DESC"
+	if opts.Ascending {
+		order = vss.EventTimestampCol + " ASC"
+	}
+	mods := []qm.QueryMod{
+		qm.Select(vss.EventNameCol, vss.EventSourceCol, vss.EventTimestampCol, vss.EventDurationNsCol, vss.EventMetadataCol, vss.EventTagsCol),
+		qm.Where(eventSubjectWhere, subject),
+		qm.Where(vss.EventTimestampCol + " >= " + dateTime64Micro(from)),
+		qm.Where(vss.EventTimestampCol + " < " + dateTime64Micro(to)),
+		qm.OrderBy(order),
+	}
+	if opts.After != nil {
+		mods = append(mods, qm.Where(vss.EventTimestampCol+" > "+dateTime64Micro(*opts.After)))
+	}
+	if opts.WithLocation {
+		mods = append(mods, qm.Select(eventLocationTimestampCol, eventLocationValueCol))
+	}
+	mods = append(mods, eventFromMods(subject, from, to, filter, opts.WithLocation)...)
+	mods = appendEventFilterMods(mods, filter)
+	stmt, args := newQuery(mods...)
+	if opts.Limit > 0 {
+		// The query builder has no WITH TIES, which keeps the events sharing the last timestamp.
+		stmt = strings.TrimSuffix(stmt, ";") + " LIMIT " + fmt.Sprint(opts.Limit) + " WITH TIES;"
+	}
+	return stmt, args
+}
+
+// eventFromMods selects from the event table, ASOF joined with the location
+// samples if withLocation is set or the filter has a location condition.
+func eventFromMods(subject string, from, to time.Time, filter *model.EventFilter, withLocation bool) []qm.QueryMod {
+	if withLocation || (filter != nil && filter.Location != nil) {
+		return eventLocationJoinMods(subject, from, to)
+	}
+	return []qm.QueryMod{qm.From(vss.EventTableName)}
+}
+
+// eventLocationJoinMods ASOF joins the event table with the non-zero location
+// samples of the subject in [from - eventLocationLookback, to). The sample
+// columns are renamed so that unqualified event columns stay unambiguous.
+func eventLocationJoinMods(subject string, from, to time.Time) []qm.QueryMod {
+	cte := fmt.Sprintf(`%s AS (
+    SELECT %s AS %s, %s AS %s, %s AS %s
+    FROM %s
+    WHERE %s = ? AND %s = ?
+      AND %s >= %s AND %s < %s
+      AND (%s.latitude != 0 OR %s.longitude != 0)
+)`,
+		eventLocationTable,
+		vss.SubjectCol, eventLocationSubjectCol, vss.TimestampCol, eventLocationTimestampCol, vss.ValueLocationCol, eventLocationValueCol,
+		vss.TableName,
+		vss.SubjectCol, vss.NameCol,
+		vss.TimestampCol, dateTime64Micro(from.Add(-eventLocationLookback)), vss.TimestampCol, dateTime64Micro(to),
+		vss.ValueLocationCol, vss.ValueLocationCol)
+	join := fmt.Sprintf("%s ASOF LEFT JOIN %s ON %s = %s AND %s >= %s",
+		vss.EventTableName, eventLocationTable,
+		vss.EventSubjectCol, eventLocationSubjectCol, vss.EventTimestampCol, eventLocationTimestampCol)
+	return []qm.QueryMod{
+		qm.With(cte, subject, vss.FieldCurrentLocationCoordinates),
+		qm.From(join),
+	}
+}
+
 func appendEventFilterMods(mods []qm.QueryMod, filter *model.EventFilter) []qm.QueryMod {
 	if filter == nil {
 		return mods
@@ -825,6 +978,48 @@ func appendEventFilterMods(mods []qm.QueryMod, filter *model.EventFilter) []qm.Q
 	if filter.Tags != nil {
 		mods = append(mods, stringArrayFilterMod(filter.Tags, vss.EventTagsCol)...)
 	}
+	for _, metaFilter := range filter.Metadata {
+		mods = append(mods, eventMetadataFilterMods(metaFilter)...)
+	}
+	if filter.Location != nil {
+		// Events without a recent enough sample have no location and never match.
+		mods = append(mods, qm.Where(fmt.Sprintf("%s >= %s - toIntervalSecond(%d)",
+			eventLocationTimestampCol, vss.EventTimestampCol, int(eventLocationLookback.Seconds()))))
+		mods = append(mods, buildLocationConditionList(filter.Location, eventLocationValueCol)...)
+	}
+	return mods
+}
+
+// eventMetadataFilterMods translates a metadata condition into JSONExtract
+// conditions on the metadata column. The path keys are passed as arguments.
+func eventMetadataFilterMods(filter *model.EventMetadataFilter) []qm.QueryMod {
+	keys := strings.Split(filter.Path, ".")
+	keyArgs := make([]any, len(keys))
+	for i, key := range keys {
+		keyArgs[i] = key
+	}
+	placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(keys)), ", ")
+	// JSONExtractString returns "" for a missing field, so string conditions
+	// also require the field to exist.
+	has := "JSONHas(" + vss.EventMetadataCol + ", " + placeholders + ")"
+	extractString := "JSONExtractString(" + vss.EventMetadataCol + ", " + placeholders + ")"
+	extractFloat := "JSONExtract(" + vss.EventMetadataCol + ", " + placeholders + ", 'Nullable(Float64)')"
+
+	var mods []qm.QueryMod
+	if filter.Eq != nil {
+		args := append(append(slices.Clone(keyArgs), keyArgs...), *filter.Eq)
+		mods = append(mods, qm.Where(has+" AND "+extractString+" = ?", args...))
+	}
+	if filter.In != nil {
+		args := append(append(slices.Clone(keyArgs), filter.In), keyArgs...)
+		mods = append(mods, qm.Where(has+" AND has(?, "+extractString+")", args...))
+	}
+	if filter.Gt != nil {
+		mods = append(mods, qm.Where(extractFloat+" > ?", append(keyArgs, *filter.Gt)...))
+	}
+	if filter.Lt != nil {
+		mods = append(mods, qm.Where(extractFloat+" < ?", append(keyArgs, *filter.Lt)...))
+	}
 	return mods
 }
 
@@ -844,6 +1039,24 @@ func getEventSummariesQuery(subject string) (string, []any) {
 	return newQuery(mods...)
 }
 
+// getRangeEventSummariesQuery returns per-name event count and first/last seen
+// in [from, to), grouped from the event table. The columns match
+// getEventSummariesQuery.
+func getRangeEventSummariesQuery(subject string, from, to *time.Time) (string, []any) {
+	mods := []qm.QueryMod{
+		qm.Select(vss.EventNameCol + " AS name"),
+		qm.Select("count(*) AS count"),
+		qm.Select("min(" + vss.EventTimestampCol + ") AS first_seen"),
+		qm.Select("max(" + vss.EventTimestampCol + ") AS last_seen"),
+		qm.From(vss.EventTableName),
+		qm.Where(eventSubjectWhere, subject),
+		qm.GroupBy(vss.EventNameCol),
+		qm.OrderBy(vss.EventNameCol),
+	}
+	mods = append(mods, timeRangeMods(vss.EventTimestampCol, from, to)...)
+	return newQuery(mods...)
+}
+
 // getEventCountsQuery returns a query that counts events by name in the given time range.
 // If eventNames is non-nil and non-empty, only those names are included; otherwise all names.
 func getEventCountsQuery(subject string, from, to time.Time, eventNames []string) (string, []any) {
@@ -862,6 +1075,29 @@ func getEventCountsQuery(subject string, from, to time.Time, eventNames []string
 	return newQuery(mods...)
 }
 
+// getEventAggregationsQuery returns a query that counts events and sums their
+// durations by name per interval bucket. Result columns: group_timestamp,
+// name, count (UInt64), duration_ns (UInt64).
+func getEventAggregationsQuery(subject string, from, to time.Time, intervalMicro int64, filter *model.EventFilter) (string, []any) {
+	mods := []qm.QueryMod{
+		qm.Select(fmt.Sprintf("toStartOfInterval(%s, toIntervalMicrosecond(%d), fromUnixTimestamp64Micro(%d)) AS %s",
+			vss.EventTimestampCol, intervalMicro, from.UnixMicro(), IntervalGroup)),
+		qm.Select(vss.EventNameCol + " AS name"),
+		qm.Select("count(*) AS count"),
+		qm.Select("sum(" + vss.EventDurationNsCol + ") AS duration_ns"),
+		qm.Where(eventSubjectWhere, subject),
+		qm.Where(vss.EventTimestampCol + " >= " + dateTime64Micro(from)),
+		qm.Where(vss.EventTimestampCol + " < " + dateTime64Micro(to)),
+		qm.GroupBy(IntervalGroup),
+		qm.GroupBy(vss.EventNameCol),
+		qm.OrderBy(groupAsc),
+		qm.OrderBy(vss.EventNameCol),
+	}
+	mods = append(mods, eventFromMods(subject, from, to, filter, false)...)
+	mods = appendEventFilterMods(mods, filter)
+	return newQuery(mods...)
+}
+
 // TimeRange is a [From, To) interval for batch event count queries.
 type TimeRange struct {
 	From, To time.Time
@@ -881,6 +1117,52 @@ func getEventCountsForRangesQuery(subject string, ranges []TimeRange, eventNames
 	return stmt, args
 }
 
+// Location samples for segment routes and distances are downsampled on the server to the first
+// sample per locationBucketSeconds, and at most maxLocationsPerSegment are returned per segment,
+// so a long segment or a high-frequency connection cannot return an unbounded number of rows.
+const (
+	locationBucketSeconds  = 10
+	maxLocationsPerSegment = 10000
+)
+
+// getLocationsForRangesQuery returns a query for the first non-zero currentLocationCoordinates sample
+// of every locationBucketSeconds bucket in each range, at most maxLocationsPerSegment per range. seg_idx
+// is the index of the range containing the sample. Ranges are (from, to) exclusive on to; globalFrom and
+// globalTo bound the scan.
+func getLocationsForRangesQuery(subject string, ranges []TimeRange, globalFrom, globalTo time.Time) (string, []any) {
+	multiIf := buildSegmentIndexMultiIf(vss.TimestampCol, ranges)
+	inner := "SELECT " + multiIf + ", " + vss.TimestampCol + ", " + vss.ValueLocationCol + " FROM " + vss.TableName + " FINAL" +
+		" PREWHERE " + subjectWhere +
+		" WHERE " + vss.NameCol + " = ?" +
+		" AND " + vss.TimestampCol + " >= " + dateTime64Micro(globalFrom) +
+		" AND " + vss.TimestampCol + " < " + dateTime64Micro(globalTo) +
+		" AND (" + vss.ValueLocationCol + ".latitude != 0 OR " + vss.ValueLocationCol + ".longitude != 0)"
+	stmt := "SELECT seg_idx, min(" + vss.TimestampCol + ") AS ts, argMin(" + vss.ValueLocationCol + ", " + vss.TimestampCol + ")" +
+		" FROM (" + inner + ") WHERE seg_idx >= 0" +
+		" GROUP BY seg_idx, toStartOfInterval(" + vss.TimestampCol + ", INTERVAL " + fmt.Sprint(locationBucketSeconds) + " second)" +
+		" ORDER BY seg_idx, ts" +
+		" LIMIT " + fmt.Sprint(maxLocationsPerSegment) + " BY seg_idx"
+	return stmt, []any{subject, vss.FieldCurrentLocationCoordinates}
+}
+
+// getFloatSamplesQuery returns the samples of the float signals names in [from, to), ordered by timestamp.
+func getFloatSamplesQuery(subject string, from, to time.Time, names []string) (string, []any) {
+	placeholders := make([]string, len(names))
+	args := make([]any, 0, 1+len(names))
+	args = append(args, subject)
+	for i, n := range names {
+		placeholders[i] = "?"
+		args = append(args, n)
+	}
+	stmt := "SELECT " + vss.NameCol + ", " + vss.TimestampCol + ", " + vss.ValueNumberCol + " FROM " + vss.TableName +
+		" PREWHERE " + subjectWhere +
+		" WHERE " + vss.NameCol + " IN (" + strings.Join(placeholders, ", ") + ")" +
+		" AND " + vss.TimestampCol + " >= " + dateTime64Micro(from) +
+		" AND " + vss.TimestampCol + " < " + dateTime64Micro(to) +
+		" ORDER BY " + vss.TimestampCol + ", " + vss.NameCol
+	return stmt, args
+}
+
 func eventCountsForRangesEmptyQuery() string {
 	return "SELECT toInt32(-1) AS seg_idx, '' AS name, toUInt64(0) AS count FROM " + vss.EventTableName + " WHERE 0"
 }
@@ -1,8 +1,11 @@
 package ch
 
 import (
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/DIMO-Network/model-garage/pkg/vss"
 	"github.com/DIMO-Network/telemetry-api/internal/graph/model"
 	"github.com/aarondl/sqlboiler/v4/queries/qm"
 	"github.com/stretchr/testify/assert"
@@ -90,3 +93,151 @@ func TestSummaryQueriesReadSummaryTables(t *testing.T) {
 	assert.Contains(t, ev, "FROM `event_summary`")
 	assert.Contains(t, ev, "sum(count)")
 }
+
+func TestRangeQueriesReadRawTables(t *testing.T) {
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(24 * time.Hour)
+
+	distinct, _ := getRangeDistinctQuery("subj", &from, &to, nil)
+	assert.Contains(t, distinct, "FROM `signal`")
+	assert.Contains(t, distinct, "timestamp >= fromUnixTimestamp64Micro(1704067200000000)")
+	assert.Contains(t, distinct, "timestamp < fromUnixTimestamp64Micro(1704153600000000)")
+
+	sig, _ := getRangeSignalSummariesQuery("subj", &from, nil, nil)
+	assert.Contains(t, sig, "FROM `signal`")
+	assert.Contains(t, sig, "count(*)")
+	assert.Contains(t, sig, "timestamp >= ")
+	assert.NotContains(t, sig, "timestamp < ")
+
+	ev, _ := getRangeEventSummariesQuery("subj", nil, &to)
+	assert.Contains(t, ev, "FROM `event`")
+	assert.Contains(t, ev, "count(*) AS count")
+	assert.NotContains(t, ev, vss.EventTimestampCol+" >= ")
+	assert.Contains(t, ev, vss.EventTimestampCol+" < fromUnixTimestamp64Micro(1704153600000000)")
+}
+
+func TestGetEventAggregationsQuery(t *testing.T) {
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(24 * time.Hour)
+	filter := &model.EventFilter{Name: &model.StringValueFilter{Eq: ref("behavior.harshBraking")}}
+
+	stmt, args := getEventAggregationsQuery("subj", from, to, time.Hour.Microseconds(), filter)
+	assert.Contains(t, stmt, "FROM `event`")
+	assert.Contains(t, stmt, "toStartOfInterval(timestamp, toIntervalMicrosecond(3600000000), fromUnixTimestamp64Micro(1704067200000000)) AS group_timestamp")
+	assert.Contains(t, stmt, "sum(duration_ns) AS duration_ns")
+	assert.Contains(t, stmt, "GROUP BY group_timestamp, name")
+	assert.Contains(t, stmt, "ORDER BY group_timestamp ASC, name")
+	assert.Equal(t, []any{"subj", "behavior.harshBraking"}, args)
+}
+
+func TestGetEventsQuery(t *testing.T) {
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(24 * time.Hour)
+
+	stmt, _ := getEventsQuery("subj", from, to, nil, EventQueryOptions{})
+	assert.Contains(t, stmt, "ORDER BY timestamp DESC")
+	assert.NotContains(t, stmt, "LIMIT")
+
+	stmt, _ = getEventsQuery("subj", from, to, nil, EventQueryOptions{Ascending: true, Limit: 101})
+	assert.Contains(t, stmt, "ORDER BY timestamp ASC")
+	assert.True(t, strings.HasSuffix(stmt, " LIMIT 101 WITH TIES;"), stmt)
+
+	after := from.Add(time.Hour)
+	stmt, args := getEventsQuery("subj", from, to, nil, EventQueryOptions{Ascending: true, After: &after})
+	assert.Contains(t, stmt, "timestamp > fromUnixTimestamp64Micro(1704070800000000)")
+	assert.Equal(t, []any{"subj"}, args)
+}
+
+func TestGetEventsQueryWithLocation(t *testing.T) {
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(time.Hour)
+
+	stmt, args := getEventsQuery("subj", from, to, nil, EventQueryOptions{WithLocation: true})
+	assert.True(t, strings.HasPrefix(stmt, "WITH event_location AS ("))
+	assert.Contains(t, stmt, "timestamp >= fromUnixTimestamp64Micro(1704063600000000)")
+	assert.Contains(t, stmt, "`loc_timestamp`, `loc_value` FROM event ASOF LEFT JOIN event_location ON subject = loc_subject AND timestamp >= loc_timestamp")
+	assert.Equal(t, []any{"subj", vss.FieldCurrentLocationCoordinates, "subj"}, args)
+}
+
+func TestEventLocationFilter(t *testing.T) {
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(time.Hour)
+	filter := &model.EventFilter{
+		Name: &model.StringValueFilter{Eq: ref("behavior.harshBraking")},
+		Location: &model.SignalLocationFilter{
+			InCircle: &model.InCircleFilter{Center: &model.FilterLocation{Latitude: 40.75, Longitude: -73.99}, Radius: 1},
+		},
+	}
+
+	stmt, args := getEventsQuery("subj", from, to, filter, EventQueryOptions{})
+	assert.Contains(t, stmt, "FROM event ASOF LEFT JOIN event_location")
+	assert.NotContains(t, stmt, "`loc_value` FROM")
+	assert.Contains(t, stmt, "loc_timestamp >= timestamp - toIntervalSecond(3600)")
+	assert.Contains(t, stmt, "geoDistance(?, ?, loc_value.longitude, loc_value.latitude) <= ?")
+	assert.Equal(t, []any{"subj", vss.FieldCurrentLocationCoordinates, "subj", "behavior.harshBraking", -73.99, 40.75, 1000.0}, args)
+
+	stmt, _ = getEventAggregationsQuery("subj", from, to, time.Hour.Microseconds(), filter)
+	assert.Contains(t, stmt, "FROM event ASOF LEFT JOIN event_location")
+	assert.Contains(t, stmt, "geoDistance(?, ?, loc_value.longitude, loc_value.latitude) <= ?")
+}
+
+func TestEventMetadataFilterMods(t *testing.T) {
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(24 * time.Hour)
+	filter := &model.EventFilter{
+		Metadata: []*model.EventMetadataFilter{
+			{Path: "code", In: []string{"P0420", "P0430"}},
+			{Path: "acceleration.peak", Gt: ref(0.5), Lt: ref(2.0)},
+			{Path: "mode", Eq: ref("")},
+		},
+	}
+
+	stmt, args := getEventsQuery("subj", from, to, filter, EventQueryOptions{})
+	assert.Contains(t, stmt, "JSONHas(metadata, ?) AND has(?, JSONExtractString(metadata, ?))")
+	assert.Contains(t, stmt, "JSONExtract(metadata, ?, ?, 'Nullable(Float64)') > ?")
+	assert.Contains(t, stmt, "JSONExtract(metadata, ?, ?, 'Nullable(Float64)') < ?")
+	// A missing field must not match an empty string.
+	assert.Contains(t, stmt, "JSONHas(metadata, ?) AND JSONExtractString(metadata, ?) = ?")
+	assert.Equal(t, []any{
+		"subj",
+		"code", []string{"P0420", "P0430"}, "code",
+		"acceleration", "peak", 0.5,
+		"acceleration", "peak", 2.0,
+		"mode", "mode", "",
+	}, args)
+}
+
+func TestGetLocationsForRangesQuery(t *testing.T) {
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	ranges := []TimeRange{
+		{From: from, To: from.Add(time.Hour)},
+		{From: from.Add(2 * time.Hour), To: from.Add(3 * time.Hour)},
+	}
+
+	stmt, args := getLocationsForRangesQuery("subj", ranges, from, from.Add(3*time.Hour))
+	assert.Contains(t, stmt, "multiIf(")
+	assert.Contains(t, stmt, "(value_location.latitude != 0 OR value_location.longitude != 0)")
+	assert.Contains(t, stmt, "FROM signal FINAL PREWHERE")
+	assert.Contains(t, stmt, "GROUP BY seg_idx, toStartOfInterval(timestamp, INTERVAL 10 second)")
+	assert.Contains(t, stmt, "ORDER BY seg_idx, ts LIMIT 10000 BY seg_idx")
+	assert.Equal(t, []any{"subj", vss.FieldCurrentLocationCoordinates}, args)
+}
+
+func TestGetFloatSamplesQuery(t *testing.T) {
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	stmt, args := getFloatSamplesQuery("subj", from, from.Add(time.Hour), []string{vss.FieldSpeed, vss.FieldAngularVelocityYaw})
+	assert.Contains(t, stmt, "name IN (?, ?)")
+	assert.Contains(t, stmt, "ORDER BY timestamp, name")
+	assert.Equal(t, []any{"subj", vss.FieldSpeed, vss.FieldAngularVelocityYaw}, args)
+}
+
+func TestBatchFloatCaseExprShareOver(t *testing.T) {
+	limit := 120.5
+	expr := batchFloatCaseExprWithAlias("batch_inner", []model.FloatSignalArgs{
+		{Name: vss.FieldSpeed, Agg: model.FloatAggregationMax},
+		{Name: vss.FieldSpeed, Agg: model.FloatAggregationShareOver, Filter: &model.SignalFloatFilter{Gt: &limit}},
+	})
+	assert.Contains(t, expr, "AND batch_inner.signal_index = 0 THEN max(batch_inner.value_number)")
+	assert.Contains(t, expr, "AND batch_inner.signal_index = 1 THEN avg(batch_inner.value_number > 120.5)")
+}
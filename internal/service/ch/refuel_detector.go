@@ -32,8 +32,8 @@ func NewRefuelDetector(conn clickhouse.Conn) *RefuelDetector {
 }
 
 // DetectSegments finds 5-min windows with >30% fuel rise, then for each rise emits a segment
-// from the trough (last low sample before the jump) to the peak (first stable high after).
-// 1 CH query (fuel only).
+// from the trough (last low sample before the jump) to the peak (first stable high after),
+// with the trough and peak fuel levels. 1 CH query (fuel only).
 func (d *RefuelDetector) DetectSegments(
 	ctx context.Context,
 	subject string,
@@ -90,7 +90,13 @@ func (d *RefuelDetector) DetectSegments(
 	}
 
 	merged := mergeTimeRanges(raw, 0, minDuration, from, to, nil)
-	return timeRangesToSegments(merged, from), nil
+	segments := timeRangesToSegments(merged, from)
+	for i, tr := range merged {
+		if trough, peak, ok := levelFirstLastInRange(samples, tr.start, tr.end); ok {
+			segments[i].Refuel = &model.RefuelDetails{TroughLevel: trough, PeakLevel: peak}
+		}
+	}
+	return segments, nil
 }
 
 // GetMechanismName returns the name of this detection mechanism.
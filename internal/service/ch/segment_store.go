@@ -0,0 +1,588 @@
+package ch
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ClickHouse/clickhouse-go/v2"
+	"github.com/DIMO-Network/telemetry-api/internal/graph/model"
+	"github.com/rs/zerolog"
+)
+
+const (
+	segmentStoreTable          = "segment_store"
+	segmentStoreWatermarkTable = "segment_store_watermark"
+	// segmentStoreChunk is the longest range detected at once while materializing, the range
+	// segments can be detected over without the store, and the most a query detects around it.
+	segmentStoreChunk = 31 * 24 * time.Hour
+	// segmentStoreMinMargin is the least time a segment must have ended before the end of the
+	// detected range to be stored. Later segments may still grow or merge with the next one.
+	segmentStoreMinMargin = time.Hour
+	// segmentStoreRefreshInterval is how often a store that is behind the queried range is
+	// extended; queries in between detect the tail after the watermark.
+	segmentStoreRefreshInterval = 15 * time.Minute
+	// segmentStoreQueueSize is the number of store keys that can wait for the writer. Queries
+	// don't queue work while it is full.
+	segmentStoreQueueSize = 1000
+	// segmentStoreJobTimeout bounds the time the writer spends on one job.
+	segmentStoreJobTimeout = 5 * time.Minute
+	// segmentStoreMaxJobChunks is the most chunks one job detects. Larger ranges are covered
+	// over several refreshes.
+	segmentStoreMaxJobChunks = 3
+	// segmentStoreRetention is how far back the store covers, the longest range segments can
+	// be queried over with the store. Older ranges are detected from raw signals.
+	segmentStoreRetention = 366 * 24 * time.Hour
+	// segmentStoreReprocessWindow is how far back stored segments are detected again, so
+	// telemetry that arrives up to this late changes them.
+	segmentStoreReprocessWindow = 7 * 24 * time.Hour
+	// segmentStoreReprocessInterval is how often the reprocess window of a store is detected again.
+	segmentStoreReprocessInterval = 6 * time.Hour
+)
+
+// ErrSegmentsNotStored is returned for stored ranges of which more than segmentStoreChunk
+// isn't materialized yet. The writer has been asked to cover them, so retrying later succeeds.
+var ErrSegmentsNotStored = errors.New("segments of the range are not stored yet")
+
+// segmentStoreDefaultConfigHash is the config hash of the only config the store keeps, so
+// arbitrary config values don't create store keys.
+var segmentStoreDefaultConfigHash = segmentConfigHash(nil)
+
+// SegmentStoreCovers reports whether the segment store keeps the segments detected with config
+// from from on: only the default detection config is stored, and only within segmentStoreRetention.
+func SegmentStoreCovers(from time.Time, config *model.SegmentConfig) bool {
+	return segmentConfigHash(config) == segmentStoreDefaultConfigHash && !from.Before(timeNow().Add(-segmentStoreRetention))
+}
+
+// segmentStore materializes closed segments per vehicle, mechanism and config in ClickHouse,
+// so only the open tail after the watermark is detected from raw signals on each query. It also
+// keeps the summaries of closed ranges, so they aren't computed again.
+// Queries only read the store; a background writer materializes the ranges they ask for.
+// The tables are created by the migrations in the migrations package.
+type segmentStore struct {
+	conn        clickhouse.Conn
+	jobs        chan segmentStoreJob
+	summaryJobs chan summaryStoreJob
+	ctx         context.Context
+	cancel      context.CancelFunc
+	done        chan struct{}
+
+	mu sync.Mutex
+	// queued holds the keys that have a job queued or running.
+	queued map[any]struct{}
+}
+
+// segmentStoreJob asks the writer to extend the store of a key to [from, to).
+type segmentStoreJob struct {
+	detector SegmentDetector
+	key      segmentStoreKey
+	from     time.Time
+	to       time.Time
+	config   *model.SegmentConfig
+}
+
+func newSegmentStore(conn clickhouse.Conn) *segmentStore {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &segmentStore{
+		conn:        conn,
+		jobs:        make(chan segmentStoreJob, segmentStoreQueueSize),
+		summaryJobs: make(chan summaryStoreJob, segmentStoreQueueSize),
+		ctx:         ctx,
+		cancel:      cancel,
+		done:        make(chan struct{}),
+		queued:      make(map[any]struct{}),
+	}
+}
+
+// run materializes queued jobs until the store is stopped.
+func (s *segmentStore) run() {
+	defer close(s.done)
+	for {
+		select {
+		case <-s.ctx.Done():
+			return
+		case job := <-s.jobs:
+			s.runJob(job.key, func(ctx context.Context) error {
+				if err := s.materialize(ctx, job); err != nil {
+					return fmt.Errorf("failed to materialize %s segments of %s: %w", job.key.mechanism, job.key.subject, err)
+				}
+				return nil
+			})
+		case job := <-s.summaryJobs:
+			s.runJob(job.key, func(ctx context.Context) error {
+				if err := s.insertSummaries(ctx, job); err != nil {
+					return fmt.Errorf("failed to store summaries of %s: %w", job.key.subject, err)
+				}
+				return nil
+			})
+		}
+	}
+}
+
+// runJob runs a job with a timeout and lets its key be queued again.
+func (s *segmentStore) runJob(key any, run func(ctx context.Context) error) {
+	ctx, cancel := context.WithTimeout(s.ctx, segmentStoreJobTimeout)
+	defer cancel()
+	if err := run(ctx); err != nil {
+		zerolog.Ctx(ctx).Warn().Err(err).Msg("Segment store job failed.")
+	}
+	s.mu.Lock()
+	delete(s.queued, key)
+	s.mu.Unlock()
+}
+
+// stop cancels the running job and waits for the writer to return.
+func (s *segmentStore) stop() {
+	s.cancel()
+	<-s.done
+}
+
+// enqueue queues a job unless one is already queued for its key or the queue is full.
+func enqueue[J any](s *segmentStore, jobs chan<- J, key any, job J) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.queued[key]; ok {
+		return
+	}
+	select {
+	case jobs <- job:
+		s.queued[key] = struct{}{}
+	default:
+	}
+}
+
+// segmentStoreKey identifies the segments of one vehicle detected by one mechanism and config.
+type segmentStoreKey struct {
+	subject    string
+	mechanism  string
+	configHash string
+}
+
+// segmentWatermark is the range a generation of the store covers: every segment that ended
+// in [coveredFrom, coveredTo) is stored, and no segment is active at coveredFrom or coveredTo.
+type segmentWatermark struct {
+	generation  uint64
+	coveredFrom time.Time
+	coveredTo   time.Time
+	updatedAt   time.Time
+	// reprocessedAt is when the reprocess window was last detected again.
+	reprocessedAt time.Time
+}
+
+// storedSegmentDetails holds the mechanism-specific details set by detectors.
+type storedSegmentDetails struct {
+	Stop     *model.SegmentStop     `json:"stop,omitempty"`
+	Refuel   *model.RefuelDetails   `json:"refuel,omitempty"`
+	FuelDrop *model.FuelDropDetails `json:"fuelDrop,omitempty"`
+}
+
+// getSegments returns the segments in [from, to): stored segments in the coverage, and
+// segments detected before and after it. Ranges the store doesn't cover yet are queued for
+// the writer.
+func (s *segmentStore) getSegments(ctx context.Context, detector SegmentDetector, subject string, from, to time.Time, config *model.SegmentConfig) ([]*model.Segment, error) {
+	configHash := segmentConfigHash(config)
+	if configHash != segmentStoreDefaultConfigHash {
+		return detector.DetectSegments(ctx, subject, from, to, config)
+	}
+	key := segmentStoreKey{subject: subject, mechanism: detector.GetMechanismName(), configHash: configHash}
+	wm, ok, err := s.getWatermark(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	uncovered := wm.uncovered(from, to)
+	// Ranges that can't be detected here are queued without waiting for the refresh interval,
+	// so retries keep the writer going until they are covered.
+	if !ok || wm.behind(from, to) && (timeNow().Sub(wm.updatedAt) >= segmentStoreRefreshInterval || uncovered > segmentStoreChunk) {
+		enqueue(s, s.jobs, key, segmentStoreJob{detector: detector, key: key, from: from, to: to, config: config})
+	}
+	if uncovered > segmentStoreChunk {
+		return nil, ErrSegmentsNotStored
+	}
+	if !ok || !from.Before(wm.coveredTo) || !to.After(wm.coveredFrom) {
+		return detector.DetectSegments(ctx, subject, from, to, config)
+	}
+
+	var segments []*model.Segment
+	storedFrom := from
+	if from.Before(wm.coveredFrom) {
+		// No segment is active at the coverage start, so the head ends before it.
+		segments, err = detector.DetectSegments(ctx, subject, from, wm.coveredFrom, config)
+		if err != nil {
+			return nil, err
+		}
+		storedFrom = wm.coveredFrom
+	}
+	storedTo := to
+	if wm.coveredTo.Before(storedTo) {
+		storedTo = wm.coveredTo
+	}
+	stored, err := s.getStoredSegments(ctx, key, wm.generation, storedFrom, storedTo)
+	if err != nil {
+		return nil, err
+	}
+	segments = append(segments, stored...)
+	if wm.coveredTo.Before(to) {
+		tail, err := detector.DetectSegments(ctx, subject, wm.coveredTo, to, config)
+		if err != nil {
+			return nil, err
+		}
+		// No segment is active at the watermark, so a tail segment starting there didn't start earlier.
+		for _, seg := range tail {
+			seg.StartedBeforeRange = false
+		}
+		segments = append(segments, tail...)
+	}
+	if segments == nil {
+		segments = []*model.Segment{}
+	}
+	return segments, nil
+}
+
+// uncovered returns the part of [from, to) that wm doesn't cover, which queries detect from
+// raw signals. The zero watermark covers nothing.
+func (wm segmentWatermark) uncovered(from, to time.Time) time.Duration {
+	if !from.Before(wm.coveredTo) || !to.After(wm.coveredFrom) {
+		return to.Sub(from)
+	}
+	var d time.Duration
+	if from.Before(wm.coveredFrom) {
+		d += wm.coveredFrom.Sub(from)
+	}
+	if wm.coveredTo.Before(to) {
+		d += to.Sub(wm.coveredTo)
+	}
+	return d
+}
+
+// behind reports whether the writer can cover more of [from, to) than wm does.
+func (wm segmentWatermark) behind(from, to time.Time) bool {
+	return wm.coveredTo.Before(to) ||
+		from.Before(wm.coveredFrom) && wm.coveredFrom.After(timeNow().Add(-segmentStoreRetention))
+}
+
+// materialize extends the coverage of the job's key towards the job's range, after the
+// coverage first and then before it, detecting at most segmentStoreMaxJobChunks chunks.
+// Nothing older than segmentStoreRetention is covered. A coverage that ended before the
+// retention window is replaced by a new generation, and the rows of the old one are deleted.
+// Every segmentStoreReprocessInterval, the end of the coverage is rewound to be detected again.
+func (s *segmentStore) materialize(ctx context.Context, job segmentStoreJob) error {
+	wm, ok, err := s.getWatermark(ctx, job.key)
+	if err != nil {
+		return err
+	}
+	windowStart := timeNow().Add(-segmentStoreRetention)
+	from := job.from
+	if from.Before(windowStart) {
+		from = windowStart
+	}
+	if !job.to.After(from) {
+		return nil
+	}
+	to := job.to
+	superseded := false
+	switch {
+	case !ok || wm.coveredTo.Before(windowStart):
+		superseded = ok
+		wm = segmentWatermark{generation: uint64(timeNow().UnixNano()), coveredFrom: from, coveredTo: from, reprocessedAt: timeNow()}
+	case timeNow().Sub(wm.reprocessedAt) >= segmentStoreReprocessInterval:
+		if wm.coveredTo.After(to) {
+			to = wm.coveredTo
+		}
+		if err := s.rewind(ctx, job.key, &wm); err != nil {
+			return err
+		}
+	}
+	margin := max(time.Duration(resolveBaseConfig(job.config).maxGapSeconds)*time.Second, segmentStoreMinMargin)
+
+	chunks := 0
+	for ; chunks < segmentStoreMaxJobChunks && wm.coveredTo.Before(to); chunks++ {
+		progress, err := s.extendCoverage(ctx, job, &wm, to, margin)
+		if err != nil {
+			return err
+		}
+		if !progress {
+			break
+		}
+	}
+	for ; chunks < segmentStoreMaxJobChunks && from.Before(wm.coveredFrom); chunks++ {
+		progress, err := s.prependCoverage(ctx, job, &wm, from)
+		if err != nil {
+			return err
+		}
+		if !progress {
+			break
+		}
+	}
+	if !wm.coveredTo.After(wm.coveredFrom) {
+		return nil
+	}
+	// The watermark is written even without progress, so queries wait for the next refresh.
+	if err := s.setWatermark(ctx, job.key, wm); err != nil {
+		return err
+	}
+	if superseded {
+		return s.deleteOtherGenerations(ctx, job.key, wm.generation)
+	}
+	return nil
+}
+
+// rewind moves the end of the coverage back by segmentStoreReprocessWindow, to the start of
+// the stored segment active there if any, and deletes the segments after it.
+func (s *segmentStore) rewind(ctx context.Context, key segmentStoreKey, wm *segmentWatermark) error {
+	rewindTo := wm.coveredTo.Add(-segmentStoreReprocessWindow)
+	if rewindTo.Before(wm.coveredFrom) {
+		rewindTo = wm.coveredFrom
+	}
+	var start *time.Time
+	row := s.conn.QueryRow(ctx, getFirstStoredSegmentStartQuery(rewindTo), key.subject, key.mechanism, key.configHash, wm.generation)
+	if err := row.Scan(&start); err != nil {
+		return fmt.Errorf("failed to query stored segment to rewind to: %w", err)
+	}
+	if start != nil && start.Before(rewindTo) {
+		rewindTo = *start
+	}
+	wm.coveredTo = rewindTo
+	wm.reprocessedAt = timeNow()
+	// Queries detect the rewound range until it is stored again.
+	if err := s.setWatermark(ctx, key, *wm); err != nil {
+		return err
+	}
+	err := s.conn.Exec(ctx, "DELETE FROM "+segmentStoreTable+
+		" WHERE subject = ? AND mechanism = ? AND config_hash = ? AND generation = ? AND start_time >= "+dateTime64Micro(rewindTo),
+		key.subject, key.mechanism, key.configHash, wm.generation)
+	if err != nil {
+		return fmt.Errorf("failed to delete rewound stored segments: %w", err)
+	}
+	return nil
+}
+
+// extendCoverage detects the chunk after the coverage, up to to, and stores its closed segments.
+func (s *segmentStore) extendCoverage(ctx context.Context, job segmentStoreJob, wm *segmentWatermark, to time.Time, margin time.Duration) (bool, error) {
+	chunkEnd := wm.coveredTo.Add(segmentStoreChunk)
+	if chunkEnd.After(to) {
+		chunkEnd = to
+	}
+	detected, err := job.detector.DetectSegments(ctx, job.key.subject, wm.coveredTo, chunkEnd, job.config)
+	if err != nil {
+		return false, err
+	}
+	closed, next := splitClosedSegments(detected, wm.coveredTo, chunkEnd, margin)
+	if !next.After(wm.coveredTo) {
+		return false, nil
+	}
+	if wm.coveredTo.Equal(wm.coveredFrom) {
+		closed, wm.coveredFrom = trimCoverageStart(closed, wm.coveredFrom)
+	}
+	if err := s.insertSegments(ctx, job.key, wm.generation, closed); err != nil {
+		return false, err
+	}
+	wm.coveredTo = next
+	return true, nil
+}
+
+// prependCoverage detects the chunk before the coverage, down to from, and stores its segments.
+func (s *segmentStore) prependCoverage(ctx context.Context, job segmentStoreJob, wm *segmentWatermark, from time.Time) (bool, error) {
+	chunkStart := wm.coveredFrom.Add(-segmentStoreChunk)
+	if chunkStart.Before(from) {
+		chunkStart = from
+	}
+	detected, err := job.detector.DetectSegments(ctx, job.key.subject, chunkStart, wm.coveredFrom, job.config)
+	if err != nil {
+		return false, err
+	}
+	for _, seg := range detected {
+		if seg.IsOngoing || seg.End == nil {
+			// Nothing is active at the coverage start, so this only happens while the data changes.
+			return false, nil
+		}
+	}
+	segments, newFrom := trimCoverageStart(detected, chunkStart)
+	if !newFrom.Before(wm.coveredFrom) {
+		return false, nil
+	}
+	if err := s.insertSegments(ctx, job.key, wm.generation, segments); err != nil {
+		return false, err
+	}
+	wm.coveredFrom = newFrom
+	return true, nil
+}
+
+// trimCoverageStart drops a first segment that started before start, whose beginning wasn't
+// detected, and returns the remaining segments and the coverage start: the end of the dropped
+// segment, or start. No segment is active at the returned start.
+func trimCoverageStart(segments []*model.Segment, start time.Time) ([]*model.Segment, time.Time) {
+	if len(segments) > 0 && segments[0].StartedBeforeRange && segments[0].End != nil {
+		return segments[1:], segments[0].End.Timestamp
+	}
+	return segments, start
+}
+
+// splitClosedSegments returns the segments detected in [from, chunkEnd) that are final, and the
+// new watermark: the start of the first segment that ended within margin of chunkEnd or is still
+// open, or chunkEnd-margin if there is none. segments must be sorted by start.
+func splitClosedSegments(segments []*model.Segment, from, chunkEnd time.Time, margin time.Duration) ([]*model.Segment, time.Time) {
+	next := chunkEnd.Add(-margin)
+	var closed []*model.Segment
+	for _, seg := range segments {
+		if seg.IsOngoing || seg.End == nil || seg.End.Timestamp.After(next) {
+			next = seg.Start.Timestamp
+			break
+		}
+		closed = append(closed, seg)
+	}
+	if next.Before(from) {
+		next = from
+	}
+	return closed, next
+}
+
+// segmentConfigHash returns a hash of the config fields that affect detection, so configs that
+// only differ in how segments are summarized share stored segments.
+func segmentConfigHash(config *model.SegmentConfig) string {
+	var detection model.SegmentConfig
+	if config != nil {
+		detection = *config
+		detection.BatteryCapacityKwh = nil
+		detection.FuelTankCapacityLiters = nil
+		detection.FuelPricePerLiter = nil
+		detection.RouteToleranceMeters = nil
+		detection.RouteFormat = nil
+	}
+	// Marshaling a struct of plain fields can't fail.
+	b, _ := json.Marshal(detection)
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:16])
+}
+
+func (s *segmentStore) getWatermark(ctx context.Context, key segmentStoreKey) (segmentWatermark, bool, error) {
+	var wm segmentWatermark
+	row := s.conn.QueryRow(ctx, getSegmentWatermarkQuery(), key.subject, key.mechanism, key.configHash)
+	if err := row.Scan(&wm.generation, &wm.coveredFrom, &wm.coveredTo, &wm.updatedAt, &wm.reprocessedAt); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return segmentWatermark{}, false, nil
+		}
+		return segmentWatermark{}, false, fmt.Errorf("failed to query segment store watermark: %w", err)
+	}
+	return wm, true, nil
+}
+
+// deleteOtherGenerations deletes the stored segments of a key that are not in the given generation.
+func (s *segmentStore) deleteOtherGenerations(ctx context.Context, key segmentStoreKey, generation uint64) error {
+	err := s.conn.Exec(ctx, "DELETE FROM "+segmentStoreTable+
+		" WHERE subject = ? AND mechanism = ? AND config_hash = ? AND generation != ?",
+		key.subject, key.mechanism, key.configHash, generation)
+	if err != nil {
+		return fmt.Errorf("failed to delete superseded stored segments: %w", err)
+	}
+	return nil
+}
+
+func (s *segmentStore) setWatermark(ctx context.Context, key segmentStoreKey, wm segmentWatermark) error {
+	err := s.conn.Exec(ctx, "INSERT INTO "+segmentStoreWatermarkTable+
+		" (subject, mechanism, config_hash, generation, covered_from, covered_to, updated_at, reprocessed_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?)",
+		key.subject, key.mechanism, key.configHash, wm.generation, wm.coveredFrom, wm.coveredTo, timeNow(), wm.reprocessedAt)
+	if err != nil {
+		return fmt.Errorf("failed to update segment store watermark: %w", err)
+	}
+	return nil
+}
+
+// insertSegments stores closed segments.
+func (s *segmentStore) insertSegments(ctx context.Context, key segmentStoreKey, generation uint64, segments []*model.Segment) error {
+	if len(segments) == 0 {
+		return nil
+	}
+	batch, err := s.conn.PrepareBatch(ctx, "INSERT INTO "+segmentStoreTable+
+		" (subject, mechanism, config_hash, generation, start_time, end_time, details)")
+	if err != nil {
+		return fmt.Errorf("failed to prepare segment store insert: %w", err)
+	}
+	for _, seg := range segments {
+		details, err := json.Marshal(storedSegmentDetails{Stop: seg.Stop, Refuel: seg.Refuel, FuelDrop: seg.FuelDrop})
+		if err != nil {
+			_ = batch.Abort()
+			return fmt.Errorf("failed to encode segment details: %w", err)
+		}
+		err = batch.Append(key.subject, key.mechanism, key.configHash, generation,
+			seg.Start.Timestamp, seg.End.Timestamp, string(details))
+		if err != nil {
+			_ = batch.Abort()
+			return fmt.Errorf("failed to append stored segment: %w", err)
+		}
+	}
+	if err := batch.Send(); err != nil {
+		return fmt.Errorf("failed to insert stored segments: %w", err)
+	}
+	return nil
+}
+
+// getStoredSegments returns the stored segments of a generation that overlap [from, to), clipped to it.
+func (s *segmentStore) getStoredSegments(ctx context.Context, key segmentStoreKey, generation uint64, from, to time.Time) (_ []*model.Segment, retErr error) {
+	rows, err := s.conn.Query(ctx, getStoredSegmentsQuery(from, to), key.subject, key.mechanism, key.configHash, generation)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query stored segments: %w", err)
+	}
+	defer func() { retErr = errors.Join(retErr, rows.Close()) }()
+	var out []*model.Segment
+	for rows.Next() {
+		var start, end time.Time
+		var details string
+		if err := rows.Scan(&start, &end, &details); err != nil {
+			return nil, fmt.Errorf("failed to scan stored segment: %w", err)
+		}
+		seg, err := storedSegment(start, end, details, from, to)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, seg)
+	}
+	return out, rows.Err()
+}
+
+// storedSegment rebuilds a segment read from the store, clipped to [from, to] like detected segments.
+func storedSegment(start, end time.Time, details string, from, to time.Time) (*model.Segment, error) {
+	startedBeforeRange := false
+	if start.Before(from) {
+		start, startedBeforeRange = from, true
+	}
+	if end.After(to) {
+		end = to
+	}
+	seg := newSegment(start, &end, int32(end.Sub(start).Seconds()), false, startedBeforeRange)
+	var d storedSegmentDetails
+	if err := json.Unmarshal([]byte(details), &d); err != nil {
+		return nil, fmt.Errorf("failed to decode stored segment details: %w", err)
+	}
+	seg.Stop, seg.Refuel, seg.FuelDrop = d.Stop, d.Refuel, d.FuelDrop
+	return seg, nil
+}
+
+// getSegmentWatermarkQuery returns a query for the latest watermark of a store key.
+func getSegmentWatermarkQuery() string {
+	return "SELECT generation, covered_from, covered_to, updated_at, reprocessed_at FROM " + segmentStoreWatermarkTable + " FINAL" +
+		" WHERE subject = ? AND mechanism = ? AND config_hash = ?"
+}
+
+// getStoredSegmentsQuery returns a query for the stored segments of a store key and generation that
+// end after from and start before to, in order.
+func getStoredSegmentsQuery(from, to time.Time) string {
+	return "SELECT start_time, end_time, details FROM " + segmentStoreTable + " FINAL" +
+		" WHERE subject = ? AND mechanism = ? AND config_hash = ? AND generation = ?" +
+		" AND end_time > " + dateTime64Micro(from) +
+		" AND start_time < " + dateTime64Micro(to) +
+		" ORDER BY start_time"
+}
+
+// getFirstStoredSegmentStartQuery returns a query for the earliest start of the stored segments of
+// a store key and generation that end after t, or NULL if there is none.
+func getFirstStoredSegmentStartQuery(t time.Time) string {
+	return "SELECT minOrNull(start_time) FROM " + segmentStoreTable + " FINAL" +
+		" WHERE subject = ? AND mechanism = ? AND config_hash = ? AND generation = ?" +
+		" AND end_time > " + dateTime64Micro(t)
+}
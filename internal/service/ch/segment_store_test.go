@@ -0,0 +1,189 @@
+package ch
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DIMO-Network/telemetry-api/internal/graph/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitClosedSegments(t *testing.T) {
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	chunkEnd := from.Add(24 * time.Hour)
+	closedSeg := func(start, end time.Duration) *model.Segment {
+		e := from.Add(end)
+		return newSegment(from.Add(start), &e, int32((end - start).Seconds()), false, false)
+	}
+
+	t.Run("all closed", func(t *testing.T) {
+		segs := []*model.Segment{closedSeg(time.Hour, 2*time.Hour), closedSeg(5*time.Hour, 6*time.Hour)}
+		closed, next := splitClosedSegments(segs, from, chunkEnd, time.Hour)
+		require.Equal(t, segs, closed)
+		require.Equal(t, chunkEnd.Add(-time.Hour), next)
+	})
+
+	t.Run("segment ending near the chunk end is not stored", func(t *testing.T) {
+		segs := []*model.Segment{closedSeg(time.Hour, 2*time.Hour), closedSeg(22*time.Hour, 23*time.Hour+30*time.Minute)}
+		closed, next := splitClosedSegments(segs, from, chunkEnd, time.Hour)
+		require.Equal(t, segs[:1], closed)
+		require.Equal(t, from.Add(22*time.Hour), next)
+	})
+
+	t.Run("ongoing segment", func(t *testing.T) {
+		segs := []*model.Segment{closedSeg(time.Hour, 2*time.Hour), newSegment(from.Add(10*time.Hour), nil, 0, true, false)}
+		closed, next := splitClosedSegments(segs, from, chunkEnd, time.Hour)
+		require.Equal(t, segs[:1], closed)
+		require.Equal(t, from.Add(10*time.Hour), next)
+	})
+
+	t.Run("segment open for the whole chunk makes no progress", func(t *testing.T) {
+		segs := []*model.Segment{newSegment(from, nil, 0, true, true)}
+		closed, next := splitClosedSegments(segs, from, chunkEnd, time.Hour)
+		require.Empty(t, closed)
+		require.Equal(t, from, next)
+	})
+
+	t.Run("chunk shorter than the margin", func(t *testing.T) {
+		closed, next := splitClosedSegments(nil, from, from.Add(10*time.Minute), time.Hour)
+		require.Empty(t, closed)
+		require.Equal(t, from, next)
+	})
+}
+
+func TestTrimCoverageStart(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	seg := func(from, to time.Duration, startedBefore bool) *model.Segment {
+		e := start.Add(to)
+		return newSegment(start.Add(from), &e, int32((to - from).Seconds()), false, startedBefore)
+	}
+
+	segs := []*model.Segment{seg(0, time.Hour, true), seg(2*time.Hour, 3*time.Hour, false)}
+	kept, coveredFrom := trimCoverageStart(segs, start)
+	require.Equal(t, segs[1:], kept, "a segment that started before the coverage is not stored")
+	require.Equal(t, start.Add(time.Hour), coveredFrom)
+
+	segs = []*model.Segment{seg(time.Hour, 2*time.Hour, false)}
+	kept, coveredFrom = trimCoverageStart(segs, start)
+	require.Equal(t, segs, kept)
+	require.Equal(t, start, coveredFrom)
+
+	kept, coveredFrom = trimCoverageStart(nil, start)
+	require.Empty(t, kept)
+	require.Equal(t, start, coveredFrom)
+}
+
+func TestSegmentWatermarkBehind(t *testing.T) {
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	origTimeNow := timeNow
+	timeNow = func() time.Time { return now }
+	t.Cleanup(func() { timeNow = origTimeNow })
+
+	wm := segmentWatermark{coveredFrom: now.AddDate(0, -2, 0), coveredTo: now.Add(-2 * time.Hour)}
+	require.False(t, wm.behind(wm.coveredFrom.Add(time.Hour), wm.coveredTo), "range inside the coverage")
+	require.True(t, wm.behind(wm.coveredFrom, now), "range after the coverage")
+	require.True(t, wm.behind(now.AddDate(0, -3, 0), wm.coveredTo), "range before the coverage")
+
+	// Nothing before the retention window is covered.
+	wm.coveredFrom = now.Add(-segmentStoreRetention).Add(-time.Hour)
+	require.False(t, wm.behind(now.AddDate(-2, 0, 0), wm.coveredTo))
+}
+
+func TestSegmentWatermarkUncovered(t *testing.T) {
+	start := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	wm := segmentWatermark{coveredFrom: start, coveredTo: start.AddDate(0, 3, 0)}
+	require.Zero(t, wm.uncovered(start.Add(time.Hour), start.AddDate(0, 2, 0)), "range inside the coverage")
+	require.Equal(t, 2*time.Hour, wm.uncovered(start.Add(-time.Hour), wm.coveredTo.Add(time.Hour)), "head and tail")
+	require.Equal(t, 24*time.Hour, wm.uncovered(wm.coveredTo.Add(time.Hour), wm.coveredTo.Add(25*time.Hour)), "range after the coverage")
+	require.Equal(t, 24*time.Hour, segmentWatermark{}.uncovered(start, start.Add(24*time.Hour)), "no coverage")
+}
+
+func TestSegmentStoreCovers(t *testing.T) {
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	origTimeNow := timeNow
+	timeNow = func() time.Time { return now }
+	t.Cleanup(func() { timeNow = origTimeNow })
+
+	from := now.AddDate(0, -6, 0)
+	require.True(t, SegmentStoreCovers(from, nil))
+	require.True(t, SegmentStoreCovers(from, &model.SegmentConfig{BatteryCapacityKwh: ref(75.0)}), "summary-only fields")
+	require.False(t, SegmentStoreCovers(from, &model.SegmentConfig{MaxGapSeconds: ref(600)}), "detection settings aren't stored")
+	require.False(t, SegmentStoreCovers(now.Add(-segmentStoreRetention).Add(-time.Hour), nil), "older than the retention")
+}
+
+func TestSegmentConfigHash(t *testing.T) {
+	require.Equal(t, segmentConfigHash(nil), segmentConfigHash(&model.SegmentConfig{}))
+	// Summary-only fields don't affect detection.
+	require.Equal(t, segmentConfigHash(nil), segmentConfigHash(&model.SegmentConfig{
+		BatteryCapacityKwh:   ref(75.0),
+		RouteToleranceMeters: ref(50.0),
+	}))
+	require.NotEqual(t, segmentConfigHash(nil), segmentConfigHash(&model.SegmentConfig{MaxGapSeconds: ref(600)}))
+	require.Equal(t, segmentConfigHash(&model.SegmentConfig{MaxGapSeconds: ref(600)}), segmentConfigHash(&model.SegmentConfig{MaxGapSeconds: ref(600)}))
+}
+
+func TestStoredSegment(t *testing.T) {
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	to := from.Add(24 * time.Hour)
+
+	seg, err := storedSegment(from.Add(time.Hour), from.Add(2*time.Hour), `{"stop":{"centroid":{"latitude":40.5,"longitude":-74.25,"hdop":0}}}`, from, to)
+	require.NoError(t, err)
+	require.Equal(t, from.Add(time.Hour), seg.Start.Timestamp)
+	require.Equal(t, from.Add(2*time.Hour), seg.End.Timestamp)
+	require.Equal(t, 3600, seg.Duration)
+	require.False(t, seg.StartedBeforeRange)
+	require.False(t, seg.IsOngoing)
+	require.Equal(t, 40.5, seg.Stop.Centroid.Latitude)
+	require.Nil(t, seg.Refuel)
+
+	// Clipped to the query range.
+	seg, err = storedSegment(from.Add(-time.Hour), to.Add(time.Hour), `{}`, from, to)
+	require.NoError(t, err)
+	require.Equal(t, from, seg.Start.Timestamp)
+	require.Equal(t, to, seg.End.Timestamp)
+	require.True(t, seg.StartedBeforeRange)
+
+	_, err = storedSegment(from, to, `not json`, from, to)
+	require.Error(t, err)
+}
+
+func TestGetStoredSegmentsQuery(t *testing.T) {
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	stmt := getStoredSegmentsQuery(from, from.Add(24*time.Hour))
+	require.Contains(t, stmt, "FROM segment_store FINAL")
+	require.Contains(t, stmt, "subject = ? AND mechanism = ? AND config_hash = ? AND generation = ?")
+	require.Contains(t, stmt, "end_time > "+dateTime64Micro(from))
+	require.Contains(t, stmt, "ORDER BY start_time")
+}
+
+func TestSegmentStoreEnqueue(t *testing.T) {
+	s := newSegmentStore(nil)
+	s.jobs = make(chan segmentStoreJob, 2)
+	job := func(subject string) segmentStoreJob {
+		return segmentStoreJob{key: segmentStoreKey{subject: subject, mechanism: "ignitionDetection"}}
+	}
+
+	queue := func(j segmentStoreJob) { enqueue(s, s.jobs, j.key, j) }
+
+	queue(job("a"))
+	queue(job("a"))
+	require.Len(t, s.jobs, 1, "a key is queued once")
+
+	queue(job("b"))
+	queue(job("c"))
+	require.Len(t, s.jobs, 2, "jobs are dropped while the queue is full")
+	require.NotContains(t, s.queued, job("c").key)
+
+	<-s.jobs
+	delete(s.queued, job("a").key)
+	queue(job("a"))
+	require.Len(t, s.jobs, 2, "a key is queued again once its job is done")
+}
+
+func TestGetFirstStoredSegmentStartQuery(t *testing.T) {
+	at := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	stmt := getFirstStoredSegmentStartQuery(at)
+	require.Contains(t, stmt, "SELECT minOrNull(start_time) FROM segment_store FINAL")
+	require.Contains(t, stmt, "subject = ? AND mechanism = ? AND config_hash = ? AND generation = ?")
+	require.Contains(t, stmt, "end_time > "+dateTime64Micro(at))
+}
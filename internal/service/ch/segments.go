@@ -26,6 +26,14 @@ func newDetector(conn clickhouse.Conn, mechanism model.DetectionMechanism) (Segm
 		return NewRefuelDetector(conn), nil
 	case model.DetectionMechanismRecharge:
 		return NewRechargeDetector(conn), nil
+	case model.DetectionMechanismThreshold:
+		return NewThresholdDetector(conn), nil
+	case model.DetectionMechanismGeofence:
+		return NewGeofenceDetector(conn), nil
+	case model.DetectionMechanismStops:
+		return NewStopsDetector(conn), nil
+	case model.DetectionMechanismFuelDrop:
+		return NewFuelDropDetector(conn), nil
 	default:
 		return nil, fmt.Errorf("unknown detection mechanism: %s", mechanism)
 	}
@@ -45,7 +53,12 @@ func (s *Service) GetSegments(
 	}
 
 	timer := prometheus.NewTimer(GetSegmentsLatency.WithLabelValues(mechanism.String()))
-	segments, err := detector.DetectSegments(ctx, subject, from, to, config)
+	var segments []*model.Segment
+	if s.segmentStore != nil {
+		segments, err = s.segmentStore.getSegments(ctx, detector, subject, from, to, config)
+	} else {
+		segments, err = detector.DetectSegments(ctx, subject, from, to, config)
+	}
 	timer.ObserveDuration()
 	if err != nil {
 		return nil, err
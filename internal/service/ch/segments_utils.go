@@ -145,6 +145,44 @@ func getLevelSamples(ctx context.Context, conn clickhouse.Conn, subject string,
 	return out, rows.Err()
 }
 
+// findSampleRuns walks sorted samples and finds contiguous runs of samples whose value matches.
+// A gap between consecutive matching samples larger than maxGap seconds ends the current run.
+// Only runs with duration >= minDuration are emitted. Ranges are clipped to [from, to].
+func findSampleRuns(samples []levelSample, match func(float64) bool, maxGap, minDuration int, from, to time.Time) []timeRange {
+	maxGapDur := time.Duration(maxGap) * time.Second
+	var ranges []timeRange
+	var runStart, runEnd time.Time
+	inRun := false
+
+	appendRun := func() {
+		if tr, ok := clipTimeRange(timeRange{start: runStart, end: runEnd}, from, to, minDuration); ok {
+			ranges = append(ranges, tr)
+		}
+	}
+	for _, s := range samples {
+		if match(s.value) {
+			if !inRun {
+				runStart = s.ts
+				runEnd = s.ts
+				inRun = true
+			} else if s.ts.Sub(runEnd) > maxGapDur {
+				appendRun()
+				runStart = s.ts
+				runEnd = s.ts
+			} else {
+				runEnd = s.ts
+			}
+		} else if inRun {
+			appendRun()
+			inRun = false
+		}
+	}
+	if inRun {
+		appendRun()
+	}
+	return ranges
+}
+
 // mergeTimeRanges merges sorted time ranges within maxGap. If shouldMerge is non-nil it is called
 // to decide whether two ranges within maxGap should actually merge (e.g. odometer check).
 // Only ranges with duration >= minDuration are kept. Ranges are clipped to [from, to].
@@ -0,0 +1,230 @@
+package ch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/ClickHouse/clickhouse-go/v2"
+	"github.com/DIMO-Network/model-garage/pkg/vss"
+	"github.com/DIMO-Network/telemetry-api/internal/graph/model"
+	"github.com/aarondl/sqlboiler/v4/queries/qm"
+)
+
+const (
+	defaultStopRadiusMeters = 100 // max distance from the stop centroid to count as stationary
+	stopMaxSpeedKph         = 3   // speeds at or below this count as stopped (sensor noise at standstill)
+	earthRadiusMeters       = 6371008.8
+)
+
+// StopsDetector detects stops: stationary periods between trips where the vehicle's location
+// stays within a radius of the stop's centroid and its speed is approximately 0.
+// Parked vehicles often report rarely, so gaps between samples don't end a stop; only
+// moving away does.
+type StopsDetector struct {
+	conn clickhouse.Conn
+}
+
+// NewStopsDetector creates a new StopsDetector with the given connection.
+func NewStopsDetector(conn clickhouse.Conn) *StopsDetector {
+	return &StopsDetector{conn: conn}
+}
+
+// locationSample is a timestamped location used by the stops detector.
+type locationSample struct {
+	ts  time.Time
+	lat float64
+	lng float64
+}
+
+// stopRange is a detected stop before conversion to a segment.
+type stopRange struct {
+	timeRange
+	centroid model.Location
+	// open is true when the data ended before the vehicle moved away.
+	open bool
+}
+
+// DetectSegments fetches location, speed and ignition samples (3 CH queries) and clusters
+// stationary location samples into stops in-memory.
+func (d *StopsDetector) DetectSegments(
+	ctx context.Context,
+	subject string,
+	from, to time.Time,
+	config *model.SegmentConfig,
+) ([]*model.Segment, error) {
+	rc := resolveBaseConfig(config)
+	radius := float64(defaultStopRadiusMeters)
+	if config != nil && config.StopRadiusMeters != nil {
+		radius = float64(*config.StopRadiusMeters)
+	}
+
+	lookbackFrom := from.Add(-time.Duration(rc.maxGapSeconds) * time.Second)
+	locations, err := d.getLocationSamples(ctx, subject, lookbackFrom, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query location samples: %w", err)
+	}
+	if len(locations) == 0 {
+		return []*model.Segment{}, nil
+	}
+	speeds, err := getLevelSamples(ctx, d.conn, subject, vss.FieldSpeed, lookbackFrom, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query speed samples: %w", err)
+	}
+	ignition, err := getLevelSamples(ctx, d.conn, subject, vss.FieldIsIgnitionOn, lookbackFrom, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query ignition samples: %w", err)
+	}
+
+	maxGapDur := time.Duration(rc.maxGapSeconds) * time.Second
+	stops := findStops(locations, speeds, radius, maxGapDur)
+	out := make([]*model.Segment, 0, len(stops))
+	for i, stop := range stops {
+		startedBefore := !stop.start.After(from)
+		var seg *model.Segment
+		if i == len(stops)-1 && stop.open && timeNow().Sub(to) <= maxGapDur {
+			// The vehicle hasn't moved away yet: the stop lasts until 'to'.
+			if int(to.Sub(stop.start).Seconds()) < rc.minDuration {
+				continue
+			}
+			start := stop.start
+			if start.Before(from) {
+				start = from
+			}
+			seg = newSegment(start, nil, int32(to.Sub(start).Seconds()), true, startedBefore)
+		} else {
+			tr, ok := clipTimeRange(stop.timeRange, from, to, rc.minDuration)
+			if !ok {
+				continue
+			}
+			end := tr.end
+			seg = newSegment(tr.start, &end, int32(end.Sub(tr.start).Seconds()), false, startedBefore)
+		}
+		centroid := stop.centroid
+		seg.Stop = &model.SegmentStop{
+			Centroid:   &centroid,
+			IgnitionOn: ignitionOnDuring(ignition, stop.start, stop.end),
+		}
+		out = append(out, seg)
+	}
+	return out, nil
+}
+
+// GetMechanismName returns the name of this detection mechanism.
+func (d *StopsDetector) GetMechanismName() string {
+	return "stops"
+}
+
+// findStops clusters sorted location samples into stops. A sample joins the current stop while it is
+// within radius meters of the stop's running centroid and the latest speed at or before it is at most
+// stopMaxSpeedKph. A sample without a speed sample in the maxGap before it is judged by location
+// alone, so a vehicle that stopped reporting speed isn't held at its last speed. Any other sample
+// ends the stop, and a stationary one starts the next.
+func findStops(locations []locationSample, speeds []levelSample, radius float64, maxGap time.Duration) []stopRange {
+	var stops []stopRange
+	var cur stopRange
+	var sumLat, sumLng float64
+	var n int
+	closeStop := func() {
+		if n > 1 && cur.end.After(cur.start) {
+			cur.centroid = model.Location{Latitude: sumLat / float64(n), Longitude: sumLng / float64(n)}
+			stops = append(stops, cur)
+		}
+		n = 0
+	}
+	for _, loc := range locations {
+		stationary := true
+		if speed, ok := recentSpeed(speeds, loc.ts, maxGap); ok {
+			stationary = speed <= stopMaxSpeedKph
+		}
+		if n > 0 && stationary && HaversineMeters(sumLat/float64(n), sumLng/float64(n), loc.lat, loc.lng) <= radius {
+			sumLat += loc.lat
+			sumLng += loc.lng
+			n++
+			cur.end = loc.ts
+			continue
+		}
+		closeStop()
+		if stationary {
+			cur = stopRange{timeRange: timeRange{start: loc.ts, end: loc.ts}}
+			sumLat, sumLng, n = loc.lat, loc.lng, 1
+		}
+	}
+	if n > 0 {
+		cur.open = true
+		closeStop()
+	}
+	return stops
+}
+
+// recentSpeed returns the latest speed sample at or before t if it is at most maxGap older than t.
+func recentSpeed(speeds []levelSample, t time.Time, maxGap time.Duration) (float64, bool) {
+	idx := sort.Search(len(speeds), func(i int) bool { return speeds[i].ts.After(t) })
+	if idx == 0 || t.Sub(speeds[idx-1].ts) > maxGap {
+		return 0, false
+	}
+	return speeds[idx-1].value, true
+}
+
+// ignitionOnDuring reports whether ignition was on at any time in [start, end], including the state
+// carried over from before start. Returns nil if there are no ignition samples at or before end.
+func ignitionOnDuring(samples []levelSample, start, end time.Time) *bool {
+	idx := sort.Search(len(samples), func(i int) bool { return samples[i].ts.After(start) })
+	if idx == 0 && (len(samples) == 0 || samples[0].ts.After(end)) {
+		return nil
+	}
+	on := idx > 0 && samples[idx-1].value == 1
+	for i := idx; !on && i < len(samples) && !samples[i].ts.After(end); i++ {
+		on = samples[i].value == 1
+	}
+	return &on
+}
+
+// HaversineMeters returns the great-circle distance in meters between two coordinates.
+func HaversineMeters(lat1, lng1, lat2, lng2 float64) float64 {
+	toRad := math.Pi / 180
+	dLat := (lat2 - lat1) * toRad
+	dLng := (lng2 - lng1) * toRad
+	a := math.Sin(dLat/2)*math.Sin(dLat/2) +
+		math.Cos(lat1*toRad)*math.Cos(lat2*toRad)*math.Sin(dLng/2)*math.Sin(dLng/2)
+	return 2 * earthRadiusMeters * math.Asin(math.Sqrt(a))
+}
+
+// getLocationSamples returns the non-zero location samples in [from, to), in timestamp order.
+func (d *StopsDetector) getLocationSamples(ctx context.Context, subject string, from, to time.Time) (_ []locationSample, retErr error) {
+	stmt, args := getLocationSamplesQuery(subject, from, to)
+	rows, err := d.conn.Query(ctx, stmt, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { retErr = errors.Join(retErr, rows.Close()) }()
+	var out []locationSample
+	for rows.Next() {
+		var ts time.Time
+		var loc vss.Location
+		if err := rows.Scan(&ts, &loc); err != nil {
+			return nil, err
+		}
+		out = append(out, locationSample{ts: ts, lat: loc.Latitude, lng: loc.Longitude})
+	}
+	return out, rows.Err()
+}
+
+// getLocationSamplesQuery returns a query for the timestamps and values of the non-zero
+// currentLocationCoordinates samples in [from, to), in order. FINAL drops duplicate rows
+// that would skew the stop centroid.
+func getLocationSamplesQuery(subject string, from, to time.Time) (string, []any) {
+	return newQuery(
+		qm.Select(vss.TimestampCol, vss.ValueLocationCol),
+		qm.From(vss.TableName+" FINAL"),
+		qm.Where(subjectWhere, subject),
+		qm.Where(vss.NameCol+" = ?", vss.FieldCurrentLocationCoordinates),
+		qm.Where(vss.TimestampCol+" >= "+dateTime64Micro(from)),
+		qm.Where(vss.TimestampCol+" < "+dateTime64Micro(to)),
+		qm.Where("("+vss.ValueLocationCol+".latitude != 0 OR "+vss.ValueLocationCol+".longitude != 0)"),
+		qm.OrderBy(vss.TimestampCol),
+	)
+}
@@ -0,0 +1,91 @@
+package ch
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DIMO-Network/model-garage/pkg/vss"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFindStops(t *testing.T) {
+	base := time.Date(2024, 1, 1, 8, 0, 0, 0, time.UTC)
+	at := func(min int) time.Time { return base.Add(time.Duration(min) * time.Minute) }
+	// Parked at home until 08:30 with GPS drift, drive for 30 minutes, then parked at work.
+	// The device sleeps while parked, so samples there are sparse.
+	locations := []locationSample{
+		{ts: at(0), lat: 40.7500, lng: -73.9900},
+		{ts: at(20), lat: 40.7502, lng: -73.9901},
+		{ts: at(30), lat: 40.7501, lng: -73.9899},
+		{ts: at(35), lat: 40.7600, lng: -73.9800},
+		{ts: at(45), lat: 40.7700, lng: -73.9700},
+		{ts: at(55), lat: 40.7800, lng: -73.9600},
+		{ts: at(60), lat: 40.7800, lng: -73.9600},
+		{ts: at(300), lat: 40.7801, lng: -73.9601},
+	}
+	speeds := []levelSample{
+		{ts: at(0), value: 0},
+		{ts: at(31), value: 50},
+		{ts: at(45), value: 50},
+		{ts: at(54), value: 50},
+		{ts: at(59), value: 0},
+	}
+
+	stops := findStops(locations, speeds, 100, 5*time.Minute)
+	require.Len(t, stops, 2)
+	require.Equal(t, at(0), stops[0].start)
+	require.Equal(t, at(30), stops[0].end)
+	require.False(t, stops[0].open)
+	require.InDelta(t, 40.7501, stops[0].centroid.Latitude, 1e-9)
+	require.InDelta(t, -73.99, stops[0].centroid.Longitude, 1e-9)
+	require.Equal(t, at(60), stops[1].start)
+	require.Equal(t, at(300), stops[1].end)
+	require.True(t, stops[1].open)
+
+	t.Run("a tighter radius splits drifting samples", func(t *testing.T) {
+		require.Empty(t, findStops(locations[:3], speeds, 10, 5*time.Minute))
+	})
+
+	t.Run("without speed data location decides", func(t *testing.T) {
+		stops := findStops(locations, nil, 100, 5*time.Minute)
+		require.Len(t, stops, 2)
+		require.Equal(t, at(55), stops[1].start)
+	})
+
+	t.Run("speed older than maxGap is ignored", func(t *testing.T) {
+		// Speed reporting stops while driving; the vehicle still parks at work.
+		stops := findStops(locations, speeds[:3], 100, 5*time.Minute)
+		require.Len(t, stops, 2)
+		require.Equal(t, at(55), stops[1].start)
+		require.Equal(t, at(300), stops[1].end)
+	})
+}
+
+func TestIgnitionOnDuring(t *testing.T) {
+	base := time.Date(2024, 1, 1, 8, 0, 0, 0, time.UTC)
+	samples := []levelSample{
+		{ts: base, value: 1},
+		{ts: base.Add(10 * time.Minute), value: 0},
+		{ts: base.Add(50 * time.Minute), value: 1},
+	}
+	require.Nil(t, ignitionOnDuring(nil, base, base.Add(time.Hour)))
+	require.Nil(t, ignitionOnDuring(samples, base.Add(-time.Hour), base.Add(-time.Minute)))
+	require.Equal(t, ref(true), ignitionOnDuring(samples, base.Add(5*time.Minute), base.Add(20*time.Minute)))
+	require.Equal(t, ref(false), ignitionOnDuring(samples, base.Add(15*time.Minute), base.Add(45*time.Minute)))
+	require.Equal(t, ref(true), ignitionOnDuring(samples, base.Add(15*time.Minute), base.Add(55*time.Minute)))
+}
+
+func TestHaversineMeters(t *testing.T) {
+	require.InDelta(t, 0, HaversineMeters(40.75, -73.99, 40.75, -73.99), 1e-9)
+	// One degree of latitude is about 111.2 km.
+	require.InDelta(t, 111195, HaversineMeters(0, 0, 1, 0), 10)
+}
+
+func TestGetLocationSamplesQuery(t *testing.T) {
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	stmt, args := getLocationSamplesQuery("subj", from, from.Add(24*time.Hour))
+	require.Contains(t, stmt, "SELECT `timestamp`, `value_location` FROM signal FINAL")
+	require.Contains(t, stmt, "(value_location.latitude != 0 OR value_location.longitude != 0)")
+	require.Contains(t, stmt, "ORDER BY timestamp")
+	require.Equal(t, []any{"subj", vss.FieldCurrentLocationCoordinates}, args)
+}
@@ -0,0 +1,241 @@
+package ch
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/DIMO-Network/telemetry-api/internal/graph/model"
+	"golang.org/x/sync/errgroup"
+)
+
+const segmentSummaryStoreTable = "segment_summary_store"
+
+// SummaryRange is a range to summarize: events are counted in [From, To) and signals are
+// aggregated in [From, SignalsTo), which may extend past To.
+type SummaryRange struct {
+	From, To, SignalsTo time.Time
+}
+
+// RangeSummary holds the signal aggregates and event counts of one SummaryRange.
+type RangeSummary struct {
+	Aggs        []*AggSignal
+	EventCounts []*EventCount
+}
+
+// summaryStoreKey identifies the summaries of one vehicle computed with one set of aggregations.
+type summaryStoreKey struct {
+	subject  string
+	argsHash string
+}
+
+// summaryStoreJob asks the writer to store summaries computed by a query.
+type summaryStoreJob struct {
+	key       summaryStoreKey
+	ranges    []SummaryRange
+	summaries []*RangeSummary
+}
+
+// GetSummariesForRanges returns the summary of each range, in order. Signals are aggregated with
+// floatArgs and locationArgs; events named in eventNames are counted, or all events if it is empty.
+// With the segment store, summaries of ranges that ended before its reprocess window are read from
+// the store; those not stored yet are computed with all events counted and queued for the writer.
+func (s *Service) GetSummariesForRanges(ctx context.Context, subject string, ranges []SummaryRange, floatArgs []model.FloatSignalArgs, locationArgs []model.LocationSignalArgs, eventNames []string) ([]*RangeSummary, error) {
+	summaries := make([]*RangeSummary, len(ranges))
+	if len(ranges) == 0 {
+		return summaries, nil
+	}
+	var key summaryStoreKey
+	if s.segmentStore != nil {
+		key = summaryStoreKey{subject: subject, argsHash: summaryArgsHash(floatArgs, locationArgs)}
+		if err := s.segmentStore.getStoredSummaries(ctx, key, ranges, summaries); err != nil {
+			return nil, err
+		}
+		eventNames = nil
+	}
+
+	var missing []SummaryRange
+	var missingIdx []int
+	for i, r := range ranges {
+		if summaries[i] == nil {
+			missing = append(missing, r)
+			missingIdx = append(missingIdx, i)
+		}
+	}
+	if len(missing) == 0 {
+		return summaries, nil
+	}
+	computed, err := s.computeSummaries(ctx, subject, missing, floatArgs, locationArgs, eventNames)
+	if err != nil {
+		return nil, err
+	}
+	job := summaryStoreJob{key: key}
+	for j, i := range missingIdx {
+		summaries[i] = computed[j]
+		if s.segmentStore != nil && summaryIsFinal(missing[j]) {
+			job.ranges = append(job.ranges, missing[j])
+			job.summaries = append(job.summaries, computed[j])
+		}
+	}
+	if len(job.ranges) > 0 {
+		enqueue(s.segmentStore, s.segmentStore.summaryJobs, job.key, job)
+	}
+	return summaries, nil
+}
+
+// computeSummaries aggregates signals and counts events for each range from raw signals and events.
+func (s *Service) computeSummaries(ctx context.Context, subject string, ranges []SummaryRange, floatArgs []model.FloatSignalArgs, locationArgs []model.LocationSignalArgs, eventNames []string) ([]*RangeSummary, error) {
+	eventRanges := make([]TimeRange, len(ranges))
+	signalRanges := make([]TimeRange, len(ranges))
+	globalFrom, globalTo := ranges[0].From, ranges[0].SignalsTo
+	for i, r := range ranges {
+		eventRanges[i] = TimeRange{From: r.From, To: r.To}
+		signalRanges[i] = TimeRange{From: r.From, To: r.SignalsTo}
+		if r.From.Before(globalFrom) {
+			globalFrom = r.From
+		}
+		if r.SignalsTo.After(globalTo) {
+			globalTo = r.SignalsTo
+		}
+	}
+	var counts []*EventCountForRange
+	var aggs []*AggSignalForRange
+	g, gctx := errgroup.WithContext(ctx)
+	g.Go(func() error {
+		var err error
+		counts, err = s.GetEventCountsForRanges(gctx, subject, eventRanges, eventNames)
+		return err
+	})
+	g.Go(func() error {
+		var err error
+		aggs, err = s.GetAggregatedSignalsForRanges(gctx, subject, signalRanges, globalFrom, globalTo, floatArgs, locationArgs)
+		return err
+	})
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	summaries := make([]*RangeSummary, len(ranges))
+	for i := range summaries {
+		summaries[i] = &RangeSummary{Aggs: []*AggSignal{}, EventCounts: []*EventCount{}}
+	}
+	for _, c := range counts {
+		summaries[c.SegIndex].EventCounts = append(summaries[c.SegIndex].EventCounts, &EventCount{Name: c.Name, Count: c.Count})
+	}
+	for _, a := range aggs {
+		summaries[a.SegIndex].Aggs = append(summaries[a.SegIndex].Aggs, &AggSignal{
+			SignalType:    a.SignalType,
+			SignalIndex:   a.SignalIndex,
+			ValueNumber:   a.ValueNumber,
+			ValueString:   a.ValueString,
+			ValueLocation: a.ValueLocation,
+		})
+	}
+	return summaries, nil
+}
+
+// summaryIsFinal reports whether a range ended before the reprocess window, so telemetry arriving
+// late no longer changes its summary, and is young enough to be kept.
+func summaryIsFinal(r SummaryRange) bool {
+	now := timeNow()
+	end := r.To
+	if r.SignalsTo.After(end) {
+		end = r.SignalsTo
+	}
+	return end.Before(now.Add(-segmentStoreReprocessWindow)) && r.From.After(now.Add(-segmentStoreRetention))
+}
+
+// summaryArgsHash returns a hash of the aggregations summaries are computed with, since the
+// aggregates of a summary are indexed by them.
+func summaryArgsHash(floatArgs []model.FloatSignalArgs, locationArgs []model.LocationSignalArgs) string {
+	// Marshaling structs of plain fields can't fail.
+	b, _ := json.Marshal(struct {
+		Float    []model.FloatSignalArgs
+		Location []model.LocationSignalArgs
+	}{floatArgs, locationArgs})
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:16])
+}
+
+// getStoredSummaries sets summaries[i] to the stored summary of ranges[i], where there is one.
+func (s *segmentStore) getStoredSummaries(ctx context.Context, key summaryStoreKey, ranges []SummaryRange, summaries []*RangeSummary) (retErr error) {
+	byRange := make(map[SummaryRange]int, len(ranges))
+	minFrom, maxFrom := ranges[0].From, ranges[0].From
+	for i, r := range ranges {
+		byRange[summaryRangeKey(r)] = i
+		if r.From.Before(minFrom) {
+			minFrom = r.From
+		}
+		if r.From.After(maxFrom) {
+			maxFrom = r.From
+		}
+	}
+	rows, err := s.conn.Query(ctx, getStoredSummariesQuery(minFrom, maxFrom), key.subject, key.argsHash)
+	if err != nil {
+		return fmt.Errorf("failed to query stored summaries: %w", err)
+	}
+	defer func() { retErr = errors.Join(retErr, rows.Close()) }()
+	for rows.Next() {
+		var r SummaryRange
+		var summary string
+		if err := rows.Scan(&r.From, &r.To, &r.SignalsTo, &summary); err != nil {
+			return fmt.Errorf("failed to scan stored summary: %w", err)
+		}
+		i, ok := byRange[summaryRangeKey(r)]
+		if !ok {
+			continue
+		}
+		var stored RangeSummary
+		if err := json.Unmarshal([]byte(summary), &stored); err != nil {
+			return fmt.Errorf("failed to decode stored summary: %w", err)
+		}
+		summaries[i] = &stored
+	}
+	return rows.Err()
+}
+
+// summaryRangeKey returns r in UTC at the precision it is stored with, to match stored ranges.
+func summaryRangeKey(r SummaryRange) SummaryRange {
+	return SummaryRange{
+		From:      r.From.UTC().Truncate(time.Microsecond),
+		To:        r.To.UTC().Truncate(time.Microsecond),
+		SignalsTo: r.SignalsTo.UTC().Truncate(time.Microsecond),
+	}
+}
+
+// insertSummaries stores the summaries of a job.
+func (s *segmentStore) insertSummaries(ctx context.Context, job summaryStoreJob) error {
+	batch, err := s.conn.PrepareBatch(ctx, "INSERT INTO "+segmentSummaryStoreTable+
+		" (subject, args_hash, from_time, to_time, signals_to, summary)")
+	if err != nil {
+		return fmt.Errorf("failed to prepare summary store insert: %w", err)
+	}
+	for i, r := range job.ranges {
+		summary, err := json.Marshal(job.summaries[i])
+		if err != nil {
+			_ = batch.Abort()
+			return fmt.Errorf("failed to encode summary: %w", err)
+		}
+		if err := batch.Append(job.key.subject, job.key.argsHash, r.From, r.To, r.SignalsTo, string(summary)); err != nil {
+			_ = batch.Abort()
+			return fmt.Errorf("failed to append stored summary: %w", err)
+		}
+	}
+	if err := batch.Send(); err != nil {
+		return fmt.Errorf("failed to insert stored summaries: %w", err)
+	}
+	return nil
+}
+
+// getStoredSummariesQuery returns a query for the stored summaries of a store key whose ranges
+// start in [minFrom, maxFrom].
+func getStoredSummariesQuery(minFrom, maxFrom time.Time) string {
+	return "SELECT from_time, to_time, signals_to, summary FROM " + segmentSummaryStoreTable + " FINAL" +
+		" WHERE subject = ? AND args_hash = ?" +
+		" AND from_time >= " + dateTime64Micro(minFrom) +
+		" AND from_time <= " + dateTime64Micro(maxFrom)
+}
@@ -0,0 +1,52 @@
+package ch
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DIMO-Network/telemetry-api/internal/graph/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSummaryIsFinal(t *testing.T) {
+	now := time.Date(2025, 6, 1, 0, 0, 0, 0, time.UTC)
+	origTimeNow := timeNow
+	timeNow = func() time.Time { return now }
+	t.Cleanup(func() { timeNow = origTimeNow })
+
+	day := func(daysAgo int) SummaryRange {
+		from := now.AddDate(0, 0, -daysAgo)
+		return SummaryRange{From: from, To: from.Add(24 * time.Hour), SignalsTo: from.Add(24 * time.Hour)}
+	}
+	require.True(t, summaryIsFinal(day(30)))
+	require.False(t, summaryIsFinal(day(3)), "late telemetry may still change the summary")
+	require.False(t, summaryIsFinal(day(400)), "older than the retention")
+
+	r := day(30)
+	r.SignalsTo = now
+	require.False(t, summaryIsFinal(r), "signals are aggregated up to the reprocess window")
+}
+
+func TestSummaryArgsHash(t *testing.T) {
+	speed := []model.FloatSignalArgs{{Name: "speed", Agg: model.FloatAggregationMax, Alias: "speed_MAX"}}
+	locs := []model.LocationSignalArgs{{Name: "currentLocationCoordinates", Agg: model.LocationAggregationFirst, Alias: "startLoc"}}
+	require.Equal(t, summaryArgsHash(speed, locs), summaryArgsHash(speed, locs))
+	require.NotEqual(t, summaryArgsHash(speed, locs), summaryArgsHash(speed, nil))
+	require.NotEqual(t, summaryArgsHash(speed, locs), summaryArgsHash(nil, locs))
+}
+
+func TestSummaryRangeKey(t *testing.T) {
+	from := time.Date(2024, 1, 1, 0, 0, 0, 1500, time.FixedZone("CET", 3600))
+	key := summaryRangeKey(SummaryRange{From: from, To: from.Add(time.Hour), SignalsTo: from.Add(time.Hour)})
+	stored := time.Date(2023, 12, 31, 23, 0, 0, 1000, time.UTC)
+	require.Equal(t, SummaryRange{From: stored, To: stored.Add(time.Hour), SignalsTo: stored.Add(time.Hour)}, key)
+}
+
+func TestGetStoredSummariesQuery(t *testing.T) {
+	from := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	stmt := getStoredSummariesQuery(from, from.Add(24*time.Hour))
+	require.Contains(t, stmt, "FROM segment_summary_store FINAL")
+	require.Contains(t, stmt, "subject = ? AND args_hash = ?")
+	require.Contains(t, stmt, "from_time >= "+dateTime64Micro(from))
+	require.Contains(t, stmt, "from_time <= "+dateTime64Micro(from.Add(24*time.Hour)))
+}
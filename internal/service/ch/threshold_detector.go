@@ -0,0 +1,114 @@
+package ch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/ClickHouse/clickhouse-go/v2"
+	"github.com/DIMO-Network/telemetry-api/internal/graph/model"
+)
+
+// ThresholdDetector detects segments where a float signal satisfies a filter,
+// e.g. speeding, overheating or low battery episodes.
+// Processes samples in-memory for exact segment boundaries, like IdlingDetector.
+type ThresholdDetector struct {
+	conn clickhouse.Conn
+}
+
+// NewThresholdDetector creates a new ThresholdDetector with the given connection.
+func NewThresholdDetector(conn clickhouse.Conn) *ThresholdDetector {
+	return &ThresholdDetector{conn: conn}
+}
+
+// DetectSegments fetches the samples of config.ThresholdSignal (1 CH query) and finds contiguous runs
+// of samples matching config.ThresholdFilter in-memory.
+func (d *ThresholdDetector) DetectSegments(
+	ctx context.Context,
+	subject string,
+	from, to time.Time,
+	config *model.SegmentConfig,
+) ([]*model.Segment, error) {
+	if config == nil || config.ThresholdSignal == nil || config.ThresholdFilter == nil {
+		return nil, errors.New("threshold detection requires thresholdSignal and thresholdFilter")
+	}
+	rc := resolveBaseConfig(config)
+
+	lookbackFrom := from.Add(-time.Duration(rc.maxGapSeconds) * time.Second)
+	samples, err := getLevelSamples(ctx, d.conn, subject, *config.ThresholdSignal, lookbackFrom, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query %s samples: %w", *config.ThresholdSignal, err)
+	}
+	if len(samples) == 0 {
+		return []*model.Segment{}, nil
+	}
+
+	match := func(v float64) bool { return matchesFloatFilter(v, config.ThresholdFilter) }
+	ranges := findSampleRuns(samples, match, rc.maxGapSeconds, rc.minDuration, from, to)
+	return timeRangesToSegments(ranges, from), nil
+}
+
+// GetMechanismName returns the name of this detection mechanism.
+func (d *ThresholdDetector) GetMechanismName() string {
+	return "threshold"
+}
+
+// matchesFloatFilter evaluates a float filter in-memory with the same semantics as
+// buildFloatConditionList: all conditions must hold, and if any or clause has
+// conditions, at least one of those clauses must hold.
+func matchesFloatFilter(v float64, fil *model.SignalFloatFilter) bool {
+	if fil == nil {
+		return true
+	}
+	if fil.Eq != nil && v != *fil.Eq {
+		return false
+	}
+	if fil.Neq != nil && v == *fil.Neq {
+		return false
+	}
+	if fil.Gt != nil && v <= *fil.Gt {
+		return false
+	}
+	if fil.Lt != nil && v >= *fil.Lt {
+		return false
+	}
+	if fil.Gte != nil && v < *fil.Gte {
+		return false
+	}
+	if fil.Lte != nil && v > *fil.Lte {
+		return false
+	}
+	if len(fil.NotIn) != 0 && slices.Contains(fil.NotIn, v) {
+		return false
+	}
+	if len(fil.In) != 0 && !slices.Contains(fil.In, v) {
+		return false
+	}
+
+	hasOr := false
+	for _, cond := range fil.Or {
+		if !HasFloatCondition(cond) {
+			continue
+		}
+		hasOr = true
+		if matchesFloatFilter(v, cond) {
+			return true
+		}
+	}
+	return !hasOr
+}
+
+// HasFloatCondition reports whether the filter has at least one condition,
+// directly or in an or clause.
+func HasFloatCondition(fil *model.SignalFloatFilter) bool {
+	if fil == nil {
+		return false
+	}
+	if fil.Eq != nil || fil.Neq != nil || fil.Gt != nil || fil.Lt != nil || fil.Gte != nil || fil.Lte != nil ||
+		len(fil.NotIn) != 0 || len(fil.In) != 0 {
+		return true
+	}
+	return slices.ContainsFunc(fil.Or, HasFloatCondition)
+}
@@ -0,0 +1,78 @@
+package ch
+
+import (
+	"testing"
+	"time"
+
+	"github.com/DIMO-Network/telemetry-api/internal/graph/model"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatchesFloatFilter(t *testing.T) {
+	tests := []struct {
+		name   string
+		filter *model.SignalFloatFilter
+		value  float64
+		want   bool
+	}{
+		{"nil filter", nil, 1, true},
+		{"gt above", &model.SignalFloatFilter{Gt: ref(120.0)}, 121, true},
+		{"gt equal", &model.SignalFloatFilter{Gt: ref(120.0)}, 120, false},
+		{"gte equal", &model.SignalFloatFilter{Gte: ref(120.0)}, 120, true},
+		{"lt below", &model.SignalFloatFilter{Lt: ref(10.0)}, 9, true},
+		{"lte above", &model.SignalFloatFilter{Lte: ref(10.0)}, 11, false},
+		{"eq", &model.SignalFloatFilter{Eq: ref(1.0)}, 1, true},
+		{"neq", &model.SignalFloatFilter{Neq: ref(1.0)}, 1, false},
+		{"in", &model.SignalFloatFilter{In: []float64{1, 2}}, 2, true},
+		{"not in", &model.SignalFloatFilter{NotIn: []float64{1, 2}}, 2, false},
+		{"range", &model.SignalFloatFilter{Gt: ref(0.0), Lt: ref(10.0)}, 10, false},
+		{
+			"or matches one clause",
+			&model.SignalFloatFilter{Or: []*model.SignalFloatFilter{{Lt: ref(10.0)}, {Gt: ref(90.0)}}},
+			95, true,
+		},
+		{
+			"or matches no clause",
+			&model.SignalFloatFilter{Or: []*model.SignalFloatFilter{{Lt: ref(10.0)}, {Gt: ref(90.0)}}},
+			50, false,
+		},
+		{"empty or clauses are ignored", &model.SignalFloatFilter{Or: []*model.SignalFloatFilter{{}}}, 50, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.want, matchesFloatFilter(tt.value, tt.filter))
+		})
+	}
+}
+
+func TestFindSampleRunsThreshold(t *testing.T) {
+	base := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+	min := func(m int) time.Time { return base.Add(time.Duration(m) * time.Minute) }
+	speeding := func(v float64) bool { return matchesFloatFilter(v, &model.SignalFloatFilter{Gt: ref(120.0)}) }
+
+	// Speeding from minute 0 to 10, a dip at minute 11, then speeding again from 12 to 14.
+	var samples []levelSample
+	for i := 0; i <= 14; i++ {
+		v := 130.0
+		if i == 11 {
+			v = 100
+		}
+		samples = append(samples, levelSample{ts: min(i), value: v})
+	}
+
+	t.Run("runs split on non-matching sample", func(t *testing.T) {
+		result := findSampleRuns(samples, speeding, 300, 60, base, min(60))
+		require.Equal(t, []timeRange{{start: min(0), end: min(10)}, {start: min(12), end: min(14)}}, result)
+	})
+
+	t.Run("minimum duration drops short runs", func(t *testing.T) {
+		result := findSampleRuns(samples, speeding, 300, 240, base, min(60))
+		require.Equal(t, []timeRange{{start: min(0), end: min(10)}}, result)
+	})
+
+	t.Run("gap larger than maxGap splits a run", func(t *testing.T) {
+		gapped := []levelSample{{ts: min(0), value: 130}, {ts: min(5), value: 130}, {ts: min(20), value: 130}, {ts: min(25), value: 130}}
+		result := findSampleRuns(gapped, speeding, 300, 60, base, min(60))
+		require.Equal(t, []timeRange{{start: min(0), end: min(5)}, {start: min(20), end: min(25)}}, result)
+	})
+}